@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -93,3 +94,18 @@ func (suite *KeeperTestHelper) PrepareBalancerPoolWithPoolAsset(assets []balance
 	suite.NoError(err)
 	return poolId
 }
+
+// PrepareConcentratedPool returns a bar/foo concentrated liquidity pool at a price of 1,
+// with a position of TestAccs[0] providing 1000000 of each asset over [-10000, 10000).
+func (suite *KeeperTestHelper) PrepareConcentratedPool() uint64 {
+	suite.FundAcc(suite.TestAccs[0], DefaultAcctFunds)
+
+	msg := concentrated.NewMsgCreateConcentratedPool(suite.TestAccs[0], "bar", "foo", 10, sdk.NewDecWithPrec(3, 3), sdk.OneDec())
+	poolId, err := suite.App.GAMMKeeper.CreateConcentratedPool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	_, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, suite.TestAccs[0], poolId, -10000, 10000, tokensDesired, sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	return poolId
+}
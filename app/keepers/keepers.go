@@ -55,6 +55,7 @@ import (
 	owasm "github.com/osmosis-labs/osmosis/v7/wasmbinding"
 	epochskeeper "github.com/osmosis-labs/osmosis/v7/x/epochs/keeper"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm"
 	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	incentiveskeeper "github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
@@ -74,6 +75,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v7/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+	twapkeeper "github.com/osmosis-labs/osmosis/v7/x/twap/keeper"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 )
 
 type AppKeepers struct {
@@ -104,6 +107,7 @@ type AppKeepers struct {
 	Bech32ICS20Keeper    *bech32ics20keeper.Keeper
 	EvidenceKeeper       *evidencekeeper.Keeper
 	GAMMKeeper           *gammkeeper.Keeper
+	TwapKeeper           *twapkeeper.Keeper
 	LockupKeeper         *lockupkeeper.Keeper
 	EpochsKeeper         *epochskeeper.Keeper
 	IncentivesKeeper     *incentiveskeeper.Keeper
@@ -262,6 +266,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper)
 	appKeepers.GAMMKeeper = &gammKeeper
 
+	twapKeeper := twapkeeper.NewKeeper(
+		appKeepers.keys[twaptypes.StoreKey],
+		appKeepers.tkeys[twaptypes.TransientStoreKey],
+		appKeepers.GetSubspace(twaptypes.ModuleName),
+		appKeepers.GAMMKeeper)
+	appKeepers.TwapKeeper = &twapKeeper
+
 	appKeepers.LockupKeeper = lockupkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[lockuptypes.StoreKey],
@@ -269,6 +280,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		*appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper)
+	appKeepers.GAMMKeeper.SetLockupKeeper(appKeepers.LockupKeeper)
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appCodec, appKeepers.keys[epochstypes.StoreKey])
 
@@ -317,12 +329,14 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.EpochsKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
+		appKeepers.GetSubspace(txfeestypes.ModuleName),
 		appKeepers.GAMMKeeper,
 		appKeepers.GAMMKeeper,
 		txfeestypes.FeeCollectorName,
 		txfeestypes.NonNativeFeeCollectorName,
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
+	appKeepers.GAMMKeeper.SetTxFeesKeeper(appKeepers.TxFeesKeeper)
 
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appCodec,
@@ -338,9 +352,12 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.GAMMKeeper, appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	// The wasm keeper is allocated before it is created, as the custom messenger
+	// calls contracts back through it for flash swaps.
+	appKeepers.WasmKeeper = &wasm.Keeper{}
+	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.GAMMKeeper, appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper, appKeepers.WasmKeeper), wasmOpts...)
 
-	wasmKeeper := wasm.NewKeeper(
+	*appKeepers.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		appKeepers.keys[wasm.StoreKey],
 		appKeepers.GetSubspace(wasm.ModuleName),
@@ -359,7 +376,6 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		supportedFeatures,
 		wasmOpts...,
 	)
-	appKeepers.WasmKeeper = &wasmKeeper
 
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper))
@@ -376,7 +392,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(poolincentivestypes.RouterKey, poolincentives.NewPoolIncentivesProposalHandler(*appKeepers.PoolIncentivesKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(*appKeepers.Bech32IBCKeeper)).
 		AddRoute(txfeestypes.RouterKey, txfees.NewUpdateFeeTokenProposalHandler(*appKeepers.TxFeesKeeper)).
-		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper))
+		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper)).
+		AddRoute(gammtypes.RouterKey, gamm.NewGammProposalHandler(appKeepers.GAMMKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(wasmEnabledProposals) != 0 {
@@ -450,8 +467,10 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(poolincentivestypes.ModuleName)
 	paramsKeeper.Subspace(superfluidtypes.ModuleName)
 	paramsKeeper.Subspace(gammtypes.ModuleName)
+	paramsKeeper.Subspace(twaptypes.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(txfeestypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -474,6 +493,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		gammtypes.NewMultiGammHooks(
 			// insert gamm hooks receivers here
 			appKeepers.PoolIncentivesKeeper.Hooks(),
+			appKeepers.TwapKeeper.GammHooks(),
+			appKeepers.TxFeesKeeper.GammHooks(),
 		),
 	)
 
@@ -532,6 +553,7 @@ func KVStoreKeys() []string {
 		ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey,
 		gammtypes.StoreKey,
+		twaptypes.StoreKey,
 		lockuptypes.StoreKey,
 		incentivestypes.StoreKey,
 		epochstypes.StoreKey,
@@ -30,6 +30,7 @@ import (
 	_ "github.com/osmosis-labs/osmosis/v7/client/docs/statik"
 	"github.com/osmosis-labs/osmosis/v7/x/epochs"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm"
+	gammclient "github.com/osmosis-labs/osmosis/v7/x/gamm/client"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives"
 	"github.com/osmosis-labs/osmosis/v7/x/lockup"
 	"github.com/osmosis-labs/osmosis/v7/x/mint"
@@ -38,6 +39,7 @@ import (
 	superfluid "github.com/osmosis-labs/osmosis/v7/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v7/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v7/x/tokenfactory"
+	"github.com/osmosis-labs/osmosis/v7/x/twap"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 )
 
@@ -62,6 +64,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 			ibcclientclient.UpgradeProposalHandler,
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
+			gammclient.SetPoolPauseStatusProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -74,6 +77,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	transfer.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	gamm.AppModuleBasic{},
+	twap.AppModuleBasic{},
 	txfees.AppModuleBasic{},
 	incentives.AppModuleBasic{},
 	lockup.AppModuleBasic{},
@@ -62,6 +62,8 @@ import (
 	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v7/x/tokenfactory"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v7/x/tokenfactory/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
@@ -123,6 +125,7 @@ func appModules(
 		params.NewAppModule(*app.ParamsKeeper),
 		app.TransferModule,
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		twap.NewAppModule(*app.TwapKeeper),
 		txfees.NewAppModule(appCodec, *app.TxFeesKeeper),
 		incentives.NewAppModule(appCodec, *app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(appCodec, *app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
@@ -170,6 +173,7 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		gammtypes.ModuleName,
+		twaptypes.ModuleName,
 		incentivestypes.ModuleName,
 		lockuptypes.ModuleName,
 		poolincentivestypes.ModuleName,
@@ -188,6 +192,9 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.LastElements(epochstypes.ModuleName)
 	// txfees auto-swap code should occur before any potential gamm end block code.
 	ord.Before(txfeestypes.ModuleName, gammtypes.ModuleName)
+	// twap pruning should occur after any end block code that may swap against pools.
+	ord.After(twaptypes.ModuleName, txfeestypes.ModuleName)
+	ord.After(twaptypes.ModuleName, gammtypes.ModuleName)
 	// only remaining modules that aren;t no-ops are: crisis & govtypes
 	// we don't care about the relative ordering between them.
 
@@ -209,6 +216,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		ibchost.ModuleName,
 		icatypes.ModuleName,
 		gammtypes.ModuleName,
+		twaptypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -251,6 +259,9 @@ func simulationModules(
 		evidence.NewAppModule(*app.EvidenceKeeper),
 		wasm.NewAppModule(appCodec, app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
+		// gamm generates its genesis state after bank, as it funds the
+		// simulation accounts with pool assets
+		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
 		incentives.NewAppModule(appCodec, *app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(appCodec, *app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
 		poolincentives.NewAppModule(appCodec, *app.PoolIncentivesKeeper),
@@ -7,6 +7,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/app/keepers"
 	"github.com/osmosis-labs/osmosis/v7/app/upgrades"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -29,6 +31,55 @@ func CreateUpgradeHandler(
 
 			bpm.StoreConsensusParams(ctx, cp)
 		}
-		return mm.RunMigrations(ctx, configurator, fromVM)
+		// RunMigrations runs the twap module's InitGenesis, so records for the
+		// already existing pools can only be created afterwards.
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return vm, err
+		}
+		err = keepers.TwapKeeper.MigrateExistingPools(ctx)
+		if err != nil {
+			return vm, err
+		}
+
+		setNewGammParams(ctx, keepers)
+
+		// txfees had no params before the base fee was introduced, which
+		// starts disabled until governance sets a min base fee.
+		txFeesParams := txfeestypes.DefaultParams()
+		keepers.TxFeesKeeper.SetParams(ctx, txFeesParams)
+		keepers.TxFeesKeeper.SetCurrentBaseFee(ctx, txFeesParams.MinBaseFee)
+		// The fee tokens start recording their prices, so that they have
+		// TWAPs once governance values fees at TWAPs.
+		keepers.TxFeesKeeper.RecordExistingFeeTokenPrices(ctx)
+
+		// Pools created before the denom index was introduced have to be
+		// indexed for the PoolsWithDenoms query to find them.
+		err = keepers.GAMMKeeper.IndexExistingPools(ctx)
+		if err != nil {
+			return vm, err
+		}
+
+		// Pools created before their share denom metadata had a name, or
+		// before it was set at all, get it backfilled.
+		err = keepers.GAMMKeeper.SetExistingPoolsShareDenomMetadata(ctx)
+		if err != nil {
+			return vm, err
+		}
+		return vm, nil
 	}
 }
+
+// setNewGammParams sets the params added to gamm to their defaults: no taker
+// fee, no pause guardian and the circuit breaker disabled, until governance
+// sets them.
+func setNewGammParams(ctx sdk.Context, keepers *keepers.AppKeepers) {
+	gammSubspace := keepers.GetSubspace(gammtypes.ModuleName)
+	defaultParams := gammtypes.DefaultParams()
+	gammSubspace.Set(ctx, gammtypes.KeyTakerFee, defaultParams.TakerFee)
+	gammSubspace.Set(ctx, gammtypes.KeyTakerFeeOverrides, defaultParams.TakerFeeOverrides)
+	gammSubspace.Set(ctx, gammtypes.KeyTakerFeeRecipient, defaultParams.TakerFeeRecipient)
+	gammSubspace.Set(ctx, gammtypes.KeyPauseGuardian, defaultParams.PauseGuardian)
+	gammSubspace.Set(ctx, gammtypes.KeyCircuitBreakerMaxPriceChange, defaultParams.CircuitBreakerMaxPriceChange)
+	gammSubspace.Set(ctx, gammtypes.KeyCircuitBreakerWindowBlocks, defaultParams.CircuitBreakerWindowBlocks)
+}
@@ -17,12 +17,13 @@ type BigDec struct {
 }
 
 const (
-	// number of decimal places
-	Precision = 18
+	// number of decimal places, twice the precision of an sdk.Dec, so that
+	// solvers converting from and to sdk.Decs don't lose precision in between
+	Precision = 36
 
 	// bytes required to represent the above precision
-	// Ceiling[Log2[999 999 999 999 999 999]]
-	DecimalPrecisionBits = 60
+	// Ceiling[Log2[10**36 - 1]]
+	DecimalPrecisionBits = 120
 
 	maxDecBitLen = maxBitLen + DecimalPrecisionBits
 
@@ -260,6 +261,17 @@ func (d BigDec) MulTruncate(d2 BigDec) BigDec {
 	return BigDec{chopped}
 }
 
+// multiplication round up
+func (d BigDec) MulRoundUp(d2 BigDec) BigDec {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	chopped := chopPrecisionAndRoundUp(mul)
+
+	if chopped.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{chopped}
+}
+
 // multiplication
 func (d BigDec) MulInt(i BigInt) BigDec {
 	mul := new(big.Int).Mul(d.i, i.i)
@@ -626,14 +638,14 @@ func (d BigDec) Ceil() BigDec {
 var MaxSortableDec = OneDec().Quo(SmallestDec())
 
 // ValidSortableDec ensures that a Dec is within the sortable bounds,
-// a BigDec can't have a precision of less than 10^-18.
+// a BigDec can't have a precision of less than 10^-36.
 // Max sortable decimal was set to the reciprocal of SmallestDec.
 func ValidSortableDec(dec BigDec) bool {
 	return dec.Abs().LTE(MaxSortableDec)
 }
 
 // SortableDecBytes returns a byte slice representation of a Dec that can be sorted.
-// Left and right pads with 0s so there are 18 digits to left and right of the decimal point.
+// Left and right pads with 0s so there are 36 digits to left and right of the decimal point.
 // For this reason, there is a maximum and minimum value for this, enforced by ValidSortableDec.
 func SortableDecBytes(dec BigDec) []byte {
 	if !ValidSortableDec(dec) {
@@ -55,3 +55,17 @@ func TestPow(t *testing.T) {
 		"expected value & actual value's difference should less than precision",
 	)
 }
+
+func TestSDKDecConversions(t *testing.T) {
+	d := sdk.MustNewDecFromStr("1.234567890123456789")
+	require.Equal(t, MustNewDecFromStr("1.234567890123456789"), BigDecFromSDKDec(d))
+	require.Equal(t, d, BigDecFromSDKDec(d).SDKDec())
+	require.Equal(t, d, BigDecFromSDKDec(d).SDKDecRoundUp())
+	require.Equal(t, NewBigDec(42), BigDecFromSDKInt(sdk.NewInt(42)))
+
+	bigDec := MustNewDecFromStr("1.000000000000000000000000000000000001")
+	require.Equal(t, sdk.OneDec(), bigDec.SDKDec())
+	require.Equal(t, sdk.MustNewDecFromStr("1.000000000000000001"), bigDec.SDKDecRoundUp())
+	require.Equal(t, sdk.MustNewDecFromStr("-1.000000000000000000"), bigDec.Neg().SDKDec())
+	require.Equal(t, sdk.MustNewDecFromStr("-1.000000000000000000"), bigDec.Neg().SDKDecRoundUp())
+}
@@ -0,0 +1,38 @@
+package osmomath
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// precisionDifferenceMultiplier is the factor between the precision of a
+// BigDec and of an sdk.Dec.
+var precisionDifferenceMultiplier = new(big.Int).Exp(tenInt, big.NewInt(Precision-sdk.Precision), nil)
+
+// BigDecFromSDKDec returns the BigDec with the value of d.
+func BigDecFromSDKDec(d sdk.Dec) BigDec {
+	return NewDecFromBigIntWithPrec(d.BigInt(), sdk.Precision)
+}
+
+// BigDecFromSDKInt returns the BigDec with the value of i.
+func BigDecFromSDKInt(i sdk.Int) BigDec {
+	return NewDecFromBigInt(i.BigInt())
+}
+
+// SDKDec returns the sdk.Dec with the value of d, truncating the digits
+// beyond the precision of an sdk.Dec.
+func (d BigDec) SDKDec() sdk.Dec {
+	truncated := new(big.Int).Quo(d.i, precisionDifferenceMultiplier)
+	return sdk.NewDecFromBigIntWithPrec(truncated, sdk.Precision)
+}
+
+// SDKDecRoundUp returns the sdk.Dec with the value of d, rounding the digits
+// beyond the precision of an sdk.Dec up.
+func (d BigDec) SDKDecRoundUp() sdk.Dec {
+	quo, rem := new(big.Int).QuoRem(d.i, precisionDifferenceMultiplier, new(big.Int))
+	if rem.Sign() == 1 {
+		quo.Add(quo, oneInt)
+	}
+	return sdk.NewDecFromBigIntWithPrec(quo, sdk.Precision)
+}
@@ -17,6 +17,11 @@ type OsmosisMsg struct {
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
 	/// Swap over one or more pools
 	Swap *SwapMsg `json:"swap,omitempty"`
+	/// Swap an exact amount in through a pool, receiving the tokens out before
+	/// paying the tokens in. The contract is called back with a
+	/// `FlashSwapCallback` sudo message once it holds the tokens out, and must
+	/// hold the tokens in when the callback returns, or the message fails.
+	FlashSwap *FlashSwapMsg `json:"flash_swap,omitempty"`
 }
 
 /// CreateDenom creates a new factory denom, of denomination:
@@ -54,3 +59,24 @@ type SwapMsg struct {
 	Route  []Step              `json:"route"`
 	Amount SwapAmountWithLimit `json:"amount"`
 }
+
+type FlashSwapMsg struct {
+	First  Swap    `json:"first"`
+	Amount ExactIn `json:"amount"`
+	// Data is passed back to the contract in the callback.
+	Data []byte `json:"data,omitempty"`
+}
+
+/// SudoMsg contains the sudo messages the osmosis bindings call contracts with.
+type SudoMsg struct {
+	FlashSwapCallback *FlashSwapCallback `json:"flash_swap_callback,omitempty"`
+}
+
+/// FlashSwapCallback is sent to a contract once it received the tokens out of
+/// a flash swap. The contract must hold TokenIn when it returns.
+type FlashSwapCallback struct {
+	PoolId   uint64   `json:"pool_id"`
+	TokenIn  sdk.Coin `json:"token_in"`
+	TokenOut sdk.Coin `json:"token_out"`
+	Data     []byte   `json:"data,omitempty"`
+}
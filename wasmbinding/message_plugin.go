@@ -17,13 +17,20 @@ import (
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v7/x/tokenfactory/types"
 )
 
-func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+// ContractSudoer calls the sudo entry point of contracts, as the wasm keeper
+// does.
+type ContractSudoer interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, sudoer ContractSudoer) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
 			bank:         bank,
 			gammKeeper:   gammKeeper,
 			tokenFactory: tokenFactory,
+			sudoer:       sudoer,
 		}
 	}
 }
@@ -33,6 +40,7 @@ type CustomMessenger struct {
 	bank         *bankkeeper.BaseKeeper
 	gammKeeper   *gammkeeper.Keeper
 	tokenFactory *tokenfactorykeeper.Keeper
+	sudoer       ContractSudoer
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -60,6 +68,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.Swap != nil {
 			return m.swapTokens(ctx, contractAddr, contractMsg.Swap)
 		}
+		if contractMsg.FlashSwap != nil {
+			return m.flashSwapTokens(ctx, contractAddr, contractMsg.FlashSwap)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -225,7 +236,7 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 			Amount: swap.Amount.ExactIn.Input,
 		}
 		tokenOutMinAmount := swap.Amount.ExactIn.MinOutput
-		tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(ctx, contractAddr, routes, tokenIn, tokenOutMinAmount)
+		tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(ctx, contractAddr, routes, tokenIn, tokenOutMinAmount, gammtypes.SwapGuards{})
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm perform swap exact amount in")
 		}
@@ -251,7 +262,7 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 			Denom:  output,
 			Amount: swap.Amount.ExactOut.Output,
 		}
-		tokenInAmount, err := keeper.MultihopSwapExactAmountOut(ctx, contractAddr, routes, tokenInMaxAmount, tokenOut)
+		tokenInAmount, err := keeper.MultihopSwapExactAmountOut(ctx, contractAddr, routes, tokenInMaxAmount, tokenOut, gammtypes.SwapGuards{})
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm perform swap exact amount out")
 		}
@@ -261,6 +272,50 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 	}
 }
 
+func (m *CustomMessenger) flashSwapTokens(ctx sdk.Context, contractAddr sdk.AccAddress, flashSwap *bindings.FlashSwapMsg) ([]sdk.Event, [][]byte, error) {
+	_, err := PerformFlashSwap(m.gammKeeper, m.sudoer, ctx, contractAddr, flashSwap)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform flash swap")
+	}
+	return nil, nil, nil
+}
+
+// PerformFlashSwap swaps an exact amount in through a pool, sending the tokens
+// out to the contract and calling it back with a FlashSwapCallback sudo
+// message before taking the tokens in from it.
+func PerformFlashSwap(keeper *gammkeeper.Keeper, sudoer ContractSudoer, ctx sdk.Context, contractAddr sdk.AccAddress, flashSwap *bindings.FlashSwapMsg) (*bindings.SwapAmount, error) {
+	if flashSwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm perform flash swap null flash swap"}
+	}
+	if !flashSwap.Amount.Input.IsPositive() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm perform flash swap non-positive amount in"}
+	}
+
+	tokenIn := sdk.Coin{
+		Denom:  flashSwap.First.DenomIn,
+		Amount: flashSwap.Amount.Input,
+	}
+	callback := func(ctx sdk.Context, tokenOut sdk.Coin) error {
+		msg, err := json.Marshal(bindings.SudoMsg{FlashSwapCallback: &bindings.FlashSwapCallback{
+			PoolId:   flashSwap.First.PoolId,
+			TokenIn:  tokenIn,
+			TokenOut: tokenOut,
+			Data:     flashSwap.Data,
+		}})
+		if err != nil {
+			return sdkerrors.Wrap(err, "flash swap callback msg")
+		}
+		_, err = sudoer.Sudo(ctx, contractAddr, msg)
+		return err
+	}
+
+	tokenOutAmount, err := keeper.FlashSwapExactAmountIn(ctx, contractAddr, flashSwap.First.PoolId, tokenIn, flashSwap.First.DenomOut, flashSwap.Amount.MinOutput, callback)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm perform flash swap exact amount in")
+	}
+	return &bindings.SwapAmount{Out: &tokenOutAmount}, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -1,12 +1,14 @@
 package wasmbinding
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v7/app"
 	"github.com/osmosis-labs/osmosis/v7/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v7/wasmbinding/bindings"
 	"github.com/osmosis-labs/osmosis/v7/x/tokenfactory/types"
@@ -829,3 +831,108 @@ func TestSwapMultiHop(t *testing.T) {
 		})
 	}
 }
+
+// flashSwapSudoer stands in for a contract receiving flash swap callbacks,
+// paying the tokens in back with funds it is given if pay is set.
+type flashSwapSudoer struct {
+	t         *testing.T
+	osmosis   *app.OsmosisApp
+	pay       bool
+	callbacks []bindings.FlashSwapCallback
+}
+
+func (s *flashSwapSudoer) Sudo(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg bindings.SudoMsg
+	require.NoError(s.t, json.Unmarshal(msg, &sudoMsg))
+	require.NotNil(s.t, sudoMsg.FlashSwapCallback)
+	s.callbacks = append(s.callbacks, *sudoMsg.FlashSwapCallback)
+
+	// the contract holds the tokens out during the callback
+	balance := s.osmosis.BankKeeper.GetBalance(ctx, contractAddr, sudoMsg.FlashSwapCallback.TokenOut.Denom)
+	require.True(s.t, balance.IsGTE(sudoMsg.FlashSwapCallback.TokenOut))
+	if s.pay {
+		fundAccount(s.t, ctx, s.osmosis, contractAddr, sdk.NewCoins(sudoMsg.FlashSwapCallback.TokenIn))
+	}
+	return nil, nil
+}
+
+func TestFlashSwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12_000_000),
+		sdk.NewInt64Coin("ustar", 240_000_000),
+	}
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+
+	flashSwap := &bindings.FlashSwapMsg{
+		First: bindings.Swap{
+			PoolId:   starPool,
+			DenomIn:  "uosmo",
+			DenomOut: "ustar",
+		},
+		Amount: bindings.ExactIn{
+			Input:     sdk.NewInt(10000),
+			MinOutput: sdk.OneInt(),
+		},
+		Data: []byte("liquidation"),
+	}
+
+	specs := map[string]struct {
+		flashSwap *bindings.FlashSwapMsg
+		pay       bool
+		expErr    bool
+	}{
+		"paid back": {
+			flashSwap: flashSwap,
+			pay:       true,
+		},
+		"not paid back": {
+			flashSwap: flashSwap,
+			pay:       false,
+			expErr:    true,
+		},
+		"zero amount in": {
+			flashSwap: &bindings.FlashSwapMsg{
+				First:  flashSwap.First,
+				Amount: bindings.ExactIn{Input: sdk.ZeroInt(), MinOutput: sdk.OneInt()},
+			},
+			pay:    true,
+			expErr: true,
+		},
+		"null flash swap": {
+			pay:    true,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			contract := RandomAccountAddress()
+			sudoer := &flashSwapSudoer{t: t, osmosis: osmosis, pay: spec.pay}
+			subCtx, _ := ctx.CacheContext()
+
+			// when
+			gotAmount, gotErr := wasmbinding.PerformFlashSwap(osmosis.GAMMKeeper, sudoer, subCtx, contract, spec.flashSwap)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, sudoer.callbacks, 1)
+			callback := sudoer.callbacks[0]
+			require.Equal(t, starPool, callback.PoolId)
+			require.Equal(t, sdk.NewInt64Coin("uosmo", 10000).String(), callback.TokenIn.String())
+			require.Equal(t, sdk.NewCoin("ustar", *gotAmount.Out).String(), callback.TokenOut.String())
+			require.Equal(t, []byte("liquidation"), callback.Data)
+
+			// the contract keeps the tokens out, having paid the tokens in
+			balances := osmosis.BankKeeper.GetAllBalances(subCtx, contract)
+			require.Equal(t, sdk.NewCoins(callback.TokenOut).String(), balances.String())
+		})
+	}
+}
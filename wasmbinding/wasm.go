@@ -13,6 +13,7 @@ func RegisterCustomPlugins(
 	gammKeeper *gammkeeper.Keeper,
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	sudoer ContractSudoer,
 ) []wasmkeeper.Option {
 	wasmQueryPlugin := NewQueryPlugin(gammKeeper, tokenFactory)
 
@@ -20,7 +21,7 @@ func RegisterCustomPlugins(
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(gammKeeper, bank, tokenFactory),
+		CustomMessageDecorator(gammKeeper, bank, tokenFactory, sudoer),
 	)
 
 	return []wasm.Option{
@@ -37,6 +37,34 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to sdk.Dec.
+	FlagSwapFee = "swap-fee"
+	// Will be parsed to sdk.Dec.
+	FlagExitFee = "exit-fee"
+	// Will be parsed to []sdk.DecCoin.
+	FlagTargetPoolWeights = "target-pool-weights"
+	// Will be parsed to time.Duration.
+	FlagWeightChangeDuration = "weight-change-duration"
+	// Will be parsed to time.Time.
+	FlagWeightChangeStartTime = "weight-change-start-time"
+
+	// Will be parsed to bool.
+	FlagSwaps = "swaps"
+	// Will be parsed to bool.
+	FlagJoins = "joins"
+	// Will be parsed to bool.
+	FlagExits = "exits"
+
+	// Will be parsed to time.Duration.
+	FlagLockDuration = "lock-duration"
+	// Will be parsed to []uint64.
+	FlagLockIds = "lock-ids"
+
+	// Will be parsed to time.Time.
+	FlagDeadline = "deadline"
+	// Will be parsed to sdk.Dec.
+	FlagMaxPriceImpact = "max-price-impact"
 )
 
 type createPoolInputs struct {
@@ -70,6 +98,14 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSwapGuards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDeadline, "", "The latest block time the swap can happen at, in RFC3339 format (optional)")
+	fs.String(FlagMaxPriceImpact, "", "The largest fraction the swap may move the spot price of each pool by, e.g. 0.05 (optional)")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -97,6 +133,32 @@ func FlagSetExitPool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetJoinPoolAndLock() *flag.FlagSet {
+	fs := FlagSetJoinPool()
+
+	fs.Duration(FlagLockDuration, 0, "The duration to lock the Gamm tokens received for")
+
+	return fs
+}
+
+func FlagSetExitLockedPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of pool")
+	fs.UintSlice(FlagLockIds, []uint{}, "The ids of the matured locks of Gamm tokens to exit the pool with")
+	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum amount of each denom to receive from the pool")
+
+	return fs
+}
+
+func FlagSetBatchSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of the pool to swap through at the end of the block")
+
+	return fs
+}
+
 func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -104,3 +166,35 @@ func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 
 	return fs
 }
+
+func FlagSetUpdateBalancerPoolParams() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSwapFee, "", "New swap fee of the pool")
+	fs.String(FlagExitFee, "", "New exit fee of the pool")
+	fs.String(FlagTargetPoolWeights, "", "Weights the pool changes to, e.g. 4uatom,6uosmo")
+	fs.String(FlagWeightChangeDuration, "", "Duration of the weight change, e.g. 168h")
+	fs.String(FlagWeightChangeStartTime, "", "Start time of the weight change in RFC3339 (defaults to the block time)")
+
+	return fs
+}
+
+func FlagSetPausePool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagSwaps, false, "Pause the swaps of the pool")
+	fs.Bool(FlagJoins, false, "Pause the joins of the pool")
+	fs.Bool(FlagExits, false, "Pause the exits of the pool")
+
+	return fs
+}
+
+func FlagSetPoolPauseStatus() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagSwaps, false, "Whether the swaps of the pool are paused")
+	fs.Bool(FlagJoins, false, "Whether the joins of the pool are paused")
+	fs.Bool(FlagExits, false, "Whether the exits of the pool are paused")
+
+	return fs
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
@@ -37,6 +38,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalLiquidity(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateBestRoute(),
+		GetCmdPoolVolume(),
+		GetCmdPoolFeesCollected(),
+		GetCmdTakerFeesCollected(),
+		GetCmdPoolPauseStatus(),
+		GetCmdPoolsWithDenoms(),
+		GetCmdPoolsByType(),
+		GetCmdPoolsByMinLiquidity(),
 	)
 
 	return cmd
@@ -503,3 +512,346 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 
 	return cmd
 }
+
+// GetCmdEstimateBestRoute returns the best route to swap a token for another denom.
+func GetCmdEstimateBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-best-route <tokenIn> <tokenOutDenom> <maxHops>",
+		Short: "Query the swap route with the highest amount out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the multihop swap route, of at most maxHops pools, with the highest amount out.
+Example:
+$ %s query gamm estimate-best-route 1000stake uosmo 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxHops, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateBestRoute(cmd.Context(), &types.QueryEstimateBestRouteRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolVolume returns the cumulative swap volume of a pool.
+func GetCmdPoolVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-volume <poolID>",
+		Short: "Query the cumulative swap volume of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative swap volume of a pool, per denom.
+Example:
+$ %s query gamm pool-volume 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolVolume(cmd.Context(), &types.QueryPoolVolumeRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolFeesCollected returns the cumulative swap fees collected of a pool.
+func GetCmdPoolFeesCollected() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fees-collected <poolID>",
+		Short: "Query the cumulative swap fees collected by a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative swap fees collected by a pool, per denom.
+Example:
+$ %s query gamm pool-fees-collected 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolFeesCollected(cmd.Context(), &types.QueryPoolFeesCollectedRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTakerFeesCollected returns the taker fees collected from all swaps.
+func GetCmdTakerFeesCollected() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taker-fees-collected",
+		Short: "Query the taker fees collected from all swaps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the taker fees collected from all swaps.
+Example:
+$ %s query gamm taker-fees-collected
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TakerFeesCollected(cmd.Context(), &types.QueryTakerFeesCollectedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolPauseStatus returns which operations of a pool are paused.
+func GetCmdPoolPauseStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-pause-status <poolID>",
+		Short: "Query which operations of a pool are paused",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the swaps, joins and exits of a pool are paused.
+Example:
+$ %s query gamm pool-pause-status 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolPauseStatus(cmd.Context(), &types.QueryPoolPauseStatusRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolsWithDenoms returns the pools containing all of the given denoms.
+func GetCmdPoolsWithDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools-with-denoms <denom> [denom]...",
+		Short: "Query pools containing all of the given denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pools containing all of the given denoms.
+Example:
+$ %s query gamm pools-with-denoms uosmo uatom
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolsWithDenoms(cmd.Context(), &types.QueryPoolsWithDenomsRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools-with-denoms")
+
+	return cmd
+}
+
+// GetCmdPoolsByType returns the pools of a pool type.
+func GetCmdPoolsByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools-by-type <poolType>",
+		Short: "Query pools of a pool type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pools of a pool type, which is one of %s, %s or %s.
+Example:
+$ %s query gamm pools-by-type %s
+`,
+				types.PoolTypeBalancer, types.PoolTypeStableswap, types.PoolTypeConcentrated,
+				version.AppName, types.PoolTypeStableswap,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolsByType(cmd.Context(), &types.QueryPoolsByTypeRequest{
+				PoolType:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools-by-type")
+
+	return cmd
+}
+
+// GetCmdPoolsByMinLiquidity returns the pools with at least the given liquidity.
+func GetCmdPoolsByMinLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools-by-min-liquidity <minLiquidity>",
+		Short: "Query pools with at least the given liquidity",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pools holding at least the given amount of each of the given denoms.
+Example:
+$ %s query gamm pools-by-min-liquidity 1000000uosmo,1000000uatom
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			minLiquidity, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolsByMinLiquidity(cmd.Context(), &types.QueryPoolsByMinLiquidityRequest{
+				MinLiquidity: minLiquidity,
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools-by-min-liquidity")
+
+	return cmd
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -37,6 +39,12 @@ func NewTxCmd() *cobra.Command {
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewMigrateSharesCmd(),
+		NewUpdateBalancerPoolParamsCmd(),
+		NewPausePoolCmd(),
+		NewJoinPoolAndLockCmd(),
+		NewExitLockedPoolCmd(),
+		NewBatchSwapCmd(),
 	)
 
 	return txCmd
@@ -146,6 +154,100 @@ func NewExitPoolCmd() *cobra.Command {
 	return cmd
 }
 
+func NewJoinPoolAndLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-pool-and-lock",
+		Short: "join a pool and lock the Gamm tokens received",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildJoinPoolAndLockMsg(clientCtx, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetJoinPoolAndLock())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagShareAmountOut)
+	_ = cmd.MarkFlagRequired(FlagMaxAmountsIn)
+	_ = cmd.MarkFlagRequired(FlagLockDuration)
+
+	return cmd
+}
+
+func NewExitLockedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-locked-pool",
+		Short: "exit a pool with the Gamm tokens of unlocking locks, withdrawing them early",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildExitLockedPoolMsg(clientCtx, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetExitLockedPool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagLockIds)
+
+	return cmd
+}
+
+func NewBatchSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-swap [token-in] [token-out-denom] [token-out-min-amount]",
+		Short: "queue a swap, cleared with the other swaps of the pool at the end of the block",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildBatchSwapMsg(clientCtx, args[0], args[1], args[2], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetBatchSwap())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+
+	return cmd
+}
+
 func NewSwapExactAmountInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap-exact-amount-in [token-in] [token-out-min-amount]",
@@ -169,6 +271,7 @@ func NewSwapExactAmountInCmd() *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
+	cmd.Flags().AddFlagSet(FlagSetSwapGuards())
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
 	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
@@ -199,6 +302,7 @@ func NewSwapExactAmountOutCmd() *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(FlagSetSwapAmountOutRoutes())
+	cmd.Flags().AddFlagSet(FlagSetSwapGuards())
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
 	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
@@ -322,6 +426,141 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
+func NewMigrateSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-shares [from-pool-id] [to-pool-id] [share-in-amount] [share-out-min-amount]",
+		Short: "exit a pool and join another pool with the withdrawn liquidity",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildMigrateSharesMsg(clientCtx, args[0], args[1], args[2], args[3], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateBalancerPoolParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-balancer-pool-params [pool-id]",
+		Short:   "update the fees of a balancer pool and schedule a weight change, as its governor",
+		Example: `update-balancer-pool-params 1 --swap-fee=0.003 --target-pool-weights=4uatom,6uosmo --weight-change-duration=168h`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildUpdateBalancerPoolParamsMsg(clientCtx, args[0], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetUpdateBalancerPoolParams())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewPausePoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-pool [pool-id]",
+		Short:   "pause the swaps, joins or exits of a pool, as the pause guardian",
+		Example: `pause-pool 1 --swaps --joins --exits`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildPausePoolMsg(clientCtx, args[0], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetPausePool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitSetPoolPauseStatusProposal implements a command handler for submitting a pool pause status proposal transaction.
+func NewCmdSubmitSetPoolPauseStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-pool-pause-status-proposal [pool-id] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal setting which operations of a pool are paused",
+		Long:    "Submit a proposal setting which operations of a pool are paused. Operations whose flag isn't set are unpaused.",
+		Example: `set-pool-pause-status-proposal 1 --swaps --joins --title="Pause pool 1" --description="..." --deposit=10000000uosmo`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseSetPoolPauseStatusArgsToContent(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetPoolPauseStatus())
+
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	pool, err := parseCreatePoolFlags(fs)
 	if err != nil {
@@ -501,6 +740,126 @@ func NewBuildExitPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Flag
 	return txf, msg, nil
 }
 
+func NewBuildJoinPoolAndLockMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	txf, joinPoolMsg, err := NewBuildJoinPoolMsg(clientCtx, txf, fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	duration, err := fs.GetDuration(FlagLockDuration)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	joinPool := joinPoolMsg.(*types.MsgJoinPool)
+	msg := &types.MsgJoinPoolAndLock{
+		Sender:         joinPool.Sender,
+		PoolId:         joinPool.PoolId,
+		ShareOutAmount: joinPool.ShareOutAmount,
+		TokenInMaxs:    joinPool.TokenInMaxs,
+		Duration:       duration,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildExitLockedPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	lockIdUints, err := fs.GetUintSlice(FlagLockIds)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	lockIds := make([]uint64, 0, len(lockIdUints))
+	for _, lockId := range lockIdUints {
+		lockIds = append(lockIds, uint64(lockId))
+	}
+
+	minAmountsOutStrs, err := fs.GetStringArray(FlagMinAmountsOut)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	minAmountsOut := sdk.Coins{}
+	for i := 0; i < len(minAmountsOutStrs); i++ {
+		parsed, err := sdk.ParseCoinsNormalized(minAmountsOutStrs[i])
+		if err != nil {
+			return txf, nil, err
+		}
+		minAmountsOut = minAmountsOut.Add(parsed...)
+	}
+
+	msg := &types.MsgExitLockedPool{
+		Sender:       clientCtx.GetFromAddress().String(),
+		PoolId:       poolId,
+		LockIds:      lockIds,
+		TokenOutMins: minAmountsOut,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildBatchSwapMsg(clientCtx client.Context, tokenInStr, tokenOutDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token out min amount")
+	}
+
+	msg := &types.MsgBatchSwap{
+		Sender:            clientCtx.GetFromAddress().String(),
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		TokenOutDenom:     tokenOutDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
+func swapGuards(fs *flag.FlagSet) (types.SwapGuards, error) {
+	guards := types.SwapGuards{}
+
+	deadlineStr, err := fs.GetString(FlagDeadline)
+	if err != nil {
+		return guards, err
+	}
+	if deadlineStr != "" {
+		deadline, err := time.Parse(time.RFC3339, deadlineStr)
+		if err != nil {
+			return guards, err
+		}
+		guards.Deadline = &deadline
+	}
+
+	maxPriceImpactStr, err := fs.GetString(FlagMaxPriceImpact)
+	if err != nil {
+		return guards, err
+	}
+	if maxPriceImpactStr != "" {
+		maxPriceImpact, err := sdk.NewDecFromStr(maxPriceImpactStr)
+		if err != nil {
+			return guards, err
+		}
+		guards.MaxPriceImpact = &maxPriceImpact
+	}
+
+	return guards, nil
+}
+
 func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
 	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
 	if err != nil {
@@ -574,11 +933,19 @@ func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOut
 	if !ok {
 		return txf, nil, errors.New("invalid token out min amount")
 	}
+
+	guards, err := swapGuards(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	msg := &types.MsgSwapExactAmountIn{
 		Sender:            clientCtx.GetFromAddress().String(),
 		Routes:            routes,
 		TokenIn:           tokenIn,
 		TokenOutMinAmount: tokenOutMinAmt,
+		Deadline:          guards.Deadline,
+		MaxPriceImpact:    guards.MaxPriceImpact,
 	}
 
 	return txf, msg, nil
@@ -599,11 +966,19 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenI
 	if !ok {
 		return txf, nil, errors.New("invalid token in max amount")
 	}
+
+	guards, err := swapGuards(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	msg := &types.MsgSwapExactAmountOut{
 		Sender:           clientCtx.GetFromAddress().String(),
 		Routes:           routes,
 		TokenInMaxAmount: tokenInMaxAmount,
 		TokenOut:         tokenOut,
+		Deadline:         guards.Deadline,
+		MaxPriceImpact:   guards.MaxPriceImpact,
 	}
 
 	return txf, msg, nil
@@ -713,3 +1088,193 @@ func NewBuildExitSwapShareAmountInMsg(clientCtx client.Context, tokenOutDenom, s
 
 	return txf, msg, nil
 }
+
+func NewBuildMigrateSharesMsg(clientCtx client.Context, fromPoolIdStr, toPoolIdStr, shareInAmtStr, shareOutMinAmtStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	fromPoolId, err := strconv.ParseUint(fromPoolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	toPoolId, err := strconv.ParseUint(toPoolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareInAmount, ok := sdk.NewIntFromString(shareInAmtStr)
+	if !ok {
+		return txf, nil, errors.New("invalid share in amount")
+	}
+
+	shareOutMinAmount, ok := sdk.NewIntFromString(shareOutMinAmtStr)
+	if !ok {
+		return txf, nil, errors.New("invalid share out min amount")
+	}
+
+	msg := &types.MsgMigrateShares{
+		Sender:            clientCtx.GetFromAddress().String(),
+		FromPoolId:        fromPoolId,
+		ToPoolId:          toPoolId,
+		ShareInAmount:     shareInAmount,
+		ShareOutMinAmount: shareOutMinAmount,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildUpdateBalancerPoolParamsMsg(clientCtx client.Context, poolIdStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &balancer.MsgUpdateBalancerPoolParams{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolID: poolId,
+	}
+
+	swapFeeStr, err := fs.GetString(FlagSwapFee)
+	if err != nil {
+		return txf, nil, err
+	}
+	if swapFeeStr != "" {
+		swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+		if err != nil {
+			return txf, nil, err
+		}
+		msg.SwapFee = &swapFee
+	}
+
+	exitFeeStr, err := fs.GetString(FlagExitFee)
+	if err != nil {
+		return txf, nil, err
+	}
+	if exitFeeStr != "" {
+		exitFee, err := sdk.NewDecFromStr(exitFeeStr)
+		if err != nil {
+			return txf, nil, err
+		}
+		msg.ExitFee = &exitFee
+	}
+
+	targetPoolWeightsStr, err := fs.GetString(FlagTargetPoolWeights)
+	if err != nil {
+		return txf, nil, err
+	}
+	if targetPoolWeightsStr == "" {
+		return txf, msg, nil
+	}
+
+	targetPoolAssetCoins, err := sdk.ParseDecCoins(targetPoolWeightsStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	var targetPoolAssets []balancer.PoolAsset
+	for _, coin := range targetPoolAssetCoins {
+		targetPoolAssets = append(targetPoolAssets, balancer.PoolAsset{
+			Weight: coin.Amount.RoundInt(),
+			Token:  sdk.NewCoin(coin.Denom, sdk.ZeroInt()),
+		})
+	}
+
+	durationStr, err := fs.GetString(FlagWeightChangeDuration)
+	if err != nil {
+		return txf, nil, err
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return txf, nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	smoothWeightParams := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolAssets,
+	}
+
+	startTimeStr, err := fs.GetString(FlagWeightChangeStartTime)
+	if err != nil {
+		return txf, nil, err
+	}
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return txf, nil, fmt.Errorf("could not parse time: %w", err)
+		}
+
+		smoothWeightParams.StartTime = startTime
+	}
+
+	msg.SmoothWeightChangeParams = &smoothWeightParams
+
+	return txf, msg, nil
+}
+
+func NewBuildPausePoolMsg(clientCtx client.Context, poolIdStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	status, err := parsePoolPauseStatusFlags(poolId, fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgPausePool{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PoolId:     poolId,
+		PauseSwaps: status.SwapsPaused,
+		PauseJoins: status.JoinsPaused,
+		PauseExits: status.ExitsPaused,
+	}
+
+	return txf, msg, nil
+}
+
+func parseSetPoolPauseStatusArgsToContent(cmd *cobra.Command, poolIdStr string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := parsePoolPauseStatusFlags(poolId, cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewSetPoolPauseStatusProposal(title, description, status), nil
+}
+
+func parsePoolPauseStatusFlags(poolId uint64, fs *flag.FlagSet) (types.PoolPauseStatus, error) {
+	swaps, err := fs.GetBool(FlagSwaps)
+	if err != nil {
+		return types.PoolPauseStatus{}, err
+	}
+
+	joins, err := fs.GetBool(FlagJoins)
+	if err != nil {
+		return types.PoolPauseStatus{}, err
+	}
+
+	exits, err := fs.GetBool(FlagExits)
+	if err != nil {
+		return types.PoolPauseStatus{}, err
+	}
+
+	return types.PoolPauseStatus{
+		PoolId:      poolId,
+		SwapsPaused: swaps,
+		JoinsPaused: joins,
+		ExitsPaused: exits,
+	}, nil
+}
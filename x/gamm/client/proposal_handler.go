@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/client/cli"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var SetPoolPauseStatusProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolPauseStatusProposal, rest.ProposalSetPoolPauseStatusRESTHandler)
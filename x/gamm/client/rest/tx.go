@@ -0,0 +1,20 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+func ProposalSetPoolPauseStatusRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-pool-pause-status",
+		Handler:  newSetPoolPauseStatusHandler(clientCtx),
+	}
+}
+
+func newSetPoolPauseStatusHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
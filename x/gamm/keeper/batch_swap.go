@@ -0,0 +1,431 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// QueueBatchSwap queues a swap of tokenIn for tokens denominated via
+// tokenOutDenom through a pool, to be cleared with the other orders of the
+// pool at the end of the block by ClearBatchSwaps. tokenIn is escrowed in the
+// gamm module account until then. The id of the order is returned.
+// The BeforeSwap hook is called with the sender of the order, and can reject
+// it. As the tokens out of the order are only known once it is cleared, the
+// hook is passed its min amount out.
+func (k Keeper) QueueBatchSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+) (uint64, error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return 0, errors.New("cannot trade same denomination in and out")
+	}
+
+	if _, err := k.getPoolForSwap(ctx, poolId); err != nil {
+		return 0, err
+	}
+	for _, denom := range []string{tokenIn.Denom, tokenOutDenom} {
+		if !k.isPoolIndexedWithDenom(ctx, poolId, denom) {
+			return 0, sdkerrors.Wrapf(types.ErrDenomNotInPool, "%s is not an asset of pool %d", denom, poolId)
+		}
+	}
+
+	err := k.hooks.BeforeSwap(ctx, sender, poolId, sdk.Coins{tokenIn}, sdk.Coins{sdk.NewCoin(tokenOutDenom, tokenOutMinAmount)})
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{tokenIn})
+	if err != nil {
+		return 0, err
+	}
+
+	order := types.BatchSwapOrder{
+		Id:                k.getNextBatchSwapOrderIdAndIncrement(ctx),
+		Sender:            sender.String(),
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		TokenOutDenom:     tokenOutDenom,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+	k.setBatchSwapOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtBatchSwapQueued,
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+	))
+	return order.Id, nil
+}
+
+// ClearBatchSwaps clears the batch swap orders queued during the block. The
+// orders of each pool and denom pair are cleared together. The orders in both
+// directions are first matched against each other at the spot price of the
+// pool, and only the excess of one direction is swapped against the pool.
+// Both the matched tokens and the excess pay the swap fee of the pool, so that
+// moving the spot price within the block isn't a cheaper way to trade against
+// the orders than swapping against the pool. Each order then gets its share
+// of the tokens out of its direction, pro rata to its tokens in, so all orders
+// in a direction get the same price.
+// Orders that would get less than their min amount out are refunded, and the
+// clearing is computed again without them. If clearing fails, all the orders
+// of the pool and denom pair are refunded.
+func (k Keeper) ClearBatchSwaps(ctx sdk.Context) {
+	orders := k.getAllBatchSwapOrders(ctx)
+	for _, order := range orders {
+		k.deleteBatchSwapOrder(ctx, order)
+	}
+
+	for _, pairOrders := range groupBatchSwapOrdersByPair(orders) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			if err := k.clearBatchSwapPair(cacheCtx, pairOrders); err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			return nil
+		})
+		if err != nil {
+			for _, order := range pairOrders {
+				k.refundBatchSwapOrder(ctx, order)
+			}
+		}
+	}
+}
+
+// batchSwapClearing is how the batch swap orders of a pool and denom pair
+// are cleared.
+type batchSwapClearing struct {
+	// tokensIn are the tokens in of the orders once the taker fee is taken
+	// out, by denom.
+	tokensIn sdk.Coins
+	// tokensOut are the tokens paid to the orders, by denom.
+	tokensOut sdk.Coins
+	// poolTokenIn is the excess of tokens in swapped against the pool, for
+	// poolTokenOut. Both are zero if nothing is swapped against the pool.
+	poolTokenIn  sdk.Coin
+	poolTokenOut sdk.Coin
+	// matchedSwapFees is the swap fee taken out of the matched tokens, which
+	// is added to the pool's liquidity.
+	matchedSwapFees sdk.Coins
+}
+
+// tokenOut returns the tokens paid to an order. tokenIn is the tokens in of
+// the order once the taker fee is taken out.
+func (c batchSwapClearing) tokenOut(tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin {
+	amount := tokenIn.Amount.Mul(c.tokensOut.AmountOf(tokenOutDenom)).Quo(c.tokensIn.AmountOf(tokenIn.Denom))
+	return sdk.NewCoin(tokenOutDenom, amount)
+}
+
+// clearBatchSwapPair clears the batch swap orders of a pool and denom pair.
+func (k Keeper) clearBatchSwapPair(ctx sdk.Context, orders []types.BatchSwapOrder) error {
+	poolId := orders[0].PoolId
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	denom0, denom1 := sortDenomPair(orders[0].TokenIn.Denom, orders[0].TokenOutDenom)
+	swapFee := pool.GetSwapFee(ctx)
+	params := k.GetParams(ctx)
+
+	var clearing batchSwapClearing
+	for {
+		clearing, err = k.calcBatchSwapClearing(ctx, params, pool, denom0, denom1, swapFee, orders)
+		if err != nil {
+			return err
+		}
+
+		// refund the orders that would get less than their min amount out,
+		// which changes the clearing of the others
+		filledOrders := []types.BatchSwapOrder{}
+		for _, order := range orders {
+			tokenOut := clearing.tokenOut(batchSwapTokenInWithoutTakerFee(params, order), order.TokenOutDenom)
+			if tokenOut.Amount.LT(order.TokenOutMinAmount) {
+				k.refundBatchSwapOrder(ctx, order)
+			} else {
+				filledOrders = append(filledOrders, order)
+			}
+		}
+		if len(filledOrders) == 0 {
+			return nil
+		}
+		if len(filledOrders) == len(orders) {
+			break
+		}
+		orders = filledOrders
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if clearing.poolTokenIn.IsPositive() {
+		circuitBreakerReference, err := k.startCircuitBreakerWindow(ctx, params, pool, denom0, denom1)
+		if err != nil {
+			return err
+		}
+
+		tokenOut, err := k.swapOutAmtGivenIn(ctx, pool, sdk.Coins{clearing.poolTokenIn}, clearing.poolTokenOut.Denom, swapFee)
+		if err != nil {
+			return err
+		}
+		if !tokenOut.IsEqual(clearing.poolTokenOut) {
+			return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "swap out %s differs from its calculation %s", tokenOut, clearing.poolTokenOut)
+		}
+
+		err = k.updatePoolForSwap(ctx, pool, moduleAddr, clearing.poolTokenIn, tokenOut, swapFee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.checkCircuitBreaker(ctx, params, pool, denom0, denom1, circuitBreakerReference); err != nil {
+			return err
+		}
+	}
+
+	// the tokens held for the orders, of which the rounding dust is left over
+	// once they are paid
+	heldCoins := sdk.Coins{}
+	for _, order := range orders {
+		heldCoins = heldCoins.Add(order.TokenIn)
+	}
+	heldCoins = heldCoins.Sub(sdk.NewCoins(clearing.poolTokenIn)).Add(sdk.NewCoins(clearing.poolTokenOut)...)
+
+	// the swap fees of the matched tokens are added to the pool's liquidity,
+	// or left to the community pool for pools whose liquidity can't be
+	// increased without joining them
+	if extendedPool, ok := pool.(types.PoolAmountOutExtension); ok && !clearing.matchedSwapFees.IsZero() {
+		if err := k.addMatchedSwapFeesToPool(ctx, extendedPool, moduleAddr, clearing.matchedSwapFees); err != nil {
+			return err
+		}
+		heldCoins = heldCoins.Sub(clearing.matchedSwapFees)
+	}
+
+	for _, order := range orders {
+		sender, err := sdk.AccAddressFromBech32(order.Sender)
+		if err != nil {
+			return err
+		}
+
+		tokenIn := batchSwapTokenInWithoutTakerFee(params, order)
+		takerFee := order.TokenIn.Sub(tokenIn)
+		if err := k.chargeTakerFee(ctx, params, moduleAddr, takerFee); err != nil {
+			return err
+		}
+
+		tokenOut := clearing.tokenOut(tokenIn, order.TokenOutDenom)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{tokenOut})
+		if err != nil {
+			return err
+		}
+		heldCoins = heldCoins.Sub(sdk.NewCoins(takerFee, tokenOut))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtBatchSwapCleared,
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+		))
+	}
+
+	if heldCoins.IsZero() {
+		return nil
+	}
+	return k.distrKeeper.FundCommunityPool(ctx, heldCoins, moduleAddr)
+}
+
+// calcBatchSwapClearing computes the clearing of the batch swap orders of a
+// pool and denom pair, without changing the pool.
+func (k Keeper) calcBatchSwapClearing(
+	ctx sdk.Context,
+	params types.Params,
+	pool types.PoolI,
+	denom0, denom1 string,
+	swapFee sdk.Dec,
+	orders []types.BatchSwapOrder,
+) (batchSwapClearing, error) {
+	tokensIn := sdk.Coins{}
+	for _, order := range orders {
+		tokensIn = tokensIn.Add(batchSwapTokenInWithoutTakerFee(params, order))
+	}
+	amount0, amount1 := tokensIn.AmountOf(denom0), tokensIn.AmountOf(denom1)
+
+	// spotPrice is the amount of denom0 swapped in per denom1 swapped out,
+	// which is the price denom1 is matched with denom0 at
+	spotPrice, err := pool.SpotPrice(ctx, denom0, denom1)
+	if err != nil {
+		return batchSwapClearing{}, err
+	}
+	if !spotPrice.IsPositive() {
+		return batchSwapClearing{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "spot price of pool %d is not positive", pool.GetId())
+	}
+
+	// the matched amounts are rounded down, so that the excess swapped
+	// against the pool covers the rounding. matchedTokenOut is paid to the
+	// direction matched in full.
+	var matchedTokenOut, poolTokenIn sdk.Coin
+	var poolTokenOutDenom string
+	if amount0.ToDec().GT(amount1.ToDec().Mul(spotPrice)) {
+		// the denom1 in is all matched, with denom0 in
+		matchedTokenOut = sdk.NewCoin(denom0, amount1.ToDec().Mul(spotPrice).TruncateInt())
+		poolTokenIn = sdk.NewCoin(denom0, amount0.Sub(matchedTokenOut.Amount))
+		poolTokenOutDenom = denom1
+	} else {
+		// the denom0 in is all matched, with denom1 in
+		matched := sdk.MinInt(amount0.ToDec().Quo(spotPrice).TruncateInt(), amount1)
+		matchedTokenOut = sdk.NewCoin(denom1, matched)
+		poolTokenIn = sdk.NewCoin(denom1, amount1.Sub(matched))
+		poolTokenOutDenom = denom0
+	}
+
+	poolTokenOut := sdk.NewCoin(poolTokenOutDenom, sdk.ZeroInt())
+	if poolTokenIn.IsPositive() {
+		poolTokenOut, err = k.calcOutAmtGivenIn(ctx, pool, sdk.Coins{poolTokenIn}, poolTokenOutDenom, swapFee)
+		if err != nil {
+			return batchSwapClearing{}, err
+		}
+	}
+	// an excess too small to get tokens out of the pool is left over as
+	// rounding dust
+	if !poolTokenOut.IsPositive() {
+		poolTokenIn = sdk.NewCoin(poolTokenIn.Denom, sdk.ZeroInt())
+		poolTokenOut = sdk.NewCoin(poolTokenOutDenom, sdk.ZeroInt())
+	}
+
+	// the tokens in of the direction matched in full are paid to the other
+	// direction, along with the tokens out of the pool. Both directions pay
+	// the swap fee on the tokens they are matched with, rounded up.
+	otherMatchedTokenOut := sdk.NewCoin(poolTokenOutDenom, tokensIn.AmountOf(poolTokenOutDenom))
+	matchedSwapFees := sdk.NewCoins(
+		sdk.NewCoin(matchedTokenOut.Denom, matchedTokenOut.Amount.ToDec().Mul(swapFee).Ceil().TruncateInt()),
+		sdk.NewCoin(otherMatchedTokenOut.Denom, otherMatchedTokenOut.Amount.ToDec().Mul(swapFee).Ceil().TruncateInt()),
+	)
+	return batchSwapClearing{
+		tokensIn: tokensIn,
+		tokensOut: sdk.NewCoins(
+			matchedTokenOut,
+			otherMatchedTokenOut.Add(poolTokenOut),
+		).Sub(matchedSwapFees),
+		poolTokenIn:     poolTokenIn,
+		poolTokenOut:    poolTokenOut,
+		matchedSwapFees: matchedSwapFees,
+	}, nil
+}
+
+// addMatchedSwapFeesToPool sends the swap fees of the matched batch swaps
+// from the gamm module account to the pool, and adds them to its liquidity
+// without minting shares.
+func (k Keeper) addMatchedSwapFeesToPool(ctx sdk.Context, pool types.PoolAmountOutExtension, moduleAddr sdk.AccAddress, fees sdk.Coins) error {
+	err := k.bankKeeper.SendCoins(ctx, moduleAddr, pool.GetAddress(), fees)
+	if err != nil {
+		return err
+	}
+	pool.IncreaseLiquidity(sdk.ZeroInt(), fees)
+	if err := k.SetPool(ctx, pool); err != nil {
+		return err
+	}
+	k.RecordTotalLiquidityIncrease(ctx, fees)
+	return nil
+}
+
+// batchSwapTokenInWithoutTakerFee returns the tokens in of a batch swap order
+// once the taker fee set in params is taken out.
+func batchSwapTokenInWithoutTakerFee(params types.Params, order types.BatchSwapOrder) sdk.Coin {
+	takerFee := takerFeeOfTokenIn(order.TokenIn, params.GetTakerFee(order.TokenIn.Denom, order.TokenOutDenom))
+	return order.TokenIn.Sub(takerFee)
+}
+
+// refundBatchSwapOrder sends the escrowed tokens in of a batch swap order
+// back to its sender.
+func (k Keeper) refundBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) {
+	sender, err := sdk.AccAddressFromBech32(order.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	// the gamm module account holds the tokens in of all orders queued in
+	// the block, so failing to refund one is a bug
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{order.TokenIn})
+	if err != nil {
+		panic(fmt.Errorf("failed to refund batch swap order %d: %w", order.Id, err))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtBatchSwapRefunded,
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, order.TokenIn.String()),
+	))
+}
+
+// groupBatchSwapOrdersByPair groups batch swap orders by pool and denom pair,
+// regardless of their direction. The groups are in the order of their first
+// order.
+func groupBatchSwapOrdersByPair(orders []types.BatchSwapOrder) [][]types.BatchSwapOrder {
+	groups := [][]types.BatchSwapOrder{}
+	groupIndexes := map[string]int{}
+	for _, order := range orders {
+		denom0, denom1 := sortDenomPair(order.TokenIn.Denom, order.TokenOutDenom)
+		key := fmt.Sprintf("%d|%s|%s", order.PoolId, denom0, denom1)
+		i, ok := groupIndexes[key]
+		if !ok {
+			i = len(groups)
+			groupIndexes[key] = i
+			groups = append(groups, []types.BatchSwapOrder{})
+		}
+		groups[i] = append(groups[i], order)
+	}
+	return groups
+}
+
+// GetAllBatchSwapOrders returns the batch swap orders queued during the
+// block, by pool and then in the order they were queued.
+func (k Keeper) GetAllBatchSwapOrders(ctx sdk.Context) []types.BatchSwapOrder {
+	return k.getAllBatchSwapOrders(ctx)
+}
+
+func (k Keeper) getAllBatchSwapOrders(ctx sdk.Context) []types.BatchSwapOrder {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatchSwapOrders)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := []types.BatchSwapOrder{}
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.BatchSwapOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+func (k Keeper) setBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyBatchSwapOrder(order.PoolId, order.Id), k.cdc.MustMarshal(&order))
+}
+
+func (k Keeper) deleteBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyBatchSwapOrder(order.PoolId, order.Id))
+}
+
+func (k Keeper) getNextBatchSwapOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	orderId := uint64(1)
+	if bz := store.Get(types.KeyNextBatchSwapOrderId); bz != nil {
+		orderId = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeyNextBatchSwapOrderId, sdk.Uint64ToBigEndian(orderId+1))
+	return orderId
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueueBatchSwap() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	sender := suite.TestAccs[0]
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(100))
+
+	_, err := keeper.QueueBatchSwap(suite.Ctx, sender, poolId, tokenIn, "baz", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrDenomNotInPool)
+
+	_, err = keeper.QueueBatchSwap(suite.Ctx, sender, poolId+1, tokenIn, "bar", sdk.OneInt())
+	suite.Require().Error(err)
+
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	orderId, err := keeper.QueueBatchSwap(suite.Ctx, sender, poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	otherOrderId, err := keeper.QueueBatchSwap(suite.Ctx, sender, poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(orderId+1, otherOrderId)
+
+	// the tokens in are escrowed until the end of the block
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	suite.Require().Equal(tokenIn.Amount.MulRaw(2).String(), balanceBefore.Sub(balanceAfter).Amount.String())
+	suite.Require().Len(keeper.GetAllBatchSwapOrders(suite.Ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestClearBatchSwaps() {
+	type order struct {
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount sdk.Int
+	}
+
+	testCases := []struct {
+		name   string
+		orders []order
+		// pauseSwaps pauses the swaps of the pool before the orders are cleared
+		pauseSwaps bool
+		// expectedFilled is whether each order is filled or refunded
+		expectedFilled []bool
+	}{
+		{
+			name: "one direction",
+			orders: []order{
+				{sdk.NewCoin("foo", sdk.NewInt(100)), "bar", sdk.OneInt()},
+				{sdk.NewCoin("foo", sdk.NewInt(300)), "bar", sdk.OneInt()},
+			},
+			expectedFilled: []bool{true, true},
+		},
+		{
+			name: "both directions",
+			orders: []order{
+				{sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt()},
+				{sdk.NewCoin("bar", sdk.NewInt(400)), "foo", sdk.OneInt()},
+				{sdk.NewCoin("bar", sdk.NewInt(200)), "foo", sdk.OneInt()},
+			},
+			expectedFilled: []bool{true, true, true},
+		},
+		{
+			name: "min amount out not met",
+			orders: []order{
+				{sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt()},
+				{sdk.NewCoin("bar", sdk.NewInt(400)), "foo", sdk.NewInt(401)},
+			},
+			expectedFilled: []bool{true, false},
+		},
+		{
+			name: "swaps paused",
+			orders: []order{
+				{sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt()},
+				{sdk.NewCoin("bar", sdk.NewInt(400)), "foo", sdk.OneInt()},
+			},
+			pauseSwaps:     true,
+			expectedFilled: []bool{false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+			senders := make([]sdk.AccAddress, len(tc.orders))
+			for i, o := range tc.orders {
+				senders[i] = sdk.AccAddress([]byte("batch_swap_sender__" + string(rune('a'+i))))
+				suite.FundAcc(senders[i], sdk.NewCoins(o.tokenIn))
+				_, err := keeper.QueueBatchSwap(suite.Ctx, senders[i], poolId, o.tokenIn, o.tokenOutDenom, o.tokenOutMinAmount)
+				suite.Require().NoError(err)
+			}
+
+			if tc.pauseSwaps {
+				keeper.SetPoolPauseStatus(suite.Ctx, types.PoolPauseStatus{PoolId: poolId, SwapsPaused: true})
+			}
+
+			keeper.ClearBatchSwaps(suite.Ctx)
+			suite.Require().Empty(keeper.GetAllBatchSwapOrders(suite.Ctx))
+
+			// nothing is left escrowed
+			moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "foo").IsZero())
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "bar").IsZero())
+
+			// filled orders in the same direction get the same price, which
+			// is no better than the spot price of the pool
+			prices := map[string]sdk.Dec{}
+			for i, o := range tc.orders {
+				balanceIn := suite.App.BankKeeper.GetBalance(suite.Ctx, senders[i], o.tokenIn.Denom)
+				balanceOut := suite.App.BankKeeper.GetBalance(suite.Ctx, senders[i], o.tokenOutDenom)
+				if !tc.expectedFilled[i] {
+					suite.Require().Equal(o.tokenIn.String(), balanceIn.String(), "order %d", i)
+					suite.Require().True(balanceOut.IsZero(), "order %d", i)
+					continue
+				}
+
+				suite.Require().True(balanceIn.IsZero(), "order %d", i)
+				suite.Require().True(balanceOut.Amount.GTE(o.tokenOutMinAmount), "order %d", i)
+				price := balanceOut.Amount.ToDec().Quo(o.tokenIn.Amount.ToDec())
+				if expected, ok := prices[o.tokenOutDenom]; ok {
+					suite.Require().True(price.Sub(expected).Abs().LTE(sdk.NewDecWithPrec(1, 2)), "order %d", i)
+				}
+				prices[o.tokenOutDenom] = price
+				suite.Require().True(price.LTE(sdk.OneDec()), "order %d", i)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClearBatchSwapsMatchesAtSpotPriceWithSwapFee() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	fooSeller := suite.TestAccs[1]
+	barSeller := suite.TestAccs[2]
+	suite.FundAcc(fooSeller, defaultAcctFunds)
+	suite.FundAcc(barSeller, defaultAcctFunds)
+	fooBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, fooSeller)
+	barBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, barSeller)
+
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	// the foo in matched with the 400 bar in at the spot price of 1 is netted
+	// out, and only the excess 600 foo is swapped against the pool
+	excessTokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(600))), "bar", pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	// both directions pay the swap fee on the 400 tokens they are matched with
+	matchedSwapFee := sdk.NewInt(400).ToDec().Mul(defaultSwapFee).Ceil().TruncateInt()
+
+	_, err = keeper.QueueBatchSwap(suite.Ctx, fooSeller, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, err = keeper.QueueBatchSwap(suite.Ctx, barSeller, poolId, sdk.NewCoin("bar", sdk.NewInt(400)), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	keeper.ClearBatchSwaps(suite.Ctx)
+
+	fooSellerGain := suite.App.BankKeeper.GetAllBalances(suite.Ctx, fooSeller).Sub(fooBalanceBefore.Sub(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", excessTokenOut.Amount.AddRaw(400).Sub(matchedSwapFee))).String(), fooSellerGain.String())
+	barSellerGain := suite.App.BankKeeper.GetAllBalances(suite.Ctx, barSeller).Sub(barBalanceBefore.Sub(sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(400)))))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(400).Sub(matchedSwapFee))).String(), barSellerGain.String())
+
+	// the swap fees of the matched tokens are added to the pool's liquidity
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedLiquidity := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(10600).Add(matchedSwapFee)),
+		sdk.NewCoin("bar", sdk.NewInt(10000).Sub(excessTokenOut.Amount).Add(matchedSwapFee)),
+	)
+	suite.Require().Equal(expectedLiquidity.String(), pool.GetTotalPoolLiquidity(suite.Ctx).String())
+	suite.Require().Equal(expectedLiquidity.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()).String())
+	suite.Require().Equal(expectedLiquidity.String(), keeper.GetTotalLiquidity(suite.Ctx).String())
+}
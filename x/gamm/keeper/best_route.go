@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// MaxBestRouteHops is the maximum number of hops EstimateBestRoute searches over.
+// The number of candidate routes grows exponentially with the number of hops.
+const MaxBestRouteHops = 4
+
+// routeCandidate is a partial route, along with what it outputs.
+type routeCandidate struct {
+	routes   []types.SwapAmountInRoute
+	tokenOut sdk.Coin
+	// spotPrice is the chained spot price of the route, in tokens in per token out.
+	spotPrice sdk.Dec
+}
+
+// EstimateBestRoute searches every route of at most maxHops pools swapping
+// tokenIn for tokenOutDenom, and returns the one with the highest amount out.
+// Routes never go through the same denom or pool twice.
+// The price impact is the relative difference between the route's execution price,
+// swap and taker fees included, and its spot price.
+//
+// Amounts are computed with CalcOutAmtGivenIn on pools read from the store,
+// so no state is written.
+func (k Keeper) EstimateBestRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+) (routes []types.SwapAmountInRoute, tokenOutAmount sdk.Int, priceImpact sdk.Dec, err error) {
+	if maxHops == 0 || maxHops > MaxBestRouteHops {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max hops must be in [1, %d], was %d", MaxBestRouteHops, maxHops)
+	}
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenIn.String())
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot swap %s for itself", tokenOutDenom)
+	}
+
+	poolsByDenom, err := k.getActivePoolsByDenom(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	params := k.GetParams(ctx)
+	best := routeCandidate{tokenOut: sdk.NewCoin(tokenOutDenom, sdk.ZeroInt())}
+	visitedDenoms := map[string]bool{tokenIn.Denom: true}
+	visitedPools := map[uint64]bool{}
+	var search func(candidate routeCandidate)
+	search = func(candidate routeCandidate) {
+		if candidate.tokenOut.Denom == tokenOutDenom {
+			// ties are broken in favor of the route found first, i.e. with the lowest pool ids
+			if candidate.tokenOut.Amount.GT(best.tokenOut.Amount) {
+				best = candidate
+			}
+			return
+		}
+		if uint64(len(candidate.routes)) == maxHops {
+			return
+		}
+
+		for _, pool := range poolsByDenom[candidate.tokenOut.Denom] {
+			if visitedPools[pool.GetId()] {
+				continue
+			}
+			for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+				if visitedDenoms[asset.Denom] {
+					continue
+				}
+
+				// pools that can't execute the swap are not part of any route
+				takerFee := takerFeeOfTokenIn(candidate.tokenOut, params.GetTakerFee(candidate.tokenOut.Denom, asset.Denom))
+				tokenOut, err := k.calcOutAmtGivenIn(ctx, pool, sdk.NewCoins(candidate.tokenOut.Sub(takerFee)), asset.Denom, pool.GetSwapFee(ctx))
+				if err != nil || !tokenOut.IsPositive() {
+					continue
+				}
+				spotPrice, err := pool.SpotPrice(ctx, candidate.tokenOut.Denom, asset.Denom)
+				if err != nil || !spotPrice.IsPositive() {
+					continue
+				}
+
+				routes := make([]types.SwapAmountInRoute, len(candidate.routes), len(candidate.routes)+1)
+				copy(routes, candidate.routes)
+				visitedDenoms[asset.Denom], visitedPools[pool.GetId()] = true, true
+				search(routeCandidate{
+					routes:    append(routes, types.SwapAmountInRoute{PoolId: pool.GetId(), TokenOutDenom: asset.Denom}),
+					tokenOut:  tokenOut,
+					spotPrice: candidate.spotPrice.Mul(spotPrice),
+				})
+				visitedDenoms[asset.Denom], visitedPools[pool.GetId()] = false, false
+			}
+		}
+	}
+	search(routeCandidate{tokenOut: tokenIn, spotPrice: sdk.OneDec()})
+
+	if len(best.routes) == 0 {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoRouteFound, "from %s to %s within %d hops", tokenIn, tokenOutDenom, maxHops)
+	}
+
+	// the spot price gives the amount out if the swap had no impact on the pools
+	spotTokenOutAmount := tokenIn.Amount.ToDec().Quo(best.spotPrice)
+	priceImpact = sdk.OneDec().Sub(best.tokenOut.Amount.ToDec().Quo(spotTokenOutAmount))
+	if priceImpact.IsNegative() {
+		// rounding of the spot prices
+		priceImpact = sdk.ZeroDec()
+	}
+
+	return best.routes, best.tokenOut.Amount, priceImpact, nil
+}
+
+// getActivePoolsByDenom returns the pools that swaps can be made against,
+// indexed by the denoms of their assets, in increasing pool id order.
+func (k Keeper) getActivePoolsByDenom(ctx sdk.Context) (map[string][]types.PoolI, error) {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsByDenom := map[string][]types.PoolI{}
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) || k.GetPoolPauseStatus(ctx, pool.GetId()).SwapsPaused {
+			continue
+		}
+		for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+			poolsByDenom[asset.Denom] = append(poolsByDenom[asset.Denom], pool)
+		}
+	}
+	return poolsByDenom, nil
+}
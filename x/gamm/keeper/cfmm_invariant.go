@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var (
+	// cfmmValueTolerance is how much the CFMM value of a pool may decrease
+	// across a swap before it is considered a violation. It accounts for the
+	// error of the power approximation used by balancer pools.
+	cfmmValueTolerance = sdk.NewDecWithPrec(1, 7)
+	// cfmmProbeSwapFraction is the fraction of the pool's reserves of a denom
+	// that the probe swaps of the cfmm-value-per-share invariant swap.
+	cfmmProbeSwapFraction = sdk.NewDecWithPrec(1, 2)
+)
+
+// cfmmValueDecreased returns whether swapping against the pool, with its swap
+// fee, decreases its CFMM value per share by more than cfmmValueTolerance,
+// along with the lowest value ratio found. Every pair of the pool's denoms is
+// swapped, in both directions and both given in and given out, by
+// cfmmProbeSwapFraction of the pool's reserves. The probe swaps are done on
+// copies of the pool read from the store, within a cache context that is
+// never written. Probe swaps that fail are skipped, as the pool rejects them
+// when swapping.
+// It is a no-op for pools that aren't CFMM pools, such as concentrated
+// liquidity pools.
+func (k Keeper) cfmmValueDecreased(ctx sdk.Context, poolId uint64) (bool, sdk.Dec, error) {
+	ctx, _ = ctx.CacheContext()
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return false, sdk.Dec{}, err
+	}
+	if _, ok := pool.(types.CFMMPoolI); !ok {
+		return false, sdk.OneDec(), nil
+	}
+	reserves := pool.GetTotalPoolLiquidity(ctx)
+
+	minRatio := sdk.OneDec()
+	for _, coinIn := range reserves {
+		for _, coinOut := range reserves {
+			if coinIn.Denom == coinOut.Denom {
+				continue
+			}
+
+			tokenIn := sdk.NewCoin(coinIn.Denom, coinIn.Amount.ToDec().Mul(cfmmProbeSwapFraction).TruncateInt())
+			tokenOut := sdk.NewCoin(coinOut.Denom, coinOut.Amount.ToDec().Mul(cfmmProbeSwapFraction).TruncateInt())
+			probes := []func(pool types.PoolI) error{
+				func(pool types.PoolI) error {
+					_, err := k.swapOutAmtGivenIn(ctx, pool, sdk.Coins{tokenIn}, coinOut.Denom, pool.GetSwapFee(ctx))
+					return err
+				},
+				func(pool types.PoolI) error {
+					_, err := k.swapInAmtGivenOut(ctx, pool, sdk.Coins{tokenOut}, coinIn.Denom, pool.GetSwapFee(ctx))
+					return err
+				},
+			}
+
+			for _, probe := range probes {
+				pool, err := k.GetPoolAndPoke(ctx, poolId)
+				if err != nil {
+					return false, sdk.Dec{}, err
+				}
+				if err := probe(pool); err != nil {
+					continue
+				}
+
+				ratio, err := pool.(types.CFMMPoolI).CFMMValueRatio(reserves)
+				if err != nil {
+					return false, sdk.Dec{}, err
+				}
+				if ratio.LT(minRatio) {
+					minRatio = ratio
+				}
+			}
+		}
+	}
+	return minRatio.LT(sdk.OneDec().Sub(cfmmValueTolerance)), minRatio, nil
+}
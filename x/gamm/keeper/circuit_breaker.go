@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// startCircuitBreakerWindow records the spot price of the pool's denomIn and
+// denomOut pair as the circuit breaker reference, unless it was recorded
+// within the current circuit breaker window, and returns the reference.
+// It must be called before the pool is swapped against.
+func (k Keeper) startCircuitBreakerWindow(ctx sdk.Context, params types.Params, pool types.PoolI, denomIn, denomOut string) (types.CircuitBreakerReference, error) {
+	if !params.IsCircuitBreakerEnabled() {
+		return types.CircuitBreakerReference{}, nil
+	}
+
+	denom0, denom1 := sortDenomPair(denomIn, denomOut)
+	reference, found := k.getCircuitBreakerReference(ctx, pool.GetId(), denom0, denom1)
+	if found && ctx.BlockHeight()-reference.Height < int64(params.CircuitBreakerWindowBlocks) {
+		return reference, nil
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, denom0, denom1)
+	if err != nil {
+		return types.CircuitBreakerReference{}, err
+	}
+	reference = types.CircuitBreakerReference{
+		SpotPrice: spotPrice,
+		Height:    ctx.BlockHeight(),
+	}
+	k.setCircuitBreakerReference(ctx, pool.GetId(), denom0, denom1, reference)
+	return reference, nil
+}
+
+// checkCircuitBreaker pauses the swaps, joins and exits of the pool if the
+// spot price of its denomIn and denomOut pair moved by more than the circuit
+// breaker max price change since reference, the start of the circuit breaker
+// window returned by startCircuitBreakerWindow.
+// It must be called after the pool is swapped against. The swap that trips
+// the circuit breaker still goes through, as reverting it would revert the
+// pause as well.
+func (k Keeper) checkCircuitBreaker(ctx sdk.Context, params types.Params, pool types.PoolI, denomIn, denomOut string, reference types.CircuitBreakerReference) error {
+	if !params.IsCircuitBreakerEnabled() {
+		return nil
+	}
+
+	denom0, denom1 := sortDenomPair(denomIn, denomOut)
+	if reference.SpotPrice.IsNil() || !reference.SpotPrice.IsPositive() {
+		return nil
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, denom0, denom1)
+	if err != nil {
+		return err
+	}
+	priceChange := spotPrice.Sub(reference.SpotPrice).Abs().Quo(reference.SpotPrice)
+	if priceChange.LTE(params.CircuitBreakerMaxPriceChange) {
+		return nil
+	}
+
+	k.SetPoolPauseStatus(ctx, types.PoolPauseStatus{
+		PoolId:      pool.GetId(),
+		SwapsPaused: true,
+		JoinsPaused: true,
+		ExitsPaused: true,
+	})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCircuitBreakerTriggered,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyPriceChange, priceChange.String()),
+	))
+	return nil
+}
+
+func (k Keeper) getCircuitBreakerReference(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.CircuitBreakerReference, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyCircuitBreakerReference(poolId, denom0, denom1))
+	if bz == nil {
+		return types.CircuitBreakerReference{}, false
+	}
+
+	var reference types.CircuitBreakerReference
+	k.cdc.MustUnmarshal(bz, &reference)
+	return reference, true
+}
+
+func (k Keeper) setCircuitBreakerReference(ctx sdk.Context, poolId uint64, denom0, denom1 string, reference types.CircuitBreakerReference) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyCircuitBreakerReference(poolId, denom0, denom1), k.cdc.MustMarshal(&reference))
+}
+
+func (k Keeper) deleteCircuitBreakerReferences(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCircuitBreakerReferences(poolId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	// collect the keys first, as deleting while iterating is unsafe
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// sortDenomPair returns the denoms in lexicographic order, so that both swap
+// directions share a circuit breaker reference.
+func sortDenomPair(denomA, denomB string) (string, string) {
+	if denomA > denomB {
+		return denomB, denomA
+	}
+	return denomA, denomB
+}
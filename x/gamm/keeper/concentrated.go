@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// CreateConcentratedPool creates a concentrated liquidity pool, returning the
+// newly created pool ID. The pool creation fee is used to fund the community
+// pool. Unlike CreatePool, no initial liquidity is provided and no LP shares
+// are minted, liquidity is added afterwards by creating positions.
+func (k Keeper) CreateConcentratedPool(ctx sdk.Context, msg concentrated.MsgCreateConcentratedPool) (uint64, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	sender := msg.PoolCreator()
+
+	// send pool creation fee to community pool
+	params := k.GetParams(ctx)
+	if err := k.distrKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return 0, err
+	}
+
+	poolId := k.GetNextPoolNumberAndIncrement(ctx)
+	pool, err := msg.CreatePool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+	if acc := k.accountKeeper.GetAccount(ctx, pool.GetAddress()); acc != nil {
+		return 0, sdkerrors.Wrapf(types.ErrPoolAlreadyExist, "pool %d already exist", poolId)
+	}
+
+	k.createPoolAccount(ctx, pool)
+
+	if err := k.SetPool(ctx, pool); err != nil {
+		return 0, err
+	}
+	k.indexPoolDenoms(ctx, pool.GetId(), poolDenoms(ctx, pool))
+
+	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
+
+	return pool.GetId(), nil
+}
+
+// getConcentratedPool returns the concentrated liquidity pool with the given id.
+func (k Keeper) getConcentratedPool(ctx sdk.Context, poolId uint64) (*concentrated.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	concentratedPool, ok := pool.(*concentrated.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotConcentratedPool, "pool %d is not a concentrated liquidity pool", poolId)
+	}
+	return concentratedPool, nil
+}
+
+// CreatePosition adds as much liquidity as tokensDesired allows to the
+// sender's position over [lowerTick, upperTick), creating it if necessary.
+// The swap fees accrued by an existing position are collected as well.
+// An error is returned if less than the minimum amount of either token would
+// be deposited.
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	tokensDesired sdk.Coins,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (liquidity sdk.Dec, tokensIn sdk.Coins, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	if err := k.ensureJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	for _, coin := range tokensDesired {
+		if coin.Denom != pool.Token0 && coin.Denom != pool.Token1 {
+			return sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s does not exist in pool %d", coin.Denom, poolId)
+		}
+	}
+
+	liquidity, err = pool.GetLiquidityForAmounts(lowerTick, upperTick, tokensDesired.AmountOf(pool.Token0), tokensDesired.AmountOf(pool.Token1))
+	if err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+	if !liquidity.IsPositive() {
+		return sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrNotPositiveLiquidity, "%s is not enough to provide liquidity over [%d, %d)", tokensDesired, lowerTick, upperTick)
+	}
+
+	position, err := k.GetPosition(ctx, poolId, sender, lowerTick, upperTick)
+	if err != nil {
+		position = types.NewPosition(poolId, sender.String(), lowerTick, upperTick)
+	}
+
+	// the ticks are only written once the position is known to be valid
+	cacheCtx, writeTicks := ctx.CacheContext()
+	tokensIn, fees, err := pool.UpdatePosition(k.getTickStore(cacheCtx, poolId), &position, liquidity)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+	if !tokensIn.IsAllLTE(tokensDesired) {
+		return sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "position requires %s, more than the desired %s", tokensIn, tokensDesired)
+	}
+	if tokensIn.AmountOf(pool.Token0).LT(tokenMinAmount0) || tokensIn.AmountOf(pool.Token1).LT(tokenMinAmount1) {
+		return sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"position deposits %s, wanted a minimum of %s%s and %s%s",
+			tokensIn, tokenMinAmount0, pool.Token0, tokenMinAmount1, pool.Token1)
+	}
+
+	if err := k.hooks.BeforeJoinPool(ctx, sender, poolId, tokensIn, sdk.ZeroInt()); err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), tokensIn); err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+	if !fees.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, fees); err != nil {
+			return sdk.Dec{}, sdk.Coins{}, err
+		}
+	}
+
+	writeTicks()
+	k.setPosition(ctx, position)
+	if err := k.SetPool(ctx, pool); err != nil {
+		return sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	k.createAddLiquidityEvent(ctx, sender, poolId, tokensIn)
+	k.hooks.AfterJoinPool(ctx, sender, poolId, tokensIn, sdk.ZeroInt())
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	k.RecordTotalLiquidityDecrease(ctx, fees)
+
+	return liquidity, tokensIn, nil
+}
+
+// WithdrawPosition removes liquidityAmount from the sender's position over
+// [lowerTick, upperTick), and sends the tokens backing it along with the
+// swap fees accrued by the position to the sender.
+// The position is deleted once all of its liquidity has been withdrawn.
+func (k Keeper) WithdrawPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	liquidityAmount sdk.Dec,
+) (tokensOut sdk.Coins, err error) {
+	if !liquidityAmount.IsPositive() {
+		return sdk.Coins{}, types.ErrNotPositiveLiquidity
+	}
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.ensureExitsNotPaused(ctx, poolId); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	position, err := k.GetPosition(ctx, poolId, sender, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// the ticks are only written once the withdrawal is known to be valid
+	cacheCtx, writeTicks := ctx.CacheContext()
+	tokens, fees, err := pool.UpdatePosition(k.getTickStore(cacheCtx, poolId), &position, liquidityAmount.Neg())
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	tokensOut = tokens.Add(fees...)
+
+	if err := k.hooks.BeforeExitPool(ctx, sender, poolId, sdk.ZeroInt(), tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if !tokensOut.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
+	writeTicks()
+	if position.Liquidity.IsZero() {
+		k.deletePosition(ctx, position)
+	} else {
+		k.setPosition(ctx, position)
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	k.createRemoveLiquidityEvent(ctx, sender, poolId, tokensOut)
+	k.hooks.AfterExitPool(ctx, sender, poolId, sdk.ZeroInt(), tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+
+	return tokensOut, nil
+}
+
+// GetPosition returns the position of owner over [lowerTick, upperTick) in
+// the given concentrated liquidity pool.
+func (k Keeper) GetPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPosition(poolId, owner, lowerTick, upperTick))
+	if bz == nil {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrPositionNotFound,
+			"no position of %s over [%d, %d) in pool %d", owner, lowerTick, upperTick, poolId)
+	}
+
+	position := types.Position{}
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, nil
+}
+
+// GetPoolPositions returns all the positions of a concentrated liquidity pool.
+func (k Keeper) GetPoolPositions(ctx sdk.Context, poolId uint64) []types.Position {
+	return k.getPositions(ctx, types.GetKeyPrefixPoolPositions(poolId))
+}
+
+// GetAllPositions returns the positions of every concentrated liquidity pool.
+func (k Keeper) GetAllPositions(ctx sdk.Context) []types.Position {
+	return k.getPositions(ctx, types.KeyPrefixPositions)
+}
+
+func (k Keeper) getPositions(ctx sdk.Context, prefix []byte) []types.Position {
+	iter := k.iterator(ctx, prefix)
+	defer iter.Close()
+
+	positions := []types.Position{}
+	for ; iter.Valid(); iter.Next() {
+		position := types.Position{}
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+func (k Keeper) setPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(positionKey(position), k.cdc.MustMarshal(&position))
+}
+
+func (k Keeper) deletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(positionKey(position))
+}
+
+func positionKey(position types.Position) []byte {
+	return types.GetKeyPosition(position.PoolId, position.GetOwnerAddress(), position.LowerTick, position.UpperTick)
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateConcentratedPool() {
+	suite.SetupTest()
+	sender := suite.TestAccs[0]
+	suite.FundAcc(sender, suite.App.GAMMKeeper.GetParams(suite.Ctx).PoolCreationFee)
+
+	msg := concentrated.NewMsgCreateConcentratedPool(sender, "bar", "foo", 10, sdk.ZeroDec(), sdk.NewDec(4))
+	poolId, err := suite.App.GAMMKeeper.CreateConcentratedPool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().IsType(&concentrated.Pool{}, pool)
+	suite.Require().True(pool.GetTotalShares().IsZero())
+	suite.Require().True(pool.GetTotalPoolLiquidity(suite.Ctx).IsZero())
+	suite.Require().NotNil(suite.App.AccountKeeper.GetAccount(suite.Ctx, pool.GetAddress()))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).IsZero(), "pool creation fee was not charged")
+
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(4), spotPrice)
+
+	// denoms must be sorted
+	msg = concentrated.NewMsgCreateConcentratedPool(sender, "foo", "bar", 10, sdk.ZeroDec(), sdk.NewDec(4))
+	_, err = suite.App.GAMMKeeper.CreateConcentratedPool(suite.Ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateAndWithdrawPosition() {
+	suite.SetupTest()
+	poolId := suite.PrepareConcentratedPool()
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolLiquidity := pool.GetTotalPoolLiquidity(suite.Ctx)
+	suite.Require().Equal(poolLiquidity, suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()))
+	suite.Require().Equal(poolLiquidity, suite.App.GAMMKeeper.GetTotalLiquidity(suite.Ctx))
+
+	lp := suite.TestAccs[1]
+	suite.FundAcc(lp, sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000)))
+
+	// slippage bounds are enforced
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("bar", 500000), sdk.NewInt64Coin("foo", 500000))
+	_, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, lp, poolId, -100, 100, tokensDesired, sdk.NewInt(500001), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+	// ticks must be aligned to the tick spacing
+	_, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, lp, poolId, -105, 100, tokensDesired, sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrInvalidTick)
+	// failed positions leave the ticks untouched
+	suite.Require().Len(suite.App.GAMMKeeper.GetPoolTicks(suite.Ctx, poolId), 2)
+
+	liquidity, tokensIn, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, lp, poolId, -100, 100, tokensDesired, sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokensIn.IsAllLTE(tokensDesired))
+	position, err := suite.App.GAMMKeeper.GetPosition(suite.Ctx, poolId, lp, -100, 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(liquidity, position.Liquidity)
+	suite.Require().Len(suite.App.GAMMKeeper.GetPoolPositions(suite.Ctx, poolId), 2)
+	// ticks are kept in their own store, sorted by index
+	ticks := suite.App.GAMMKeeper.GetPoolTicks(suite.Ctx, poolId)
+	suite.Require().Len(ticks, 4)
+	for i, index := range []int64{-10000, -100, 100, 10000} {
+		suite.Require().Equal(index, ticks[i].Index)
+	}
+
+	// swaps go through the regular swap path
+	trader := suite.TestAccs[2]
+	suite.FundAcc(trader, sdk.NewCoins(sdk.NewInt64Coin("bar", 100000)))
+	tokenOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("bar", 100000), "foo", sdk.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOut.IsPositive())
+
+	// withdrawing everything returns the position's share of the swap fees, and deletes it
+	balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+	tokensOut, err := suite.App.GAMMKeeper.WithdrawPosition(suite.Ctx, lp, poolId, -100, 100, liquidity)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balancesBefore.Add(tokensOut...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp))
+	suite.Require().True(tokensOut.AmountOf("bar").GT(tokensIn.AmountOf("bar")))
+	_, err = suite.App.GAMMKeeper.GetPosition(suite.Ctx, poolId, lp, -100, 100)
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+	suite.Require().Len(suite.App.GAMMKeeper.GetPoolTicks(suite.Ctx, poolId), 2)
+
+	_, err = suite.App.GAMMKeeper.WithdrawPosition(suite.Ctx, lp, poolId, -100, 100, liquidity)
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()))
+	suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), suite.App.GAMMKeeper.GetTotalLiquidity(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolShareOperations() {
+	suite.SetupTest()
+	poolId := suite.PrepareConcentratedPool()
+	sender := suite.TestAccs[0]
+
+	// concentrated liquidity pools don't issue LP shares
+	_, err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+	suite.Require().Error(err)
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+	suite.Require().Error(err)
+	_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), sdk.OneInt())
+	suite.Require().Error(err)
+
+	// and positions can only be created in concentrated liquidity pools
+	balancerPoolId := suite.PrepareBalancerPool()
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 1000))
+	_, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, sender, balancerPoolId, -100, 100, tokensDesired, sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrNotConcentratedPool)
+}
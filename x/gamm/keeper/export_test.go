@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// SetHooksUnsafe replaces the gamm hooks, which SetHooks only sets once.
+func (k *Keeper) SetHooksUnsafe(gh types.GammHooks) {
+	k.hooks = gh
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// FlashSwapCallback is called during a flash swap, once the tokens out of the
+// swap were sent to its receiver. The receiver must hold the tokens in of the
+// swap when it returns.
+type FlashSwapCallback func(ctx sdk.Context, tokenOut sdk.Coin) error
+
+// FlashSwapExactAmountIn swaps tokenIn for tokens denominated via tokenOutDenom
+// through a pool like SwapExactAmountIn, except that the tokens out are sent
+// to the receiver first. callback is then called, and tokenIn, which includes
+// the taker fee, is only taken from the receiver after it returns, so the
+// receiver can use the tokens out to pay for the swap. If the receiver can't
+// pay, an error is returned, and the whole message must revert.
+// The pool is locked while callback runs, so it can't be swapped against,
+// joined or exited before the swap is paid for. Its spot price can't be
+// calculated either, as its stored reserves don't account for the tokens out
+// yet: readers such as the txfees price recorder keep their last price.
+func (k Keeper) FlashSwapExactAmountIn(
+	ctx sdk.Context,
+	receiver sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	callback FlashSwapCallback,
+) (sdk.Int, error) {
+	if callback == nil {
+		return sdk.Int{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "flash swap callback is nil")
+	}
+
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	swapFee := pool.GetSwapFee(ctx)
+	return k.swapExactAmountInWithCallback(ctx, receiver, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee, callback)
+}
+
+// updatePoolForFlashSwap updates the pool like updatePoolForSwap, but sends
+// the out tokens from the pool to the receiver before calling callback, and
+// the in tokens from the receiver to the pool after. The pool is only stored
+// once the in tokens are paid.
+func (k Keeper) updatePoolForFlashSwap(
+	ctx sdk.Context,
+	pool types.PoolI,
+	receiver sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+	callback FlashSwapCallback,
+) error {
+	err := k.hooks.BeforeSwap(ctx, receiver, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), receiver, sdk.Coins{
+		tokenOut,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the pool is unlocked before it is stored, so that the swap hooks can
+	// read its updated spot price
+	k.setFlashSwapLock(ctx, pool.GetId(), true)
+	err = callback(ctx, tokenOut)
+	k.setFlashSwapLock(ctx, pool.GetId(), false)
+	if err != nil {
+		return sdkerrors.Wrap(err, "flash swap callback")
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, receiver, pool.GetAddress(), sdk.Coins{
+		tokenIn,
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "flash swap of pool %d not paid back", pool.GetId())
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	k.afterPoolUpdatedForSwap(ctx, pool, receiver, tokenIn, tokenOut, swapFee)
+	return nil
+}
+
+func (k Keeper) setFlashSwapLock(ctx sdk.Context, poolId uint64, locked bool) {
+	store := ctx.KVStore(k.storeKey)
+	if locked {
+		store.Set(types.GetKeyFlashSwapLock(poolId), []byte{})
+	} else {
+		store.Delete(types.GetKeyFlashSwapLock(poolId))
+	}
+}
+
+// ensureNotFlashSwapLocked returns an error if a flash swap through the pool
+// is in progress.
+func (k Keeper) ensureNotFlashSwapLocked(ctx sdk.Context, poolId uint64) error {
+	if ctx.KVStore(k.storeKey).Has(types.GetKeyFlashSwapLock(poolId)) {
+		return sdkerrors.Wrapf(types.ErrPoolLocked, "flash swap through pool %d in progress", poolId)
+	}
+	return nil
+}
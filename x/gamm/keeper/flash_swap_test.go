@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashSwapExactAmountIn() {
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(100))
+	receiver := sdk.AccAddress([]byte("flash_swap_receiver_"))
+
+	testCases := []struct {
+		name string
+		// callback returns the callback of the flash swap through the pool
+		callback    func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error
+		expectedErr error
+	}{
+		{
+			name: "paid back",
+			callback: func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error {
+				return func(ctx sdk.Context, tokenOut sdk.Coin) error {
+					suite.Require().Equal(tokenOut.String(), suite.App.BankKeeper.GetBalance(ctx, receiver, "bar").String())
+					suite.FundAcc(receiver, sdk.NewCoins(tokenIn))
+					return nil
+				}
+			},
+		},
+		{
+			name: "not paid back",
+			callback: func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error {
+				return func(ctx sdk.Context, tokenOut sdk.Coin) error {
+					return nil
+				}
+			},
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name: "swap through the pool in the callback",
+			callback: func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error {
+				return func(ctx sdk.Context, tokenOut sdk.Coin) error {
+					_, err := suite.App.GAMMKeeper.SwapExactAmountIn(ctx, receiver, poolId, tokenOut, "foo", sdk.OneInt())
+					return err
+				}
+			},
+			expectedErr: types.ErrPoolLocked,
+		},
+		{
+			name: "spot price of the pool in the callback",
+			callback: func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error {
+				return func(ctx sdk.Context, tokenOut sdk.Coin) error {
+					_, err := suite.App.GAMMKeeper.CalculateSpotPrice(ctx, poolId, "foo", "bar")
+					return err
+				}
+			},
+			expectedErr: types.ErrPoolLocked,
+		},
+		{
+			name: "callback error",
+			callback: func(poolId uint64) func(ctx sdk.Context, tokenOut sdk.Coin) error {
+				return func(ctx sdk.Context, tokenOut sdk.Coin) error {
+					return errors.New("liquidation failed")
+				}
+			},
+			expectedErr: errors.New("liquidation failed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+			// the tokens out are the same as the ones of a regular swap
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenOut, err := keeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+			suite.Require().NoError(err)
+			poolBefore, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+
+			tokenOutAmount, err := keeper.FlashSwapExactAmountIn(suite.Ctx, receiver, poolId, tokenIn, "bar", sdk.OneInt(), tc.callback(poolId))
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr.Error())
+
+				// the pool isn't updated, and is unlocked, when the flash swap fails
+				pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(poolBefore.GetTotalPoolLiquidity(suite.Ctx).String(), pool.GetTotalPoolLiquidity(suite.Ctx).String())
+				_, err = keeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+				suite.Require().NoError(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOut.String(), tokenOutAmount.String())
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", tokenOutAmount)).String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, receiver).String())
+
+			pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx).String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()).String())
+
+			// the pool is unlocked once the flash swap is over, and its spot
+			// price accounts for the swap
+			spotPrice, err := keeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+			suite.Require().NoError(err)
+			expectedSpotPrice, err := pool.SpotPrice(suite.Ctx, "foo", "bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedSpotPrice, spotPrice)
+			_, err = keeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+			suite.Require().NoError(err)
+		})
+	}
+
+	_, err := suite.App.GAMMKeeper.FlashSwapExactAmountIn(suite.Ctx, receiver, 1, tokenIn, "bar", sdk.OneInt(), nil)
+	suite.Require().Error(err)
+}
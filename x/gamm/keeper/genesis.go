@@ -24,6 +24,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		k.indexPoolDenoms(ctx, pool.GetId(), poolDenoms(ctx, pool))
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
@@ -31,6 +32,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		}
 	}
 
+	for _, position := range genState.Positions {
+		k.setPosition(ctx, position)
+	}
+
+	for _, tick := range genState.Ticks {
+		k.getTickStore(ctx, tick.PoolId).SetTick(tick)
+	}
+
+	for _, poolVolume := range genState.PoolVolumes {
+		k.SetPoolVolume(ctx, poolVolume)
+	}
+
+	k.SetTakerFeesCollected(ctx, genState.TakerFeesCollected)
+
+	for _, pauseStatus := range genState.PoolPauseStatuses {
+		k.SetPoolPauseStatus(ctx, pauseStatus)
+	}
+
 	k.SetTotalLiquidity(ctx, liquidity)
 }
 
@@ -52,5 +71,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		NextPoolNumber: k.GetNextPoolNumberAndIncrement(ctx),
 		Pools:          poolAnys,
 		Params:         k.GetParams(ctx),
+		Positions:      k.GetAllPositions(ctx),
+		Ticks:          k.GetAllTicks(ctx),
+		PoolVolumes:    k.GetAllPoolVolumes(ctx),
+
+		TakerFeesCollected: k.GetTakerFeesCollected(ctx),
+		PoolPauseStatuses:  k.GetAllPoolPauseStatuses(ctx),
 	}
 }
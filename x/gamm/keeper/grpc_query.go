@@ -116,6 +116,126 @@ func (q Querier) Pools(
 	}, nil
 }
 
+func (q Querier) PoolsWithDenoms(ctx context.Context, req *types.QueryPoolsWithDenomsRequest) (*types.QueryPoolsWithDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denoms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no denoms given")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	denomPoolStore := prefix.NewStore(sdkCtx.KVStore(q.Keeper.storeKey), types.GetKeyPrefixDenomPools(req.Denoms[0]))
+
+	anys, pageRes, err := q.paginatePools(sdkCtx, denomPoolStore, req.Pagination, func(pool types.PoolI) bool {
+		for _, denom := range req.Denoms[1:] {
+			if !q.Keeper.isPoolIndexedWithDenom(sdkCtx, pool.GetId(), denom) {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsWithDenomsResponse{
+		Pools:      anys,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) PoolsByType(ctx context.Context, req *types.QueryPoolsByTypeRequest) (*types.QueryPoolsByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	switch req.PoolType {
+	case types.PoolTypeBalancer, types.PoolTypeStableswap, types.PoolTypeConcentrated:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool type %s", req.PoolType)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	poolStore := prefix.NewStore(sdkCtx.KVStore(q.Keeper.storeKey), types.KeyPrefixPools)
+
+	anys, pageRes, err := q.paginatePools(sdkCtx, poolStore, req.Pagination, func(pool types.PoolI) bool {
+		return GetPoolType(pool) == req.PoolType
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsByTypeResponse{
+		Pools:      anys,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) PoolsByMinLiquidity(ctx context.Context, req *types.QueryPoolsByMinLiquidityRequest) (*types.QueryPoolsByMinLiquidityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.MinLiquidity.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// only the pools containing the first denom need to be paged through
+	poolIdStore := prefix.NewStore(sdkCtx.KVStore(q.Keeper.storeKey), types.KeyPrefixPools)
+	if !req.MinLiquidity.Empty() {
+		poolIdStore = prefix.NewStore(sdkCtx.KVStore(q.Keeper.storeKey), types.GetKeyPrefixDenomPools(req.MinLiquidity[0].Denom))
+	}
+
+	anys, pageRes, err := q.paginatePools(sdkCtx, poolIdStore, req.Pagination, func(pool types.PoolI) bool {
+		return pool.GetTotalPoolLiquidity(sdkCtx).IsAllGTE(req.MinLiquidity)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsByMinLiquidityResponse{
+		Pools:      anys,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginatePools pages through the pools whose ids are the keys of poolIdStore,
+// keeping the ones filter returns true for.
+func (q Querier) paginatePools(
+	ctx sdk.Context,
+	poolIdStore prefix.Store,
+	pageReq *query.PageRequest,
+	filter func(pool types.PoolI) bool,
+) ([]*codectypes.Any, *query.PageResponse, error) {
+	anys := []*codectypes.Any{}
+	pageRes, err := query.FilteredPaginate(poolIdStore, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		pool, err := q.Keeper.GetPoolAndPoke(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return false, err
+		}
+
+		if !filter(pool) {
+			return false, nil
+		}
+
+		if accumulate {
+			any, err := codectypes.NewAnyWithValue(pool)
+			if err != nil {
+				return false, err
+			}
+			anys = append(anys, any)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return anys, pageRes, nil
+}
+
 func (q Querier) NumPools(ctx context.Context, _ *types.QueryNumPoolsRequest) (*types.QueryNumPoolsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -219,6 +339,62 @@ func (q Querier) SpotPrice(ctx context.Context, req *types.QuerySpotPriceRequest
 	}, nil
 }
 
+func (q Querier) PoolVolume(ctx context.Context, req *types.QueryPoolVolumeRequest) (*types.QueryPoolVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolVolumeResponse{
+		Volume: q.Keeper.GetPoolVolume(sdkCtx, req.PoolId),
+	}, nil
+}
+
+func (q Querier) PoolFeesCollected(ctx context.Context, req *types.QueryPoolFeesCollectedRequest) (*types.QueryPoolFeesCollectedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolFeesCollectedResponse{
+		FeesCollected: q.Keeper.GetPoolFeesCollected(sdkCtx, req.PoolId),
+	}, nil
+}
+
+func (q Querier) TakerFeesCollected(ctx context.Context, _ *types.QueryTakerFeesCollectedRequest) (*types.QueryTakerFeesCollectedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryTakerFeesCollectedResponse{
+		TakerFeesCollected: q.Keeper.GetTakerFeesCollected(sdkCtx),
+	}, nil
+}
+
+func (q Querier) PoolPauseStatus(ctx context.Context, req *types.QueryPoolPauseStatusRequest) (*types.QueryPoolPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolPauseStatusResponse{
+		PauseStatus: q.Keeper.GetPoolPauseStatus(sdkCtx, req.PoolId),
+	}, nil
+}
+
 func (q Querier) TotalLiquidity(ctx context.Context, _ *types.QueryTotalLiquidityRequest) (*types.QueryTotalLiquidityResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -256,7 +432,7 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenOutAmount, err := q.Keeper.MultihopSwapExactAmountIn(sdkCtx, sender, req.Routes, tokenIn, sdk.NewInt(1))
+	tokenOutAmount, err := q.Keeper.MultihopSwapExactAmountIn(sdkCtx, sender, req.Routes, tokenIn, sdk.NewInt(1), types.SwapGuards{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -295,7 +471,7 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenInAmount, err := q.Keeper.MultihopSwapExactAmountOut(sdkCtx, sender, req.Routes, sdkIntMaxValue, tokenOut)
+	tokenInAmount, err := q.Keeper.MultihopSwapExactAmountOut(sdkCtx, sender, req.Routes, sdkIntMaxValue, tokenOut, types.SwapGuards{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -304,3 +480,35 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+func (q Querier) EstimateBestRoute(ctx context.Context, req *types.QueryEstimateBestRouteRequest) (*types.QueryEstimateBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err.Error())
+	}
+
+	if req.MaxHops == 0 || req.MaxHops > MaxBestRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops must be in [1, %d]", MaxBestRouteHops)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes, tokenOutAmount, priceImpact, err := q.Keeper.EstimateBestRoute(sdkCtx, tokenIn, req.TokenOutDenom, req.MaxHops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+		PriceImpact:    priceImpact,
+	}, nil
+}
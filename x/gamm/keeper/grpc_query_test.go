@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -283,3 +284,240 @@ func (suite *KeeperTestSuite) TestQueryBalancerPoolSpotPrice() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEstimateBestRoute() {
+	queryClient := suite.queryClient
+
+	// a shallow foo/baz pool, and deep foo/bar and bar/baz pools
+	suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 100000), sdk.NewInt64Coin("baz", 100000))
+	suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000))
+	suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("bar", 10000000), sdk.NewInt64Coin("baz", 10000000))
+
+	testCases := []struct {
+		name           string
+		req            *types.QueryEstimateBestRouteRequest
+		expectErr      bool
+		expectedRoutes []types.SwapAmountInRoute
+	}{
+		{
+			name: "going through bar beats the shallow direct pool",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10000foo",
+				TokenOutDenom: "baz",
+				MaxHops:       2,
+			},
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}, {PoolId: 3, TokenOutDenom: "baz"}},
+		},
+		{
+			name: "single hop",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10000foo",
+				TokenOutDenom: "baz",
+				MaxHops:       1,
+			},
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+		},
+		{
+			name: "tiny amounts are better off in the direct pool",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10foo",
+				TokenOutDenom: "baz",
+				MaxHops:       3,
+			},
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+		},
+		{
+			name: "no route",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10000foo",
+				TokenOutDenom: "qux",
+				MaxHops:       3,
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid token in",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "foo",
+				TokenOutDenom: "baz",
+				MaxHops:       3,
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero max hops",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10000foo",
+				TokenOutDenom: "baz",
+			},
+			expectErr: true,
+		},
+		{
+			name: "too many max hops",
+			req: &types.QueryEstimateBestRouteRequest{
+				TokenIn:       "10000foo",
+				TokenOutDenom: "baz",
+				MaxHops:       5,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			result, err := queryClient.EstimateBestRoute(gocontext.Background(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error")
+				return
+			}
+			suite.Require().NoError(err, "unexpected error")
+			suite.Require().Equal(tc.expectedRoutes, result.Routes)
+			suite.Require().True(result.PriceImpact.IsPositive())
+			suite.Require().True(result.PriceImpact.LT(sdk.OneDec()))
+
+			// the estimate matches executing the route
+			tokenIn, err := sdk.ParseCoinNormalized(tc.req.TokenIn)
+			suite.Require().NoError(err)
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(tokenIn))
+			tokenOutAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(cacheCtx, suite.TestAccs[0], result.Routes, tokenIn, sdk.OneInt(), types.SwapGuards{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tokenOutAmount, result.TokenOutAmount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPoolVolumeAndFeesCollected() {
+	queryClient := suite.queryClient
+	keeper := suite.App.GAMMKeeper
+
+	// Pool not exist
+	_, err := queryClient.PoolVolume(gocontext.Background(), &types.QueryPoolVolumeRequest{PoolId: 1})
+	suite.Require().Error(err)
+	_, err = queryClient.PoolFeesCollected(gocontext.Background(), &types.QueryPoolFeesCollectedRequest{PoolId: 1})
+	suite.Require().Error(err)
+
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+	// No swaps yet
+	volumeRes, err := queryClient.PoolVolume(gocontext.Background(), &types.QueryPoolVolumeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().True(volumeRes.Volume.Empty())
+
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(1000))
+	tokenOutAmount, err := keeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	tokenOut := sdk.NewCoin("foo", sdk.NewInt(100))
+	tokenInAmount, err := keeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "bar", sdk.NewInt(1000), tokenOut)
+	suite.Require().NoError(err)
+
+	volumeRes, err = queryClient.PoolVolume(gocontext.Background(), &types.QueryPoolVolumeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("foo", tokenIn.Amount.Add(tokenOut.Amount)),
+		sdk.NewCoin("bar", tokenOutAmount.Add(tokenInAmount)),
+	), volumeRes.Volume)
+
+	// Swap fees are paid on the tokens swapped in
+	feesRes, err := queryClient.PoolFeesCollected(gocontext.Background(), &types.QueryPoolFeesCollectedRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("foo", tokenIn.Amount.ToDec().Mul(defaultSwapFee).TruncateInt()),
+		sdk.NewCoin("bar", tokenInAmount.ToDec().Mul(defaultSwapFee).TruncateInt()),
+	), feesRes.FeesCollected)
+
+	// The volumes are kept through genesis
+	genesis := keeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.PoolVolume{{
+		PoolId:        poolId,
+		Volume:        volumeRes.Volume,
+		FeesCollected: feesRes.FeesCollected,
+	}}, genesis.PoolVolumes)
+}
+
+func (suite *KeeperTestSuite) TestQueryFilteredPools() {
+	queryClient := suite.queryClient
+
+	suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 100000), sdk.NewInt64Coin("baz", 100000))
+	suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000))
+	suite.PrepareConcentratedPool()
+
+	poolIds := func(anys []*codectypes.Any) []uint64 {
+		ids := []uint64{}
+		for _, any := range anys {
+			var pool types.PoolI
+			err := suite.App.InterfaceRegistry().UnpackAny(any, &pool)
+			suite.Require().NoError(err)
+			ids = append(ids, pool.GetId())
+		}
+		return ids
+	}
+
+	// pools with denoms
+	withDenomsTestCases := []struct {
+		denoms          []string
+		expectedPoolIds []uint64
+	}{
+		{denoms: []string{"foo"}, expectedPoolIds: []uint64{1, 2, 3}},
+		{denoms: []string{"bar", "foo"}, expectedPoolIds: []uint64{2, 3}},
+		{denoms: []string{"bar", "baz"}, expectedPoolIds: []uint64{}},
+		{denoms: []string{"qux"}, expectedPoolIds: []uint64{}},
+	}
+	for _, tc := range withDenomsTestCases {
+		res, err := queryClient.PoolsWithDenoms(gocontext.Background(), &types.QueryPoolsWithDenomsRequest{Denoms: tc.denoms})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expectedPoolIds, poolIds(res.Pools), "denoms %v", tc.denoms)
+	}
+
+	_, err := queryClient.PoolsWithDenoms(gocontext.Background(), &types.QueryPoolsWithDenomsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.PoolsWithDenoms(gocontext.Background(), &types.QueryPoolsWithDenomsRequest{
+		Denoms:     []string{"foo"},
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2}, poolIds(res.Pools))
+	res, err = queryClient.PoolsWithDenoms(gocontext.Background(), &types.QueryPoolsWithDenomsRequest{
+		Denoms:     []string{"foo"},
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3}, poolIds(res.Pools))
+
+	// pools by type
+	byTypeRes, err := queryClient.PoolsByType(gocontext.Background(), &types.QueryPoolsByTypeRequest{PoolType: types.PoolTypeBalancer})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2}, poolIds(byTypeRes.Pools))
+
+	byTypeRes, err = queryClient.PoolsByType(gocontext.Background(), &types.QueryPoolsByTypeRequest{PoolType: types.PoolTypeConcentrated})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3}, poolIds(byTypeRes.Pools))
+
+	byTypeRes, err = queryClient.PoolsByType(gocontext.Background(), &types.QueryPoolsByTypeRequest{PoolType: types.PoolTypeStableswap})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byTypeRes.Pools)
+
+	_, err = queryClient.PoolsByType(gocontext.Background(), &types.QueryPoolsByTypeRequest{PoolType: "unknown"})
+	suite.Require().Error(err)
+
+	// pools by min liquidity
+	minLiquidityTestCases := []struct {
+		minLiquidity    sdk.Coins
+		expectedPoolIds []uint64
+	}{
+		{minLiquidity: sdk.Coins{}, expectedPoolIds: []uint64{1, 2, 3}},
+		{minLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)), expectedPoolIds: []uint64{1, 2, 3}},
+		{minLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 500000)), expectedPoolIds: []uint64{2, 3}},
+		{minLiquidity: sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 5000000)), expectedPoolIds: []uint64{2}},
+		{minLiquidity: sdk.NewCoins(sdk.NewInt64Coin("baz", 1)), expectedPoolIds: []uint64{1}},
+	}
+	for _, tc := range minLiquidityTestCases {
+		res, err := queryClient.PoolsByMinLiquidity(gocontext.Background(), &types.QueryPoolsByMinLiquidityRequest{MinLiquidity: tc.minLiquidity})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expectedPoolIds, poolIds(res.Pools), "min liquidity %s", tc.minLiquidity)
+	}
+}
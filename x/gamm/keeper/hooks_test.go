@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var _ types.GammHooks = &vetoGammHooks{}
+
+// vetoGammHooks aborts the joins, exits and swaps whose Before hook is
+// called with err.
+type vetoGammHooks struct {
+	joinErr error
+	exitErr error
+	swapErr error
+}
+
+func (h *vetoGammHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+func (h *vetoGammHooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (h *vetoGammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+func (h *vetoGammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+func (h *vetoGammHooks) BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error {
+	return h.joinErr
+}
+
+func (h *vetoGammHooks) BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error {
+	return h.exitErr
+}
+
+func (h *vetoGammHooks) BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error {
+	return h.swapErr
+}
+
+func (suite *KeeperTestSuite) TestBeforeHooksVeto() {
+	errVeto := errors.New("vetoed")
+
+	testCases := []struct {
+		name  string
+		hooks *vetoGammHooks
+	}{
+		{
+			name:  "no veto",
+			hooks: &vetoGammHooks{},
+		},
+		{
+			name:  "join vetoed",
+			hooks: &vetoGammHooks{joinErr: errVeto},
+		},
+		{
+			name:  "exit vetoed",
+			hooks: &vetoGammHooks{exitErr: errVeto},
+		},
+		{
+			name:  "swap vetoed",
+			hooks: &vetoGammHooks{swapErr: errVeto},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			keeper.SetHooksUnsafe(types.NewMultiGammHooks(&vetoGammHooks{}, tc.hooks))
+
+			assertVetoed := func(err error, vetoErr error) {
+				if vetoErr != nil {
+					suite.Require().ErrorIs(err, vetoErr)
+				} else {
+					suite.Require().NoError(err)
+				}
+			}
+
+			pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(suite.Ctx)
+
+			_, err = keeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoin("foo", sdk.NewInt(100)), "bar", sdk.OneInt())
+			assertVetoed(err, tc.hooks.swapErr)
+			_, err = keeper.SwapExactAmountOut(suite.Ctx, sender, poolId, "foo", sdk.NewInt(1000), sdk.NewCoin("bar", sdk.NewInt(100)))
+			assertVetoed(err, tc.hooks.swapErr)
+			// batch swaps are checked with their sender when they are queued
+			_, err = keeper.QueueBatchSwap(suite.Ctx, sender, poolId, sdk.NewCoin("foo", sdk.NewInt(100)), "bar", sdk.OneInt())
+			assertVetoed(err, tc.hooks.swapErr)
+
+			// vetoed swaps leave the pool unchanged
+			if tc.hooks.swapErr != nil {
+				pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(liquidityBefore.String(), pool.GetTotalPoolLiquidity(suite.Ctx).String())
+			}
+
+			_, err = keeper.JoinPoolNoSwap(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+			assertVetoed(err, tc.hooks.joinErr)
+			_, err = keeper.ExitPool(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+			assertVetoed(err, tc.hooks.exitErr)
+		})
+	}
+}
@@ -10,18 +10,37 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName     = "pool-account-balance-equals-expected"
+	cfmmValueInvariantName       = "cfmm-value-per-share"
+	poolTotalSharesInvariantName = "pool-total-shares-equal-share-supply"
+	totalLiquidityInvariantName  = "total-liquidity-equals-pool-liquidity"
+)
 
 // RegisterInvariants registers all governance invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, cfmmValueInvariantName, CFMMValueInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, poolTotalSharesInvariantName, PoolTotalSharesInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, totalLiquidityInvariantName, TotalLiquidityInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the gamm module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg, broke := PoolAccountInvariant(keeper, bk)(ctx)
-		return msg, broke
+		if broke {
+			return msg, broke
+		}
+		msg, broke = CFMMValueInvariant(keeper)(ctx)
+		if broke {
+			return msg, broke
+		}
+		msg, broke = PoolTotalSharesInvariant(keeper, bk)(ctx)
+		if broke {
+			return msg, broke
+		}
+		return TotalLiquidityInvariant(keeper)(ctx)
 	}
 }
 
@@ -49,3 +68,87 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 			"\tgamm all pool asset coins and account coins match\n"), false
 	}
 }
+
+// CFMMValueInvariant checks that the CFMM value per share of balancer and
+// stableswap pools doesn't decrease across a swap, by swapping against copies
+// of the pools that are never written back.
+func CFMMValueInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, cfmmValueInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, pool := range pools {
+			decreased, ratio, err := keeper.cfmmValueDecreased(ctx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, cfmmValueInvariantName,
+					fmt.Sprintf("\tgamm pool id %d\n\t CFMM value check failed: %s\n", pool.GetId(), err)), true
+			}
+			if decreased {
+				return sdk.FormatInvariant(types.ModuleName, cfmmValueInvariantName,
+					fmt.Sprintf("\tgamm pool id %d\n\t CFMM value ratio across a swap: %s\n", pool.GetId(), ratio)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, cfmmValueInvariantName,
+			"\tgamm CFMM value per share of all pools doesn't decrease across a swap\n"), false
+	}
+}
+
+// PoolTotalSharesInvariant checks that the total shares of balancer and
+// stableswap pools equal the supply of their share denom.
+func PoolTotalSharesInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, pool := range pools {
+			if _, ok := pool.(types.CFMMPoolI); !ok {
+				continue
+			}
+
+			totalShares := pool.GetTotalShares()
+			shareSupply := bk.GetSupply(ctx, types.GetPoolShareDenom(pool.GetId())).Amount
+			if !totalShares.Equal(shareSupply) {
+				return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName,
+					fmt.Sprintf("\tgamm pool id %d\n\t pool total shares: %s\n\t share supply: %s\n",
+						pool.GetId(), totalShares, shareSupply)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName,
+			"\tgamm all pool total shares and share supplies match\n"), false
+	}
+}
+
+// TotalLiquidityInvariant checks that the total liquidity records equal the
+// sum of the liquidity of all pools.
+func TotalLiquidityInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		poolsLiquidity := sdk.Coins{}
+		for _, pool := range pools {
+			poolsLiquidity = poolsLiquidity.Add(pool.GetTotalPoolLiquidity(ctx)...)
+		}
+
+		totalLiquidity := keeper.GetTotalLiquidity(ctx)
+		if !totalLiquidity.IsEqual(poolsLiquidity) {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				fmt.Sprintf("\tgamm total liquidity: %s\n\t sum of pool liquidity: %s\n",
+					totalLiquidity, poolsLiquidity)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+			"\tgamm total liquidity and sum of pool liquidity match\n"), false
+	}
+}
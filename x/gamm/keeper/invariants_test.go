@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name        string
+		breakState  func(poolId uint64)
+		invariant   func() sdk.Invariant
+		expectBroke bool
+	}{
+		{
+			name:       "all invariants hold after swaps",
+			breakState: func(poolId uint64) {},
+			invariant: func() sdk.Invariant {
+				return keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)
+			},
+		},
+		{
+			name: "CFMM value per share decreased",
+			breakState: func(poolId uint64) {
+				// a negative swap fee pays the swapper out of the pool's value
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				balancerPool := pool.(*balancer.Pool)
+				balancerPool.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				err = suite.App.GAMMKeeper.SetPool(suite.Ctx, balancerPool)
+				suite.Require().NoError(err)
+			},
+			invariant: func() sdk.Invariant {
+				return keeper.CFMMValueInvariant(*suite.App.GAMMKeeper)
+			},
+			expectBroke: true,
+		},
+		{
+			name: "share supply differs from pool total shares",
+			breakState: func(poolId uint64) {
+				shares := sdk.NewCoins(sdk.NewInt64Coin(types.GetPoolShareDenom(poolId), 1))
+				err := suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, shares)
+				suite.Require().NoError(err)
+			},
+			invariant: func() sdk.Invariant {
+				return keeper.PoolTotalSharesInvariant(*suite.App.GAMMKeeper, suite.App.BankKeeper)
+			},
+			expectBroke: true,
+		},
+		{
+			name: "total liquidity differs from pool liquidity",
+			breakState: func(poolId uint64) {
+				suite.App.GAMMKeeper.RecordTotalLiquidityIncrease(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+			},
+			invariant: func() sdk.Invariant {
+				return keeper.TotalLiquidityInvariant(*suite.App.GAMMKeeper)
+			},
+			expectBroke: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := suite.TestAccs[0]
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			suite.PrepareConcentratedPool()
+
+			_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt())
+			suite.Require().NoError(err)
+			_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, sender, poolId, "bar", sdk.NewInt(10000), sdk.NewCoin("foo", sdk.NewInt(500)))
+			suite.Require().NoError(err)
+
+			tc.breakState(poolId)
+
+			_, broke := tc.invariant()(suite.Ctx)
+			suite.Require().Equal(tc.expectBroke, broke)
+			_, broke = keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+			suite.Require().Equal(tc.expectBroke, broke)
+		})
+	}
+}
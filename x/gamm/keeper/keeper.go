@@ -33,6 +33,8 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistrKeeper
+	txfeesKeeper  types.TxFeesKeeper
+	lockupKeeper  types.LockupKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistrKeeper) Keeper {
@@ -98,6 +100,22 @@ func (k *Keeper) createRemoveLiquidityEvent(ctx sdk.Context, sender sdk.AccAddre
 	})
 }
 
+// SetTxFeesKeeper sets the txfees keeper, which is constructed after the gamm
+// keeper, used to route taker fees to stakers.
+func (k *Keeper) SetTxFeesKeeper(txfeesKeeper types.TxFeesKeeper) *Keeper {
+	k.txfeesKeeper = txfeesKeeper
+
+	return k
+}
+
+// SetLockupKeeper sets the lockup keeper, which is constructed after the gamm
+// keeper, used to lock pool shares and exit pools with locked shares.
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) *Keeper {
+	k.lockupKeeper = lockupKeeper
+
+	return k
+}
+
 // Set the gamm hooks.
 func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	if k.hooks != nil {
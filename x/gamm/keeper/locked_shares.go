@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var errLockupKeeperNotSet = errors.New("lockup keeper is not set")
+
+// JoinPoolAndLock joins a pool without swapping, like JoinPoolNoSwap, and
+// locks the pool shares received for duration, returning the id of the lock.
+func (k Keeper) JoinPoolAndLock(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareOutAmount sdk.Int,
+	tokenInMaxs sdk.Coins,
+	duration time.Duration,
+) (lockId uint64, err error) {
+	if k.lockupKeeper == nil {
+		return 0, errLockupKeeperNotSet
+	}
+
+	sharesOut, err := k.JoinPoolNoSwap(ctx, sender, poolId, shareOutAmount, tokenInMaxs)
+	if err != nil {
+		return 0, err
+	}
+
+	shares := sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), sharesOut))
+	lock, err := k.lockupKeeper.CreateLock(ctx, sender, shares, duration)
+	if err != nil {
+		return 0, err
+	}
+	return lock.ID, nil
+}
+
+// ExitLockedPool withdraws the pool shares of the given locks, which must be
+// owned by the sender, hold only shares of the pool and have started
+// unlocking, and exits the pool with all of them, like ExitPool.
+// The locks are unlocked early, like the superfluid unpooling does, as matured
+// locks are withdrawn by the lockup end blocker and can't be exited with.
+func (k Keeper) ExitLockedPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lockIds []uint64,
+	tokenOutMins sdk.Coins,
+) (exitCoins sdk.Coins, err error) {
+	if k.lockupKeeper == nil {
+		return sdk.Coins{}, errLockupKeeperNotSet
+	}
+
+	shareDenom := types.GetPoolShareDenom(poolId)
+	shareInAmount := sdk.ZeroInt()
+	for _, lockId := range lockIds {
+		lock, err := k.lockupKeeper.GetLockByID(ctx, lockId)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+
+		if lock.Owner != sender.String() {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrNotLockOwner, "lock %d is owned by %s", lockId, lock.Owner)
+		}
+
+		if len(lock.Coins) != 1 || lock.Coins[0].Denom != shareDenom {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLockNotOfPoolShares, "lock %d holds %s, pool %d shares are %s", lockId, lock.Coins, poolId, shareDenom)
+		}
+
+		// superfluid staked shares have to be undelegated through the superfluid module
+		if k.lockupKeeper.HasAnySyntheticLockups(ctx, lockId) {
+			return sdk.Coins{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock %d has synthetic lockups", lockId)
+		}
+
+		// locks that haven't started unlocking are still bonded for their
+		// whole duration
+		if !lock.IsUnlocking() {
+			return sdk.Coins{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock %d hasn't started unlocking", lockId)
+		}
+
+		err = k.lockupKeeper.ForceUnlock(ctx, *lock)
+		if err != nil {
+			return sdk.Coins{}, sdkerrors.Wrapf(err, "lock %d", lockId)
+		}
+		shareInAmount = shareInAmount.Add(lock.Coins[0].Amount)
+	}
+
+	return k.ExitPool(ctx, sender, poolId, shareInAmount, tokenOutMins)
+}
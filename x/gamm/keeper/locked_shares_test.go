@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup"
+)
+
+func (suite *KeeperTestSuite) TestJoinPoolAndLockAndExitLockedPool() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	lockupKeeper := suite.App.LockupKeeper
+	sender := suite.TestAccs[0]
+	other := suite.TestAccs[1]
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	shareDenom := types.GetPoolShareDenom(poolId)
+	duration := time.Hour
+
+	lockId, err := keeper.JoinPoolAndLock(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{}, duration)
+	suite.Require().NoError(err)
+
+	lock, err := lockupKeeper.GetLockByID(suite.Ctx, lockId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sender.String(), lock.Owner)
+	suite.Require().Equal(duration, lock.Duration)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(shareDenom, types.OneShare)), lock.Coins)
+
+	otherLockId, err := keeper.JoinPoolAndLock(suite.Ctx, other, poolId, types.OneShare, sdk.Coins{}, duration)
+	suite.Require().NoError(err)
+
+	// the lock hasn't started unlocking
+	_, err = keeper.ExitLockedPool(suite.Ctx, sender, poolId, []uint64{lockId}, sdk.Coins{})
+	suite.Require().Error(err)
+
+	err = lockupKeeper.BeginUnlock(suite.Ctx, lockId, nil)
+	suite.Require().NoError(err)
+	err = lockupKeeper.BeginUnlock(suite.Ctx, otherLockId, nil)
+	suite.Require().NoError(err)
+
+	_, err = keeper.ExitLockedPool(suite.Ctx, sender, poolId, []uint64{otherLockId}, sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	_, err = keeper.ExitLockedPool(suite.Ctx, sender, poolId+1, []uint64{lockId}, sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrLockNotOfPoolShares)
+
+	// the lock is still unlocking, and is withdrawn early
+	balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+	exitCoins, err := keeper.ExitLockedPool(suite.Ctx, sender, poolId, []uint64{lockId}, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().False(exitCoins.Empty())
+
+	// the shares are burned and the sender receives the exit coins
+	_, err = lockupKeeper.GetLockByID(suite.Ctx, lockId)
+	suite.Require().Error(err)
+	balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+	suite.Require().Equal(balancesBefore.Add(exitCoins...), balancesAfter)
+}
+
+func (suite *KeeperTestSuite) TestExitLockedPoolAfterLockMatures() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	lockupKeeper := suite.App.LockupKeeper
+	sender := suite.TestAccs[0]
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	shareDenom := types.GetPoolShareDenom(poolId)
+	duration := time.Hour
+
+	lockId, err := keeper.JoinPoolAndLock(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{}, duration)
+	suite.Require().NoError(err)
+	maturedLockId, err := keeper.JoinPoolAndLock(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{}, duration)
+	suite.Require().NoError(err)
+	suite.Require().NoError(lockupKeeper.BeginUnlock(suite.Ctx, lockId, nil))
+	suite.Require().NoError(lockupKeeper.BeginUnlock(suite.Ctx, maturedLockId, nil))
+
+	// past the end time of the locks, they can still be exited with until the
+	// lockup end blocker withdraws them
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration + time.Second)).WithBlockHeight(suite.Ctx.BlockHeight() + 10)
+	exitCoins, err := keeper.ExitLockedPool(suite.Ctx, sender, poolId, []uint64{lockId}, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().False(exitCoins.Empty())
+
+	maturedLock, err := lockupKeeper.GetLockByID(suite.Ctx, maturedLockId)
+	suite.Require().NoError(err)
+	sharesBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom)
+	lockup.EndBlocker(suite.Ctx, *lockupKeeper)
+
+	// the matured lock is withdrawn to the sender, who exits the pool with
+	// its shares like with any other shares
+	_, err = lockupKeeper.GetLockByID(suite.Ctx, maturedLockId)
+	suite.Require().Error(err)
+	_, err = keeper.ExitLockedPool(suite.Ctx, sender, poolId, []uint64{maturedLockId}, sdk.Coins{})
+	suite.Require().Error(err)
+	sharesAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom)
+	suite.Require().Equal(sharesBefore.Add(maturedLock.Coins[0]), sharesAfter)
+	_, err = keeper.ExitPool(suite.Ctx, sender, poolId, maturedLock.Coins[0].Amount, sdk.Coins{})
+	suite.Require().NoError(err)
+}
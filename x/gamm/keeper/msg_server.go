@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -26,16 +28,23 @@ func NewBalancerMsgServerImpl(keeper *Keeper) balancer.MsgServer {
 	}
 }
 
-// func NewStableswapMsgServerImpl(keeper *Keeper) stableswap.MsgServer {
-// 	return &msgServer{
-// 		keeper: keeper,
-// 	}
-// }
+func NewConcentratedMsgServerImpl(keeper *Keeper) concentrated.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func NewStableswapMsgServerImpl(keeper *Keeper) stableswap.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
 
 var (
-	_ types.MsgServer    = msgServer{}
-	_ balancer.MsgServer = msgServer{}
-	// _ stableswap.MsgServer = msgServer{}
+	_ types.MsgServer        = msgServer{}
+	_ balancer.MsgServer     = msgServer{}
+	_ concentrated.MsgServer = msgServer{}
+	_ stableswap.MsgServer   = msgServer{}
 )
 
 func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.MsgCreateBalancerPool) (*balancer.MsgCreateBalancerPoolResponse, error) {
@@ -43,23 +52,144 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
 }
 
-// func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
-// 	poolId, err := server.CreatePool(goCtx, msg)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return &stableswap.MsgCreateStableswapPoolResponse{PoolID: poolId}, nil
-// }
+func (server msgServer) UpdateBalancerPoolParams(goCtx context.Context, msg *balancer.MsgUpdateBalancerPoolParams) (*balancer.MsgUpdateBalancerPoolParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolParams, err := server.keeper.UpdateBalancerPoolParams(ctx, sender, msg.PoolID, msg.SwapFee, msg.ExitFee, msg.SmoothWeightChangeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolParamsUpdated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapFee, poolParams.SwapFee.String()),
+			sdk.NewAttribute(types.AttributeKeyExitFee, poolParams.ExitFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgUpdateBalancerPoolParamsResponse{}, nil
+}
+
+func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
+	poolId, err := server.CreatePool(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &stableswap.MsgCreateStableswapPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.SetStableSwapScalingFactors(ctx, msg.ScalingFactors, msg.PoolID, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
+}
+
+func (server msgServer) StableSwapStartScalingFactorRamp(goCtx context.Context, msg *stableswap.MsgStableSwapStartScalingFactorRamp) (*stableswap.MsgStableSwapStartScalingFactorRampResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.StartStableSwapScalingFactorRamp(ctx, msg.PoolID, msg.Sender, msg.TargetScalingFactors, msg.StartTime, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapStartScalingFactorRampResponse{}, nil
+}
+
+func (server msgServer) StableSwapCancelScalingFactorRamp(goCtx context.Context, msg *stableswap.MsgStableSwapCancelScalingFactorRamp) (*stableswap.MsgStableSwapCancelScalingFactorRampResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.CancelStableSwapScalingFactorRamp(ctx, msg.PoolID, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapCancelScalingFactorRampResponse{}, nil
+}
+
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *concentrated.MsgCreateConcentratedPool) (*concentrated.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolId, err := server.keeper.CreateConcentratedPool(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCreateConcentratedPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) CreatePosition(goCtx context.Context, msg *concentrated.MsgCreatePosition) (*concentrated.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity, tokensIn, err := server.keeper.CreatePosition(ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick, msg.TokensDesired, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCreatePositionResponse{LiquidityCreated: liquidity, TokensIn: tokensIn}, nil
+}
+
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *concentrated.MsgWithdrawPosition) (*concentrated.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
 
-// func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+	tokensOut, err := server.keeper.WithdrawPosition(ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick, msg.LiquidityAmount)
+	if err != nil {
+		return nil, err
+	}
 
-// 	if err := server.keeper.SetStableSwapScalingFactors(ctx, msg.ScalingFactors, msg.PoolID, msg.ScalingFactorGovernor); err != nil {
-// 		return nil, err
-// 	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
 
-// 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
-// }
+	return &concentrated.MsgWithdrawPositionResponse{TokensOut: tokensOut}, nil
+}
 
 func (server msgServer) CreatePool(goCtx context.Context, msg types.CreatePoolMsg) (poolId uint64, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -92,7 +222,7 @@ func (server msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool)
 		return nil, err
 	}
 
-	err = server.keeper.JoinPoolNoSwap(ctx, sender, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs)
+	_, err = server.keeper.JoinPoolNoSwap(ctx, sender, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +278,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.MultihopSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.MultihopSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.SwapGuards())
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +303,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.MultihopSwapExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.MultihopSwapExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.SwapGuards())
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +320,56 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountOut) (*types.MsgSplitRouteSwapExactAmountOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.SplitRouteSwapExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
+}
+
 func (server msgServer) JoinSwapExternAmountIn(goCtx context.Context, msg *types.MsgJoinSwapExternAmountIn) (*types.MsgJoinSwapExternAmountInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -290,3 +470,140 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+func (server msgServer) MigrateShares(goCtx context.Context, msg *types.MsgMigrateShares) (*types.MsgMigrateSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, err := server.keeper.MigrateShares(ctx, sender, msg.FromPoolId, msg.ToPoolId, msg.ShareInAmount, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolExited,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.FromPoolId, 10)),
+		),
+		sdk.NewEvent(
+			types.TypeEvtPoolJoined,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.ToPoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateSharesResponse{ShareOutAmount: shareOutAmount}, nil
+}
+
+func (server msgServer) PausePool(goCtx context.Context, msg *types.MsgPausePool) (*types.MsgPausePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.PausePool(ctx, sender, msg.PoolId, msg.PauseSwaps, msg.PauseJoins, msg.PauseExits)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPausePoolResponse{}, nil
+}
+
+func (server msgServer) JoinPoolAndLock(goCtx context.Context, msg *types.MsgJoinPoolAndLock) (*types.MsgJoinPoolAndLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	lockId, err := server.keeper.JoinPoolAndLock(ctx, sender, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolJoined,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgJoinPoolAndLockResponse{LockId: lockId}, nil
+}
+
+func (server msgServer) ExitLockedPool(goCtx context.Context, msg *types.MsgExitLockedPool) (*types.MsgExitLockedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	exitCoins, err := server.keeper.ExitLockedPool(ctx, sender, msg.PoolId, msg.LockIds, msg.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolExited,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgExitLockedPoolResponse{TokenOut: exitCoins}, nil
+}
+
+func (server msgServer) BatchSwap(goCtx context.Context, msg *types.MsgBatchSwap) (*types.MsgBatchSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.QueueBatchSwap(ctx, sender, msg.PoolId, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// The swap events are emitted when the order is cleared
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgBatchSwapResponse{OrderId: orderId}, nil
+}
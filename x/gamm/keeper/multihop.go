@@ -2,27 +2,37 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 // MultihopSwapExactAmountIn defines the input denom and input amount for the first pool,
 // the output of the first pool is chained as the input for the next routed pool
-// transaction succeeds when final amount out is greater than tokenOutMinAmount defined.
+// transaction succeeds when final amount out is greater than tokenOutMinAmount defined,
+// and each swap meets the swap guards.
 func (k Keeper) MultihopSwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int,
+	guards types.SwapGuards,
 ) (tokenOutAmount sdk.Int, err error) {
+	if err := checkSwapDeadline(ctx, guards); err != nil {
+		return sdk.Int{}, err
+	}
+
 	for i, route := range routes {
 		_outMinAmount := sdk.NewInt(1)
 		if len(routes)-1 == i {
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, route.PoolId, tokenIn, route.TokenOutDenom, _outMinAmount)
+		err = k.swapWithPriceImpactGuard(ctx, route.PoolId, tokenIn.Denom, route.TokenOutDenom, guards, func() (err error) {
+			tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, route.PoolId, tokenIn, route.TokenOutDenom, _outMinAmount)
+			return err
+		})
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -34,14 +44,20 @@ func (k Keeper) MultihopSwapExactAmountIn(
 // MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
-// Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
+// Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined,
+// and each swap meets the swap guards.
 func (k Keeper) MultihopSwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	routes []types.SwapAmountOutRoute,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
+	guards types.SwapGuards,
 ) (tokenInAmount sdk.Int, err error) {
+	if err := checkSwapDeadline(ctx, guards); err != nil {
+		return sdk.Int{}, err
+	}
+
 	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
 	if err != nil {
 		return sdk.Int{}, err
@@ -55,7 +71,11 @@ func (k Keeper) MultihopSwapExactAmountOut(
 			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
 		}
 
-		_tokenInAmount, err := k.SwapExactAmountOut(ctx, sender, route.PoolId, route.TokenInDenom, insExpected[i], _tokenOut)
+		var _tokenInAmount sdk.Int
+		err = k.swapWithPriceImpactGuard(ctx, route.PoolId, route.TokenInDenom, _tokenOut.Denom, guards, func() (err error) {
+			_tokenInAmount, err = k.SwapExactAmountOut(ctx, sender, route.PoolId, route.TokenInDenom, insExpected[i], _tokenOut)
+			return err
+		})
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -70,6 +90,7 @@ func (k Keeper) MultihopSwapExactAmountOut(
 
 // TODO: Document this function.
 func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) ([]sdk.Int, error) {
+	params := k.GetParams(ctx)
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
@@ -79,10 +100,11 @@ func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.S
 			return nil, err
 		}
 
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx))
+		tokenIn, err := k.calcInAmtGivenOut(ctx, pool, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx))
 		if err != nil {
 			return nil, err
 		}
+		tokenIn.Amount = tokenInAmountWithTakerFee(tokenIn.Amount, params.GetTakerFee(route.TokenInDenom, tokenOut.Denom))
 
 		insExpected[i] = tokenIn.Amount
 		tokenOut = tokenIn
@@ -90,3 +112,74 @@ func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.S
 
 	return insExpected, nil
 }
+
+// SplitRouteSwapExactAmountIn swaps tokenInDenom along each of the routes,
+// each route swapping its own amount in as a multihop swap.
+// The transaction succeeds when the total amount out of all the routes is
+// greater than tokenOutMinAmount.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		// the slippage bound is checked on the total, each route only has to swap something
+		routeTokenOutAmount, err := k.MultihopSwapExactAmountIn(ctx, sender, route.Pools, tokenIn, sdk.OneInt(), types.SwapGuards{})
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "split route swap outputs %s, less than the min amount %s", tokenOutAmount, tokenOutMinAmount)
+	}
+
+	return tokenOutAmount, nil
+}
+
+// SplitRouteSwapExactAmountOut swaps for tokenOutDenom along each of the
+// routes, each route swapping for its own amount out as a multihop swap.
+// The transaction succeeds when the total amount in of all the routes is
+// less than tokenInMaxAmount.
+func (k Keeper) SplitRouteSwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenOut := sdk.NewCoin(tokenOutDenom, route.TokenOutAmount)
+		// each route may use whatever is left of the total max amount in
+		remainingTokenInMaxAmount := tokenInMaxAmount.Sub(tokenInAmount)
+		if !remainingTokenInMaxAmount.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "split route swap requires more than the max amount %s", tokenInMaxAmount)
+		}
+
+		routeTokenInAmount, err := k.MultihopSwapExactAmountOut(ctx, sender, route.Pools, remainingTokenInMaxAmount, tokenOut, types.SwapGuards{})
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "split route swap requires %s, more than the max amount %s", tokenInAmount, tokenInMaxAmount)
+	}
+
+	return tokenInAmount, nil
+}
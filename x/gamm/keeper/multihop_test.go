@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -69,7 +70,7 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountIn()
 				return dec
 			}()
 
-			tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount)
+			tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount, types.SwapGuards{})
 			suite.NoError(err, "test: %v", test.name)
 
 			// Calculate the chained spot price.
@@ -95,7 +96,7 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountIn()
 			sp := test.param.tokenIn.Amount.ToDec().Quo(tokenOutAmount.ToDec())
 			suite.True(sp.GT(spotPriceBefore) && sp.LT(spotPriceAfter), "test: %v", test.name)
 		} else {
-			_, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount)
+			_, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount, types.SwapGuards{})
 			suite.Error(err, "test: %v", test.name)
 		}
 	}
@@ -163,7 +164,7 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountOut()
 				return dec
 			}()
 
-			tokenInAmount, err := keeper.MultihopSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut)
+			tokenInAmount, err := keeper.MultihopSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut, types.SwapGuards{})
 			suite.Require().NoError(err, "test: %v", test.name)
 
 			// Calculate the chained spot price.
@@ -191,8 +192,242 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountOut()
 			fmt.Printf("spBefore %s, spAfter %s, sp actual %s\n", spotPriceBefore, spotPriceAfter, sp)
 			suite.True(sp.GT(spotPriceBefore) && sp.LT(spotPriceAfter), "multi-hop spot price wrong, test: %v", test.name)
 		} else {
-			_, err := keeper.MultihopSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut)
+			_, err := keeper.MultihopSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut, types.SwapGuards{})
 			suite.Error(err, "test: %v", test.name)
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	tests := []struct {
+		name              string
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		expectPass        bool
+	}{
+		{
+			name: "foo -> baz (pool 1), and foo -> bar (pool 2) -> baz (pool 1)",
+			routes: []types.SwapAmountInSplitRoute{
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+					TokenInAmount: sdk.NewInt(100000),
+				},
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}, {PoolId: 1, TokenOutDenom: "baz"}},
+					TokenInAmount: sdk.NewInt(50000),
+				},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectPass:        true,
+		},
+		{
+			name: "total amount out below the min amount",
+			routes: []types.SwapAmountInSplitRoute{
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+					TokenInAmount: sdk.NewInt(100000),
+				},
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "baz"}},
+					TokenInAmount: sdk.NewInt(100000),
+				},
+			},
+			// each route yields less than 100000 baz, which is less than the total
+			tokenOutMinAmount: sdk.NewInt(100000),
+			expectPass:        false,
+		},
+		{
+			name: "routes ending in different denoms",
+			routes: []types.SwapAmountInSplitRoute{
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+					TokenInAmount: sdk.NewInt(100000),
+				},
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}},
+					TokenInAmount: sdk.NewInt(100000),
+				},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectPass:        false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPool()
+			suite.PrepareBalancerPool()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+
+			// the split route swap must match swapping each route on its own
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenOutAmount := sdk.ZeroInt()
+			for _, route := range test.routes {
+				routeTokenOutAmount, err := keeper.MultihopSwapExactAmountIn(cacheCtx, sender, route.Pools, sdk.NewCoin("foo", route.TokenInAmount), sdk.OneInt(), types.SwapGuards{})
+				suite.Require().NoError(err)
+				expectedTokenOutAmount = expectedTokenOutAmount.Add(routeTokenOutAmount)
+			}
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			tokenOutAmount, err := keeper.SplitRouteSwapExactAmountIn(suite.Ctx, sender, test.routes, "foo", test.tokenOutMinAmount)
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(types.SwapAmountInSplitRoutes(test.routes).TokenInAmount(), balancesBefore.AmountOf("foo").Sub(balancesAfter.AmountOf("foo")))
+			suite.Require().Equal(tokenOutAmount, balancesAfter.AmountOf("baz").Sub(balancesBefore.AmountOf("baz")))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountOut() {
+	tests := []struct {
+		name             string
+		routes           []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount sdk.Int
+		expectPass       bool
+	}{
+		{
+			name: "foo -> baz (pool 1), and foo -> bar (pool 2) -> baz (pool 1)",
+			routes: []types.SwapAmountOutSplitRoute{
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}},
+					TokenOutAmount: sdk.NewInt(100000),
+				},
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "foo"}, {PoolId: 1, TokenInDenom: "bar"}},
+					TokenOutAmount: sdk.NewInt(50000),
+				},
+			},
+			tokenInMaxAmount: sdk.NewInt(90000000),
+			expectPass:       true,
+		},
+		{
+			name: "total amount in above the max amount",
+			routes: []types.SwapAmountOutSplitRoute{
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}},
+					TokenOutAmount: sdk.NewInt(100000),
+				},
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "foo"}},
+					TokenOutAmount: sdk.NewInt(100000),
+				},
+			},
+			// each route requires more than 100000 foo, which is less than the total
+			tokenInMaxAmount: sdk.NewInt(400000),
+			expectPass:       false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPool()
+			suite.PrepareBalancerPool()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+
+			// the split route swap must match swapping each route on its own
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenInAmount := sdk.ZeroInt()
+			for _, route := range test.routes {
+				routeTokenInAmount, err := keeper.MultihopSwapExactAmountOut(cacheCtx, sender, route.Pools, test.tokenInMaxAmount, sdk.NewCoin("baz", route.TokenOutAmount), types.SwapGuards{})
+				suite.Require().NoError(err)
+				expectedTokenInAmount = expectedTokenInAmount.Add(routeTokenInAmount)
+			}
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			tokenInAmount, err := keeper.SplitRouteSwapExactAmountOut(suite.Ctx, sender, test.routes, "baz", test.tokenInMaxAmount)
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenInAmount, tokenInAmount)
+
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(tokenInAmount, balancesBefore.AmountOf("foo").Sub(balancesAfter.AmountOf("foo")))
+			suite.Require().Equal(types.SwapAmountOutSplitRoutes(test.routes).TokenOutAmount(), balancesAfter.AmountOf("baz").Sub(balancesBefore.AmountOf("baz")))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultihopSwapGuards() {
+	smallPriceImpact := sdk.NewDecWithPrec(1, 3)
+	largePriceImpact := sdk.NewDecWithPrec(5, 1)
+	pastDeadline := func() *time.Time {
+		deadline := suite.Ctx.BlockTime().Add(-time.Second)
+		return &deadline
+	}
+	currentDeadline := func() *time.Time {
+		deadline := suite.Ctx.BlockTime()
+		return &deadline
+	}
+
+	tests := []struct {
+		name        string
+		guards      func() types.SwapGuards
+		expectedErr error
+	}{
+		{
+			name:   "no guards",
+			guards: func() types.SwapGuards { return types.SwapGuards{} },
+		},
+		{
+			name:   "deadline not exceeded",
+			guards: func() types.SwapGuards { return types.SwapGuards{Deadline: currentDeadline()} },
+		},
+		{
+			name:        "deadline exceeded",
+			guards:      func() types.SwapGuards { return types.SwapGuards{Deadline: pastDeadline()} },
+			expectedErr: types.ErrSwapDeadlineExceeded,
+		},
+		{
+			name:   "price impact within the max",
+			guards: func() types.SwapGuards { return types.SwapGuards{MaxPriceImpact: &largePriceImpact} },
+		},
+		{
+			name:        "price impact over the max",
+			guards:      func() types.SwapGuards { return types.SwapGuards{MaxPriceImpact: &smallPriceImpact} },
+			expectedErr: types.ErrPriceImpactTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPool()
+			suite.PrepareBalancerPool()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+			guards := test.guards()
+
+			_, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+			}, sdk.NewInt64Coin("foo", 100000), sdk.OneInt(), guards)
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			_, err = keeper.MultihopSwapExactAmountOut(suite.Ctx, sender, []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: "foo"},
+				{PoolId: 2, TokenInDenom: "bar"},
+			}, sdk.NewInt(1000000), sdk.NewInt64Coin("baz", 100000), guards)
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
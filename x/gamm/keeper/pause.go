@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// GetPoolPauseStatus returns which operations of a pool are paused.
+func (k Keeper) GetPoolPauseStatus(ctx sdk.Context, poolId uint64) types.PoolPauseStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolPauseStatus(poolId))
+	if bz == nil {
+		return types.PoolPauseStatus{PoolId: poolId}
+	}
+
+	var status types.PoolPauseStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return status
+}
+
+// GetAllPoolPauseStatuses returns the pause statuses of all pools with paused
+// operations.
+func (k Keeper) GetAllPoolPauseStatuses(ctx sdk.Context) []types.PoolPauseStatus {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolPauseStatus)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	statuses := []types.PoolPauseStatus{}
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.PoolPauseStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// SetPoolPauseStatus sets which operations of a pool are paused.
+// The circuit breaker reference spot prices of the pool are reset, so that
+// price moves from before the pause status changed don't trip it again.
+func (k Keeper) SetPoolPauseStatus(ctx sdk.Context, status types.PoolPauseStatus) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPoolPauseStatus(status.PoolId)
+	if status.SwapsPaused || status.JoinsPaused || status.ExitsPaused {
+		store.Set(key, k.cdc.MustMarshal(&status))
+	} else {
+		store.Delete(key)
+	}
+	k.deleteCircuitBreakerReferences(ctx, status.PoolId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolPauseStatusChanged,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(status.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeySwapsPaused, strconv.FormatBool(status.SwapsPaused)),
+		sdk.NewAttribute(types.AttributeKeyJoinsPaused, strconv.FormatBool(status.JoinsPaused)),
+		sdk.NewAttribute(types.AttributeKeyExitsPaused, strconv.FormatBool(status.ExitsPaused)),
+	))
+}
+
+// PausePool pauses the swaps, joins or exits of a pool on behalf of the pause
+// guardian. Operations that are already paused stay paused, so the pause
+// guardian can't unpause pools.
+func (k Keeper) PausePool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, pauseSwaps, pauseJoins, pauseExits bool) error {
+	guardian := k.GetParams(ctx).PauseGuardian
+	if guardian == "" || guardian != sender.String() {
+		return sdkerrors.Wrapf(types.ErrNotPauseGuardian, "sender %s", sender)
+	}
+
+	if _, err := k.GetPoolAndPoke(ctx, poolId); err != nil {
+		return err
+	}
+
+	status := k.GetPoolPauseStatus(ctx, poolId)
+	status.SwapsPaused = status.SwapsPaused || pauseSwaps
+	status.JoinsPaused = status.JoinsPaused || pauseJoins
+	status.ExitsPaused = status.ExitsPaused || pauseExits
+	k.SetPoolPauseStatus(ctx, status)
+	return nil
+}
+
+// HandleSetPoolPauseStatusProposal sets the pause status of a pool, pausing or
+// unpausing its swaps, joins and exits.
+func (k Keeper) HandleSetPoolPauseStatusProposal(ctx sdk.Context, p *types.SetPoolPauseStatusProposal) error {
+	if _, err := k.GetPoolAndPoke(ctx, p.PauseStatus.PoolId); err != nil {
+		return err
+	}
+
+	k.SetPoolPauseStatus(ctx, p.PauseStatus)
+	return nil
+}
+
+func (k Keeper) ensureJoinsNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.GetPoolPauseStatus(ctx, poolId).JoinsPaused {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "joins to pool %d are paused", poolId)
+	}
+	return k.ensureNotFlashSwapLocked(ctx, poolId)
+}
+
+func (k Keeper) ensureExitsNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.GetPoolPauseStatus(ctx, poolId).ExitsPaused {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "exits from pool %d are paused", poolId)
+	}
+	return k.ensureNotFlashSwapLocked(ctx, poolId)
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestPausePool() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	guardian := suite.TestAccs[1]
+	sender := suite.TestAccs[0]
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+	swap := func() error {
+		_, err := keeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoin("foo", sdk.NewInt(10)), "bar", sdk.OneInt())
+		return err
+	}
+	join := func() error {
+		_, err := keeper.JoinPoolNoSwap(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+		return err
+	}
+	exit := func() error {
+		_, err := keeper.ExitPool(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+		return err
+	}
+
+	// no pause guardian is set by default
+	err := keeper.PausePool(suite.Ctx, guardian, poolId, true, false, false)
+	suite.Require().ErrorIs(err, types.ErrNotPauseGuardian)
+
+	params := keeper.GetParams(suite.Ctx)
+	params.PauseGuardian = guardian.String()
+	keeper.SetParams(suite.Ctx, params)
+
+	err = keeper.PausePool(suite.Ctx, sender, poolId, true, false, false)
+	suite.Require().ErrorIs(err, types.ErrNotPauseGuardian)
+
+	err = keeper.PausePool(suite.Ctx, guardian, poolId+1, true, false, false)
+	suite.Require().Error(err)
+
+	// pause swaps
+	err = keeper.PausePool(suite.Ctx, guardian, poolId, true, false, false)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(swap(), types.ErrPoolPaused)
+	suite.Require().NoError(join())
+	suite.Require().NoError(exit())
+
+	// pausing joins keeps swaps paused
+	err = keeper.PausePool(suite.Ctx, guardian, poolId, false, true, false)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(swap(), types.ErrPoolPaused)
+	suite.Require().ErrorIs(join(), types.ErrPoolPaused)
+	suite.Require().NoError(exit())
+
+	err = keeper.PausePool(suite.Ctx, guardian, poolId, false, false, true)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(exit(), types.ErrPoolPaused)
+	suite.Require().Equal(types.PoolPauseStatus{
+		PoolId:      poolId,
+		SwapsPaused: true,
+		JoinsPaused: true,
+		ExitsPaused: true,
+	}, keeper.GetPoolPauseStatus(suite.Ctx, poolId))
+	suite.Require().Len(keeper.GetAllPoolPauseStatuses(suite.Ctx), 1)
+
+	// governance unpauses the pool
+	err = keeper.HandleSetPoolPauseStatusProposal(suite.Ctx, &types.SetPoolPauseStatusProposal{
+		Title:       "unpause",
+		Description: "unpause",
+		PauseStatus: types.PoolPauseStatus{PoolId: poolId},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(swap())
+	suite.Require().NoError(join())
+	suite.Require().NoError(exit())
+	suite.Require().Empty(keeper.GetAllPoolPauseStatuses(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	testCases := []struct {
+		name string
+		// amounts of foo swapped in for bar, in consecutive blocks
+		swapAmounts []int64
+		// whether the window is over before the last swap
+		windowOver bool
+		// the swap after which the pool is paused, -1 if it isn't
+		expectedPausedAfter int
+	}{
+		{
+			name:                "small swap",
+			swapAmounts:         []int64{100},
+			expectedPausedAfter: -1,
+		},
+		{
+			name:                "large swap",
+			swapAmounts:         []int64{1000},
+			expectedPausedAfter: 0,
+		},
+		{
+			name:                "small swaps adding up within the window",
+			swapAmounts:         []int64{100, 100, 100},
+			expectedPausedAfter: 2,
+		},
+		{
+			name:                "small swaps adding up over two windows",
+			swapAmounts:         []int64{100, 100, 100},
+			windowOver:          true,
+			expectedPausedAfter: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+			params := keeper.GetParams(suite.Ctx)
+			params.CircuitBreakerMaxPriceChange = sdk.NewDecWithPrec(5, 2)
+			params.CircuitBreakerWindowBlocks = 10
+			keeper.SetParams(suite.Ctx, params)
+
+			for i, amount := range tc.swapAmounts {
+				blocks := int64(1)
+				if tc.windowOver && i == len(tc.swapAmounts)-1 {
+					blocks = int64(params.CircuitBreakerWindowBlocks)
+				}
+				suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + blocks)
+
+				_, err := keeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoin("foo", sdk.NewInt(amount)), "bar", sdk.OneInt())
+				suite.Require().NoError(err)
+
+				status := keeper.GetPoolPauseStatus(suite.Ctx, poolId)
+				expectPaused := tc.expectedPausedAfter != -1 && i >= tc.expectedPausedAfter
+				suite.Require().Equal(expectPaused, status.SwapsPaused, "swap %d", i)
+				suite.Require().Equal(expectPaused, status.JoinsPaused, "swap %d", i)
+				suite.Require().Equal(expectPaused, status.ExitsPaused, "swap %d", i)
+			}
+
+			if tc.expectedPausedAfter != -1 {
+				_, err := keeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoin("bar", sdk.NewInt(100)), "foo", sdk.OneInt())
+				suite.Require().ErrorIs(err, types.ErrPoolPaused)
+			}
+		})
+	}
+}
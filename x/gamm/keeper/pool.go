@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -43,7 +44,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	return pool, nil
 }
 
-// Get pool, and check if the pool is active / allowed to be swapped against
+// Get pool, and check if the pool is active and its swaps aren't paused / allowed to be swapped against
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -53,6 +54,14 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	if !pool.IsActive(ctx) {
 		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
 	}
+
+	if k.GetPoolPauseStatus(ctx, poolId).SwapsPaused {
+		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolPaused, "swaps on pool %d are paused", poolId)
+	}
+
+	if err := k.ensureNotFlashSwapLocked(ctx, poolId); err != nil {
+		return &balancer.Pool{}, err
+	}
 	return pool, nil
 }
 
@@ -247,9 +256,113 @@ func (k *Keeper) SetStableSwapScalingFactors(ctx sdk.Context, scalingFactors []u
 	}
 
 	stableswapPool.ScalingFactor = scalingFactors
+	// setting the scaling factors overrides any ongoing ramp
+	stableswapPool.ScalingFactorRamp = nil
 
 	if err = k.SetPool(ctx, stableswapPool); err != nil {
 		return err
 	}
 	return nil
 }
+
+// StartStableSwapScalingFactorRamp starts changing the ScalingFactors of a
+// stableswap pool linearly to targetScalingFactors over duration, from startTime.
+func (k *Keeper) StartStableSwapScalingFactorRamp(
+	ctx sdk.Context,
+	poolId uint64,
+	scalingFactorGovernor string,
+	targetScalingFactors []uint64,
+	startTime time.Time,
+	duration time.Duration,
+) error {
+	stableswapPool, err := k.getStableSwapPoolForGovernor(ctx, poolId, scalingFactorGovernor)
+	if err != nil {
+		return err
+	}
+
+	err = stableswapPool.StartScalingFactorRamp(targetScalingFactors, startTime, duration, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, stableswapPool)
+}
+
+// CancelStableSwapScalingFactorRamp stops the scaling factor ramp of a
+// stableswap pool, keeping the ScalingFactors it reached.
+func (k *Keeper) CancelStableSwapScalingFactorRamp(ctx sdk.Context, poolId uint64, scalingFactorGovernor string) error {
+	stableswapPool, err := k.getStableSwapPoolForGovernor(ctx, poolId, scalingFactorGovernor)
+	if err != nil {
+		return err
+	}
+
+	if err = stableswapPool.CancelScalingFactorRamp(ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, stableswapPool)
+}
+
+func (k *Keeper) getStableSwapPoolForGovernor(ctx sdk.Context, poolId uint64, scalingFactorGovernor string) (*stableswap.Pool, error) {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	stableswapPool, ok := poolI.(*stableswap.Pool)
+	if !ok {
+		return nil, types.ErrNotStableSwapPool
+	}
+
+	if scalingFactorGovernor != stableswapPool.ScalingFactorGovernor {
+		return nil, types.ErrNotScalingFactorGovernor
+	}
+
+	return stableswapPool, nil
+}
+
+// UpdateBalancerPoolParams updates the fees of a balancer pool and schedules a
+// new weight change for it. Only a pool governor set to an address can update
+// its pool, and fees that are nil keep their current value.
+func (k Keeper) UpdateBalancerPoolParams(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	swapFee, exitFee *sdk.Dec,
+	smoothWeightChangeParams *balancer.SmoothWeightChangeParams,
+) (balancer.PoolParams, error) {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return balancer.PoolParams{}, err
+	}
+
+	balancerPool, ok := poolI.(*balancer.Pool)
+	if !ok {
+		return balancer.PoolParams{}, types.ErrNotBalancerPool
+	}
+
+	// governors set to an lp token lock can't sign messages
+	governor, err := sdk.AccAddressFromBech32(balancerPool.FuturePoolGovernor)
+	if err != nil || !governor.Equals(sender) {
+		return balancer.PoolParams{}, sdkerrors.Wrapf(types.ErrNotPoolGovernor, "pool %d", poolId)
+	}
+
+	newSwapFee := balancerPool.PoolParams.SwapFee
+	if swapFee != nil {
+		newSwapFee = *swapFee
+	}
+	newExitFee := balancerPool.PoolParams.ExitFee
+	if exitFee != nil {
+		newExitFee = *exitFee
+	}
+
+	err = balancerPool.UpdatePoolParams(newSwapFee, newExitFee, smoothWeightChangeParams, ctx.BlockTime())
+	if err != nil {
+		return balancer.PoolParams{}, err
+	}
+
+	if err = k.SetPool(ctx, balancerPool); err != nil {
+		return balancer.PoolParams{}, err
+	}
+	return balancerPool.PoolParams, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// indexPoolDenoms adds the pool to the denom index of each of denoms.
+// It is only called when the pool is created, as the assets of a pool don't
+// change once it is created, so pools are never removed from the index
+// either, and swaps, joins and exits don't pay for indexing.
+func (k Keeper) indexPoolDenoms(ctx sdk.Context, poolId uint64, denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Set(types.GetKeyDenomPool(denom, poolId), []byte{})
+	}
+}
+
+// isPoolIndexedWithDenom returns whether the pool contains denom according to
+// the denom index.
+func (k Keeper) isPoolIndexedWithDenom(ctx sdk.Context, poolId uint64, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyDenomPool(denom, poolId))
+}
+
+// GetPoolIdsWithDenom returns the ids of the pools containing denom, in
+// increasing order.
+func (k Keeper) GetPoolIdsWithDenom(ctx sdk.Context, denom string) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDenomPools(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return poolIds
+}
+
+// IndexExistingPools adds all pools to the denom index. It is used to build
+// the index for pools created before it was introduced.
+func (k Keeper) IndexExistingPools(ctx sdk.Context) error {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		k.indexPoolDenoms(ctx, pool.GetId(), poolDenoms(ctx, pool))
+	}
+	return nil
+}
+
+// poolDenoms returns the denoms of the assets of a pool.
+func poolDenoms(ctx sdk.Context, pool types.PoolI) []string {
+	// concentrated liquidity pools have no liquidity until a position is created
+	if concentratedPool, ok := pool.(*concentrated.Pool); ok {
+		return []string{concentratedPool.Token0, concentratedPool.Token1}
+	}
+
+	denoms := []string{}
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		denoms = append(denoms, coin.Denom)
+	}
+	return denoms
+}
+
+// GetPoolType returns the type of a pool, which is one of balancer, stableswap
+// or concentrated.
+func GetPoolType(pool types.PoolI) string {
+	switch pool.(type) {
+	case *balancer.Pool:
+		return types.PoolTypeBalancer
+	case *stableswap.Pool:
+		return types.PoolTypeStableswap
+	case *concentrated.Pool:
+		return types.PoolTypeConcentrated
+	default:
+		return ""
+	}
+}
@@ -6,7 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
@@ -21,6 +20,11 @@ func (k Keeper) CalculateSpotPrice(
 	baseAssetDenom string,
 	quoteAssetDenom string,
 ) (sdk.Dec, error) {
+	// the stored reserves of a pool are stale during a flash swap through it
+	if err := k.ensureNotFlashSwapLocked(ctx, poolID); err != nil {
+		return sdk.Dec{}, err
+	}
+
 	pool, err := k.GetPoolAndPoke(ctx, poolID)
 	if err != nil {
 		return sdk.Dec{}, err
@@ -81,6 +85,18 @@ func (k Keeper) validateCreatedPool(
 	return nil
 }
 
+// createPoolAccount creates and saves the pool's module account to the account keeper.
+func (k Keeper) createPoolAccount(ctx sdk.Context, pool types.PoolI) {
+	acc := k.accountKeeper.NewAccount(
+		ctx,
+		authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(pool.GetAddress()),
+			pool.GetAddress().String(),
+		),
+	)
+	k.accountKeeper.SetAccount(ctx, acc)
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or
 // an error upon failure. The pool creation fee is used to fund the community
 // pool. It will create a dedicated module account for the pool and sends the
@@ -115,15 +131,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
-	// create and save the pool's module account to the account keeper
-	acc := k.accountKeeper.NewAccount(
-		ctx,
-		authtypes.NewModuleAccount(
-			authtypes.NewBaseAccountWithAddress(pool.GetAddress()),
-			pool.GetAddress().String(),
-		),
-	)
-	k.accountKeeper.SetAccount(ctx, acc)
+	k.createPoolAccount(ctx, pool)
 
 	// send initial liquidity to the pool
 	err = k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), initialPoolLiquidity)
@@ -138,31 +146,12 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 	}
 
 	// Finally, add the share token's meta data to the bank keeper.
-	poolShareBaseDenom := types.GetPoolShareDenom(pool.GetId())
-	poolShareDisplayDenom := fmt.Sprintf("GAMM-%d", pool.GetId())
-	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-		Description: fmt.Sprintf("The share token of the gamm pool %d", pool.GetId()),
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    poolShareBaseDenom,
-				Exponent: 0,
-				Aliases: []string{
-					"attopoolshare",
-				},
-			},
-			{
-				Denom:    poolShareDisplayDenom,
-				Exponent: types.OneShareExponent,
-				Aliases:  nil,
-			},
-		},
-		Base:    poolShareBaseDenom,
-		Display: poolShareDisplayDenom,
-	})
+	k.setPoolShareDenomMetadata(ctx, pool)
 
 	if err := k.SetPool(ctx, pool); err != nil {
 		return 0, err
 	}
+	k.indexPoolDenoms(ctx, pool.GetId(), poolDenoms(ctx, pool))
 
 	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
@@ -176,36 +165,41 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 //
 // JoinPoolNoSwap determines the maximum amount that can be LP'd without any swap,
 // by looking at the ratio of the total LP'd assets. (e.g. 2 osmo : 1 atom)
-// It then finds the maximal amount that can be LP'd.
+// It then finds the maximal amount that can be LP'd, and returns the number of
+// LP shares actually minted, which rounding can make slightly less than shareOutAmount.
 func (k Keeper) JoinPoolNoSwap(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	poolId uint64,
 	shareOutAmount sdk.Int,
 	tokenInMaxs sdk.Coins,
-) (err error) {
+) (sharesOut sdk.Int, err error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
-		return err
+		return sdk.Int{}, err
+	}
+
+	if err := k.ensureJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
 	}
 
 	neededLpLiquidity, err := getMaximalNoSwapLPAmount(ctx, pool, shareOutAmount)
 	if err != nil {
-		return err
+		return sdk.Int{}, err
 	}
 
 	// if neededLPLiquidity >= tokenInMaxs, return err
 	// if tokenInMaxs == 0, don't do this check.
 	if tokenInMaxs.Len() != 0 {
 		if !(neededLpLiquidity.DenomsSubsetOf(tokenInMaxs) && tokenInMaxs.IsAllGTE(neededLpLiquidity)) {
-			return sdkerrors.Wrapf(types.ErrLimitMaxAmount, "TokenInMaxs is less than the needed LP liquidity to this JoinPoolNoSwap,"+
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "TokenInMaxs is less than the needed LP liquidity to this JoinPoolNoSwap,"+
 				" upperbound: %v, needed %v", tokenInMaxs, neededLpLiquidity)
 		}
 	}
 
-	sharesOut, err := pool.JoinPool(ctx, neededLpLiquidity, pool.GetSwapFee(ctx))
+	sharesOut, err = pool.JoinPool(ctx, neededLpLiquidity, pool.GetSwapFee(ctx))
 	if err != nil {
-		return err
+		return sdk.Int{}, err
 	}
 	// sanity check, don't return error as not worth halting the LP. We know its not too much.
 	if sharesOut.LT(shareOutAmount) {
@@ -214,11 +208,17 @@ func (k Keeper) JoinPoolNoSwap(
 	}
 
 	err = k.applyJoinPoolStateChange(ctx, pool, sender, sharesOut, neededLpLiquidity)
-	return err
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return sharesOut, nil
 }
 
 func getMaximalNoSwapLPAmount(ctx sdk.Context, pool types.PoolI, shareOutAmount sdk.Int) (neededLpLiquidity sdk.Coins, err error) {
 	totalSharesAmount := pool.GetTotalShares()
+	if !totalSharesAmount.IsPositive() {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrNotImplemented, "pool %d does not issue LP shares", pool.GetId())
+	}
 	// shareRatio is the desired number of shares, divided by the total number of
 	// shares currently in the pool. It is intended to be used in scenarios where you want
 	// (tokens per share) * number of shares out = # tokens * (# shares out / cur total shares)
@@ -259,6 +259,10 @@ func (k Keeper) JoinSwapExactAmountIn(
 		return sdk.Int{}, err
 	}
 
+	if err := k.ensureJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
 	sharesOut, err := pool.JoinPool(ctx, tokensIn, pool.GetSwapFee(ctx))
 	switch {
 	case err != nil:
@@ -295,6 +299,10 @@ func (k Keeper) JoinSwapShareAmountOut(
 		return sdk.Int{}, err
 	}
 
+	if err := k.ensureJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of join", poolId)
@@ -331,6 +339,10 @@ func (k Keeper) ExitPool(
 		return sdk.Coins{}, err
 	}
 
+	if err := k.ensureExitsNotPaused(ctx, poolId); err != nil {
+		return sdk.Coins{}, err
+	}
+
 	totalSharesAmount := pool.GetTotalShares()
 	if shareInAmount.GTE(totalSharesAmount) || shareInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
@@ -401,6 +413,10 @@ func (k Keeper) ExitSwapExactAmountOut(
 		return sdk.Int{}, err
 	}
 
+	if err := k.ensureExitsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", poolId)
@@ -417,3 +433,60 @@ func (k Keeper) ExitSwapExactAmountOut(
 
 	return shareInAmount, nil
 }
+
+// MigrateShares exits shareInAmount shares of the pool fromPoolId and joins the
+// pool toPoolId with all of the exited tokens, in a single state transition.
+// The tokens exited must all be assets of the pool being joined. If they are
+// only some of its assets, they are joined one at a time with single asset
+// joins, as pools only join multiple assets at once when given all of them.
+func (k Keeper) MigrateShares(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	fromPoolId uint64,
+	toPoolId uint64,
+	shareInAmount sdk.Int,
+	shareOutMinAmount sdk.Int,
+) (shareOutAmount sdk.Int, err error) {
+	if fromPoolId == toPoolId {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrMigrateToSamePool, "pool id %d", fromPoolId)
+	}
+
+	toPool, err := k.getPoolForSwap(ctx, toPoolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	exitCoins, err := k.ExitPool(ctx, sender, fromPoolId, shareInAmount, sdk.Coins{})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !exitCoins.DenomsSubsetOf(toPool.GetTotalPoolLiquidity(ctx)) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrMigrateDenomMismatch,
+			"exited %s from pool %d, pool %d has %s",
+			exitCoins, fromPoolId, toPoolId, toPool.GetTotalPoolLiquidity(ctx))
+	}
+
+	// Joining with all the exited tokens joins at the pool ratio as much as
+	// possible, and single asset joins the remainder.
+	if len(exitCoins) == len(toPool.GetTotalPoolLiquidity(ctx)) {
+		return k.JoinSwapExactAmountIn(ctx, sender, toPoolId, exitCoins, shareOutMinAmount)
+	}
+
+	shareOutAmount = sdk.ZeroInt()
+	for _, coin := range exitCoins {
+		sharesOut, err := k.JoinSwapExactAmountIn(ctx, sender, toPoolId, sdk.Coins{coin}, sdk.ZeroInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		shareOutAmount = shareOutAmount.Add(sharesOut)
+	}
+	if shareOutAmount.LT(shareOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(
+			types.ErrLimitMinAmount,
+			"too much slippage; needed a minimum of %s shares to pass, got %s",
+			shareOutMinAmount, shareOutAmount,
+		)
+	}
+	return shareOutAmount, nil
+}
@@ -5,9 +5,12 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -210,9 +213,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
-			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
-			})
+			keeper.SetParams(suite.Ctx, types.NewParams(sdk.Coins{}))
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -226,9 +227,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
-			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: nil,
-			})
+			keeper.SetParams(suite.Ctx, types.NewParams(nil))
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -262,7 +261,7 @@ func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 			fn: func(poolId uint64) {
 				keeper := suite.App.GAMMKeeper
 				balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1])
-				err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{})
+				_, err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{})
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.OneShare.MulRaw(50).String(), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], "gamm/pool/1").Amount.String())
 				balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1])
@@ -280,14 +279,14 @@ func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 		{
 			fn: func(poolId uint64) {
 				keeper := suite.App.GAMMKeeper
-				err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt(0), sdk.Coins{})
+				_, err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt(0), sdk.Coins{})
 				suite.Require().Error(err, "can't join the pool with requesting 0 share amount")
 			},
 		},
 		{
 			fn: func(poolId uint64) {
 				keeper := suite.App.GAMMKeeper
-				err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt(-1), sdk.Coins{})
+				_, err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt(-1), sdk.Coins{})
 				suite.Require().Error(err, "can't join the pool with requesting negative share amount")
 			},
 		},
@@ -296,7 +295,7 @@ func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 				keeper := suite.App.GAMMKeeper
 				// Test the "tokenInMaxs"
 				// In this case, to get the 50 * OneShare amount of share token, the foo, bar token are expected to be provided as 5000 amounts.
-				err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{
+				_, err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{
 					sdk.NewCoin("bar", sdk.NewInt(4999)), sdk.NewCoin("foo", sdk.NewInt(4999)),
 				})
 				suite.Require().Error(err)
@@ -307,7 +306,7 @@ func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 				keeper := suite.App.GAMMKeeper
 				// Test the "tokenInMaxs"
 				// In this case, to get the 50 * OneShare amount of share token, the foo, bar token are expected to be provided as 5000 amounts.
-				err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{
+				_, err := keeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(50), sdk.Coins{
 					sdk.NewCoin("bar", sdk.NewInt(5000)), sdk.NewCoin("foo", sdk.NewInt(5000)),
 				})
 				suite.Require().NoError(err)
@@ -456,7 +455,7 @@ func (suite *KeeperTestSuite) TestActiveBalancerPool() {
 			suite.Ctx = suite.Ctx.WithBlockTime(tc.blockTime)
 
 			// uneffected by start time
-			err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], poolId, types.OneShare.MulRaw(50), sdk.Coins{})
+			_, err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], poolId, types.OneShare.MulRaw(50), sdk.Coins{})
 			suite.Require().NoError(err)
 			_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], poolId, types.InitPoolSharesSupply.QuoRaw(2), sdk.Coins{})
 			suite.Require().NoError(err)
@@ -574,36 +573,374 @@ func (suite *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 	}
 }
 
-// func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
-// 	stableSwapPoolParams := stableswap.PoolParams{
-// 		SwapFee: defaultSwapFee,
-// 		ExitFee: defaultExitFee,
-// 	}
+func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
+	stableSwapPoolParams := stableswap.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}
+
+	testPoolAsset := sdk.Coins{
+		sdk.NewCoin("foo", sdk.NewInt(10000)),
+		sdk.NewCoin("bar", sdk.NewInt(10000)),
+	}
+
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+	testScalingFactors := []uint64{1, 1}
+
+	msg := stableswap.NewMsgCreateStableswapPool(
+		suite.TestAccs[0], stableSwapPoolParams, testPoolAsset, defaultFutureGovernor, "")
+	poolID, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	err = suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, testScalingFactors, poolID, "")
+	suite.Require().NoError(err)
+
+	poolI, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolID)
+	suite.Require().NoError(err)
+
+	poolScalingFactors := poolI.(*stableswap.Pool).GetScalingFactors()
+
+	suite.Require().Equal(
+		poolScalingFactors,
+		testScalingFactors,
+	)
+}
+
+func (suite *KeeperTestSuite) TestStableSwapScalingFactorRampMsgs() {
+	testCases := []struct {
+		name        string
+		senderIdx   int
+		startRamp   bool
+		cancelRamp  bool
+		expectedErr error
+		// scaling factors of the pool, ordered by denom, an hour after the
+		// messages
+		expectedScalingFactors []uint64
+	}{
+		{
+			name:                   "governor starts a ramp",
+			startRamp:              true,
+			expectedScalingFactors: []uint64{100, 200},
+		},
+		{
+			name:                   "governor cancels a ramp",
+			startRamp:              true,
+			cancelRamp:             true,
+			expectedScalingFactors: []uint64{100, 100},
+		},
+		{
+			name:        "sender is not the governor",
+			senderIdx:   1,
+			startRamp:   true,
+			expectedErr: types.ErrNotScalingFactorGovernor,
+		},
+		{
+			name:        "pool has no ramp to cancel",
+			cancelRamp:  true,
+			expectedErr: types.ErrNoScalingFactorRamp,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			governor := suite.TestAccs[0]
+			suite.FundAcc(governor, defaultAcctFunds)
+			// the messages are delivered through the app's router, which
+			// the stableswap Msg service must be registered on
+			deliver := func(msg sdk.Msg) error {
+				handler := suite.App.MsgServiceRouter().Handler(msg)
+				suite.Require().NotNil(handler)
+				_, err := handler(suite.Ctx, msg)
+				return err
+			}
+
+			createMsg := stableswap.NewMsgCreateStableswapPool(
+				governor,
+				stableswap.PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee},
+				sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10000)), sdk.NewCoin("bar", sdk.NewInt(10000))),
+				defaultFutureGovernor,
+				governor.String(),
+			)
+			res, err := keeper.NewStableswapMsgServerImpl(suite.App.GAMMKeeper).CreateStableswapPool(sdk.WrapSDKContext(suite.Ctx), &createMsg)
+			suite.Require().NoError(err)
+			poolId := res.PoolID
+			suite.Require().NoError(suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, []uint64{100, 100}, poolId, governor.String()))
+
+			sender := suite.TestAccs[tc.senderIdx].String()
+			if tc.startRamp {
+				err = deliver(&stableswap.MsgStableSwapStartScalingFactorRamp{
+					Sender:               sender,
+					PoolID:               poolId,
+					TargetScalingFactors: []uint64{100, 300},
+					Duration:             2 * time.Hour,
+				})
+			}
+			if err == nil && tc.cancelRamp {
+				err = deliver(&stableswap.MsgStableSwapCancelScalingFactorRamp{
+					Sender: sender,
+					PoolID: poolId,
+				})
+			}
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedScalingFactors, pool.(*stableswap.Pool).GetScalingFactors())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateShares() {
+	testCases := []struct {
+		name              string
+		fromPoolId        uint64
+		toPoolId          uint64
+		shareInAmount     sdk.Int
+		shareOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		{
+			name:              "migrate to a pool of the same assets",
+			fromPoolId:        1,
+			toPoolId:          2,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		{
+			name:              "share out min amount not met",
+			fromPoolId:        1,
+			toPoolId:          2,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: types.InitPoolSharesSupply,
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		{
+			name:              "migrate to a pool of other assets",
+			fromPoolId:        1,
+			toPoolId:          3,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrMigrateDenomMismatch,
+		},
+		{
+			name:              "migrate to a pool of more assets",
+			fromPoolId:        1,
+			toPoolId:          4,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		{
+			name:              "share out min amount of the joins of each asset not met",
+			fromPoolId:        1,
+			toPoolId:          4,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: types.InitPoolSharesSupply,
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		{
+			name:              "migrate to the same pool",
+			fromPoolId:        1,
+			toPoolId:          1,
+			shareInAmount:     types.InitPoolSharesSupply.QuoRaw(2),
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrMigrateToSamePool,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+
+			suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			suite.prepareCustomBalancerPool(defaultAcctFunds, []balancertypes.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(20000))},
+				{Weight: sdk.NewInt(300), Token: sdk.NewCoin("bar", sdk.NewInt(10000))},
+			}, balancer.PoolParams{SwapFee: defaultSwapFee, ExitFee: sdk.ZeroDec()})
+			suite.prepareCustomBalancerPool(defaultAcctFunds, []balancertypes.PoolAsset{
+				defaultFooAsset,
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("baz", sdk.NewInt(10000))},
+			}, defaultPoolParams)
+			suite.prepareCustomBalancerPool(defaultAcctFunds, []balancertypes.PoolAsset{
+				defaultFooAsset,
+				defaultBarAsset,
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("baz", sdk.NewInt(10000))},
+			}, defaultPoolParams)
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			shareOutAmount, err := keeper.MigrateShares(suite.Ctx, sender, tc.fromPoolId, tc.toPoolId, tc.shareInAmount, tc.shareOutMinAmount)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(shareOutAmount.IsPositive())
+
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			fromShareDenom := types.GetPoolShareDenom(tc.fromPoolId)
+			toShareDenom := types.GetPoolShareDenom(tc.toPoolId)
+			suite.Require().Equal(balancesBefore.AmountOf(fromShareDenom).Sub(tc.shareInAmount), balancesAfter.AmountOf(fromShareDenom))
+			suite.Require().Equal(balancesBefore.AmountOf(toShareDenom).Add(shareOutAmount), balancesAfter.AmountOf(toShareDenom))
+
+			// all of the exited tokens were joined into the new pool
+			suite.Require().Equal(balancesBefore.AmountOf("foo"), balancesAfter.AmountOf("foo"))
+			suite.Require().Equal(balancesBefore.AmountOf("bar"), balancesAfter.AmountOf("bar"))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateBalancerPoolParams() {
+	newSwapFee := sdk.MustNewDecFromStr("0.01")
+	newExitFee := sdk.ZeroDec()
+	weightChange := func() *balancer.SmoothWeightChangeParams {
+		return &balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancertypes.PoolAsset{
+				{Weight: sdk.NewInt(300), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                     string
+		governor                 func() string
+		senderIdx                int
+		swapFee                  *sdk.Dec
+		exitFee                  *sdk.Dec
+		smoothWeightChangeParams *balancer.SmoothWeightChangeParams
+		expectedErr              error
+	}{
+		{
+			name:     "governor updates the fees",
+			governor: func() string { return suite.TestAccs[0].String() },
+			swapFee:  &newSwapFee,
+			exitFee:  &newExitFee,
+		},
+		{
+			name:                     "governor schedules a weight change",
+			governor:                 func() string { return suite.TestAccs[0].String() },
+			smoothWeightChangeParams: weightChange(),
+		},
+		{
+			name:        "sender is not the governor",
+			governor:    func() string { return suite.TestAccs[0].String() },
+			senderIdx:   1,
+			swapFee:     &newSwapFee,
+			expectedErr: types.ErrNotPoolGovernor,
+		},
+		{
+			name:        "governor is an lp token lock",
+			governor:    func() string { return "168h" },
+			swapFee:     &newSwapFee,
+			expectedErr: types.ErrNotPoolGovernor,
+		},
+		{
+			name:        "swap fee too large",
+			governor:    func() string { return suite.TestAccs[0].String() },
+			swapFee:     func() *sdk.Dec { fee := sdk.OneDec(); return &fee }(),
+			expectedErr: types.ErrTooMuchSwapFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
 
-// 	testPoolAsset := sdk.Coins{
-// 		sdk.NewCoin("foo", sdk.NewInt(10000)),
-// 		sdk.NewCoin("bar", sdk.NewInt(10000)),
-// 	}
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
 
-// 	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+			poolId, err := keeper.CreatePool(
+				suite.Ctx,
+				balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, tc.governor()),
+			)
+			suite.Require().NoError(err)
 
-// 	testScalingFactors := []uint64{1, 1}
+			poolParams, err := keeper.UpdateBalancerPoolParams(
+				suite.Ctx, suite.TestAccs[tc.senderIdx], poolId, tc.swapFee, tc.exitFee, tc.smoothWeightChangeParams)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
 
-// 	msg := stableswap.NewMsgCreateStableswapPool(
-// 		suite.TestAccs[0], stableSwapPoolParams, testPoolAsset, defaultFutureGovernor)
-// 	poolID, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
-// 	suite.Require().NoError(err)
+			pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolParams, pool.(*balancer.Pool).PoolParams)
 
-// 	err = suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, testScalingFactors, poolID, "")
-// 	suite.Require().NoError(err)
+			expectedSwapFee, expectedExitFee := defaultSwapFee, defaultExitFee
+			if tc.swapFee != nil {
+				expectedSwapFee = *tc.swapFee
+			}
+			if tc.exitFee != nil {
+				expectedExitFee = *tc.exitFee
+			}
+			suite.Require().Equal(expectedSwapFee, poolParams.SwapFee)
+			suite.Require().Equal(expectedExitFee, poolParams.ExitFee)
 
-// 	poolI, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolID)
-// 	suite.Require().NoError(err)
+			if tc.smoothWeightChangeParams == nil {
+				suite.Require().Nil(poolParams.SmoothWeightChangeParams)
+				return
+			}
 
-// 	poolScalingFactors := poolI.(*stableswap.Pool).GetScalingFactors()
+			// the weights reach their targets once the weight change is over
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+			pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			fooWeight, err := pool.(*balancer.Pool).GetTokenWeight("foo")
+			suite.Require().NoError(err)
+			barWeight, err := pool.(*balancer.Pool).GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(barWeight.MulRaw(3), fooWeight)
+		})
+	}
+}
 
-// 	suite.Require().Equal(
-// 		poolScalingFactors,
-// 		testScalingFactors,
-// 	)
-// }
+func (suite *KeeperTestSuite) TestPoolShareDenomMetadata() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	suite.App.BankKeeper.SetDenomMetaData(suite.Ctx, banktypes.Metadata{
+		Base:    "foo",
+		Display: "FOO",
+		Symbol:  "FOO",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "foo", Exponent: 0},
+			{Denom: "FOO", Exponent: 6},
+		},
+	})
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	shareDenom := types.GetPoolShareDenom(poolId)
+
+	assertMetadata := func() {
+		metadata, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, shareDenom)
+		suite.Require().True(found)
+		suite.Require().Equal(shareDenom, metadata.Base)
+		suite.Require().Equal(fmt.Sprintf("GAMM-%d", poolId), metadata.Display)
+		suite.Require().Equal(fmt.Sprintf("GAMM-%d", poolId), metadata.Symbol)
+		// bar has no metadata, so its denom is used
+		suite.Require().Equal(fmt.Sprintf("bar/FOO pool %d shares", poolId), metadata.Name)
+		suite.Require().Len(metadata.DenomUnits, 2)
+		suite.Require().Equal(uint32(types.OneShareExponent), metadata.DenomUnits[1].Exponent)
+	}
+	assertMetadata()
+
+	// metadata registered before pools had names is backfilled
+	suite.App.BankKeeper.SetDenomMetaData(suite.Ctx, banktypes.Metadata{Base: shareDenom})
+	err := keeper.SetExistingPoolsShareDenomMetadata(suite.Ctx)
+	suite.Require().NoError(err)
+	assertMetadata()
+}
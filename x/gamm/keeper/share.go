@@ -1,13 +1,23 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool types.PoolI, joiner sdk.AccAddress, numShares sdk.Int, joinCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
+	err := k.hooks.BeforeJoinPool(ctx, joiner, pool.GetId(), joinCoins, numShares)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
 	if err != nil {
 		return err
 	}
@@ -29,7 +39,12 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool types.PoolI, join
 }
 
 func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool types.PoolI, exiter sdk.AccAddress, numShares sdk.Int, exitCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
+	err := k.hooks.BeforeExitPool(ctx, exiter, pool.GetId(), numShares, exitCoins)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
 	if err != nil {
 		return err
 	}
@@ -87,3 +102,72 @@ func (k Keeper) BurnPoolShareFromAccount(ctx sdk.Context, pool types.PoolI, addr
 
 	return nil
 }
+
+// setPoolShareDenomMetadata registers the x/bank metadata of the share denom
+// of a pool, gamm/pool/{poolID}, which is displayed as GAMM-{poolID} with 18
+// decimals. Its name is derived from the pool assets, using the symbol or
+// display denom of their own metadata when they have one.
+func (k Keeper) setPoolShareDenomMetadata(ctx sdk.Context, pool types.PoolI) {
+	assetNames := []string{}
+	for _, denom := range poolDenoms(ctx, pool) {
+		assetNames = append(assetNames, k.denomDisplayName(ctx, denom))
+	}
+
+	poolShareBaseDenom := types.GetPoolShareDenom(pool.GetId())
+	poolShareDisplayDenom := fmt.Sprintf("GAMM-%d", pool.GetId())
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the gamm pool %d", pool.GetId()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    poolShareBaseDenom,
+				Exponent: 0,
+				Aliases: []string{
+					"attopoolshare",
+				},
+			},
+			{
+				Denom:    poolShareDisplayDenom,
+				Exponent: types.OneShareExponent,
+				Aliases:  nil,
+			},
+		},
+		Base:    poolShareBaseDenom,
+		Display: poolShareDisplayDenom,
+		Name:    fmt.Sprintf("%s pool %d shares", strings.Join(assetNames, "/"), pool.GetId()),
+		Symbol:  poolShareDisplayDenom,
+	})
+}
+
+// denomDisplayName returns the symbol of denom, or its display denom if it
+// has no symbol, according to its x/bank metadata. denom itself is returned
+// if it has no metadata.
+func (k Keeper) denomDisplayName(ctx sdk.Context, denom string) string {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	switch {
+	case found && metadata.Symbol != "":
+		return metadata.Symbol
+	case found && metadata.Display != "":
+		return metadata.Display
+	default:
+		return denom
+	}
+}
+
+// SetExistingPoolsShareDenomMetadata registers the x/bank metadata of the
+// share denoms of all pools with shares. It is used to backfill the metadata
+// of pools created before it was introduced, or before it had a name.
+func (k Keeper) SetExistingPoolsShareDenomMetadata(ctx sdk.Context) error {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		// concentrated liquidity pools have no shares
+		if _, ok := pool.(*concentrated.Pool); ok {
+			continue
+		}
+		k.setPoolShareDenomMetadata(ctx, pool)
+	}
+	return nil
+}
@@ -13,7 +13,8 @@ import (
 // denominated via tokenOutDenom through a pool denoted by poolId specifying that
 // tokenOutMinAmount must be returned in the resulting asset returning an error
 // upon failure. Upon success, the resulting tokens swapped for are returned. A
-// swap fee is applied determined by the pool's parameters.
+// swap fee is applied determined by the pool's parameters, and the protocol's
+// taker fee is taken out of tokenIn before it is swapped.
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -44,12 +45,42 @@ func (k Keeper) swapExactAmountIn(
 	tokenOutMinAmount sdk.Int,
 	swapFee sdk.Dec,
 ) (tokenOutAmount sdk.Int, err error) {
+	return k.swapExactAmountInWithCallback(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee, nil)
+}
+
+// swapExactAmountInWithCallback swaps like swapExactAmountIn. If
+// flashSwapCallback is not nil, the tokens out are sent to the sender before
+// it is called, and the tokens in are only taken from the sender after it
+// returns.
+func (k Keeper) swapExactAmountInWithCallback(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+	flashSwapCallback FlashSwapCallback,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := tokenIn.Validate(); err != nil {
+		return sdk.Int{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
 	if tokenIn.Denom == tokenOutDenom {
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
+
+	// the params are read once per swap, for the circuit breaker and the taker fee
+	params := k.GetParams(ctx)
+	circuitBreakerReference, err := k.startCircuitBreakerWindow(ctx, params, pool, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	takerFee := takerFeeOfTokenIn(tokenIn, params.GetTakerFee(tokenIn.Denom, tokenOutDenom))
+	tokenIn = tokenIn.Sub(takerFee)
 	tokensIn := sdk.Coins{tokenIn}
 
-	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	tokenOutCoin, err := k.swapOutAmtGivenIn(ctx, pool, tokensIn, tokenOutDenom, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -64,7 +95,20 @@ func (k Keeper) swapExactAmountIn(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
 	}
 
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if flashSwapCallback == nil {
+		err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin, swapFee)
+	} else {
+		err = k.updatePoolForFlashSwap(ctx, pool, sender, tokenIn, tokenOutCoin, swapFee, flashSwapCallback)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.chargeTakerFee(ctx, params, sender, takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.checkCircuitBreaker(ctx, params, pool, tokenIn.Denom, tokenOutDenom, circuitBreakerReference); err != nil {
 		return sdk.Int{}, err
 	}
 
@@ -104,46 +148,69 @@ func (k Keeper) swapExactAmountOut(
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
 
+	// the params are read once per swap, for the circuit breaker and the taker fee
+	params := k.GetParams(ctx)
+	circuitBreakerReference, err := k.startCircuitBreakerWindow(ctx, params, pool, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	poolOutBal := pool.GetTotalPoolLiquidity(ctx).AmountOf(tokenOut.Denom)
 	if tokenOut.Amount.GTE(poolOutBal) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
 			"can't get more tokens out than there are tokens in the pool")
 	}
-	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	tokenIn, err := k.swapInAmtGivenOut(ctx, pool, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	tokenInAmount = tokenIn.Amount
 
-	if tokenInAmount.LTE(sdk.ZeroInt()) {
+	if tokenIn.Amount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
 
+	// the taker fee is charged on top of the tokens swapped into the pool
+	tokenInAmount = tokenInAmountWithTakerFee(tokenIn.Amount, params.GetTakerFee(tokenInDenom, tokenOut.Denom))
+	takerFee := sdk.NewCoin(tokenInDenom, tokenInAmount.Sub(tokenIn.Amount))
+
 	if tokenInAmount.GT(tokenInMaxAmount) {
-		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", sdk.NewCoin(tokenInDenom, tokenInAmount), tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+
+	if err = k.chargeTakerFee(ctx, params, sender, takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err = k.checkCircuitBreaker(ctx, params, pool, tokenInDenom, tokenOut.Denom, circuitBreakerReference); err != nil {
+		return sdk.Int{}, err
+	}
 	return tokenInAmount, nil
 }
 
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
+// The swap is added to the pool's volume, and the swapFee paid on tokenIn to its fees collected.
+// The BeforeSwap hook can abort the swap before anything is updated.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool types.PoolI,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) error {
-	tokensIn := sdk.Coins{tokenIn}
-	tokensOut := sdk.Coins{tokenOut}
+	err := k.hooks.BeforeSwap(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
+	if err != nil {
+		return err
+	}
 
-	err := k.SetPool(ctx, pool)
+	err = k.SetPool(ctx, pool)
 	if err != nil {
 		return err
 	}
@@ -162,10 +229,26 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	k.afterPoolUpdatedForSwap(ctx, pool, sender, tokenIn, tokenOut, swapFee)
+	return nil
+}
+
+// afterPoolUpdatedForSwap emits the swap event, calls the AfterSwap hook and
+// records the swap once its tokens were exchanged.
+func (k Keeper) afterPoolUpdatedForSwap(
+	ctx sdk.Context,
+	pool types.PoolI,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) {
+	tokensIn := sdk.Coins{tokenIn}
+	tokensOut := sdk.Coins{tokenOut}
+
 	ctx.EventManager().EmitEvent(types.CreateSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut))
 	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
-
-	return err
+	k.recordPoolVolume(ctx, pool.GetId(), tokenIn, tokenOut, swapFee)
 }
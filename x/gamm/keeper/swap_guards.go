@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// checkSwapDeadline returns an error if the block time is past the deadline
+// of the swap guards.
+func checkSwapDeadline(ctx sdk.Context, guards types.SwapGuards) error {
+	if guards.Deadline != nil && ctx.BlockTime().After(*guards.Deadline) {
+		return sdkerrors.Wrapf(types.ErrSwapDeadlineExceeded, "block time %s is past the deadline %s", ctx.BlockTime(), guards.Deadline)
+	}
+	return nil
+}
+
+// swapWithPriceImpactGuard runs swap, which swaps tokenInDenom for
+// tokenOutDenom in the pool, and returns an error if it moved the spot price
+// of the pool by more than the max price impact of the swap guards.
+func (k Keeper) swapWithPriceImpactGuard(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenInDenom string,
+	tokenOutDenom string,
+	guards types.SwapGuards,
+	swap func() error,
+) error {
+	if guards.MaxPriceImpact == nil {
+		return swap()
+	}
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	spotPriceBefore, err := pool.SpotPrice(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	err = swap()
+	if err != nil {
+		return err
+	}
+
+	pool, err = k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	spotPriceAfter, err := pool.SpotPrice(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	priceImpact := spotPriceAfter.Sub(spotPriceBefore).Abs().Quo(spotPriceBefore)
+	if priceImpact.GT(*guards.MaxPriceImpact) {
+		return sdkerrors.Wrapf(types.ErrPriceImpactTooLarge, "swap moves the spot price of pool %d by %s, more than %s", poolId, priceImpact, guards.MaxPriceImpact)
+	}
+	return nil
+}
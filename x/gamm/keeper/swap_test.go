@@ -77,6 +77,24 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleSwapExactAmountIn() {
 			},
 			expectPass: false,
 		},
+		{
+			name: "empty in denom",
+			param: param{
+				tokenIn:           sdk.Coin{Amount: sdk.NewInt(2451783)},
+				tokenOutDenom:     "bar",
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative in amount",
+			param: param{
+				tokenIn:           sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-100000)},
+				tokenOutDenom:     "bar",
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
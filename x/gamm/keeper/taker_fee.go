@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+// takerFeeOfTokenIn returns the taker fee taken out of tokenIn, before it is
+// swapped into a pool.
+func takerFeeOfTokenIn(tokenIn sdk.Coin, takerFee sdk.Dec) sdk.Coin {
+	return sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().Mul(takerFee).TruncateInt())
+}
+
+// tokenInAmountWithTakerFee returns the amount of tokens to swap in so that
+// poolTokenInAmount is left to swap into a pool once the taker fee is taken out.
+func tokenInAmountWithTakerFee(poolTokenInAmount sdk.Int, takerFee sdk.Dec) sdk.Int {
+	return poolTokenInAmount.ToDec().Quo(sdk.OneDec().Sub(takerFee)).Ceil().TruncateInt()
+}
+
+// chargeTakerFee sends the taker fee paid by sender to the recipient set in
+// params, and adds it to the taker fees collected.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, params types.Params, sender sdk.AccAddress, takerFee sdk.Coin) error {
+	if !takerFee.IsPositive() {
+		return nil
+	}
+
+	var err error
+	takerFees := sdk.Coins{takerFee}
+	if params.TakerFeeRecipient == types.Stakers && k.isConvertibleToStakerRewards(ctx, takerFee.Denom) {
+		// the txfees module swaps the fees it collects to the base denom
+		// and sends them to the fee collector at the end of each epoch
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, txfeestypes.NonNativeFeeCollectorName, takerFees)
+	} else {
+		err = k.distrKeeper.FundCommunityPool(ctx, takerFees, sender)
+	}
+	if err != nil {
+		return err
+	}
+
+	k.addPrefixedCoin(ctx, types.KeyPrefixTakerFeesCollected, takerFee)
+	return nil
+}
+
+// isConvertibleToStakerRewards returns whether fees in denom can be paid to stakers
+// by the txfees module, i.e. whether it is the base denom or a fee token.
+func (k Keeper) isConvertibleToStakerRewards(ctx sdk.Context, denom string) bool {
+	if k.txfeesKeeper == nil {
+		return false
+	}
+
+	baseDenom, err := k.txfeesKeeper.GetBaseDenom(ctx)
+	if err == nil && denom == baseDenom {
+		return true
+	}
+
+	_, err = k.txfeesKeeper.GetFeeToken(ctx, denom)
+	return err == nil
+}
+
+// GetTakerFeesCollected returns the taker fees collected from all swaps.
+func (k Keeper) GetTakerFeesCollected(ctx sdk.Context) sdk.Coins {
+	return k.getPrefixedCoins(ctx, types.KeyPrefixTakerFeesCollected)
+}
+
+// SetTakerFeesCollected sets the taker fees collected from all swaps.
+func (k Keeper) SetTakerFeesCollected(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setPrefixedCoin(ctx, types.KeyPrefixTakerFeesCollected, coin)
+	}
+}
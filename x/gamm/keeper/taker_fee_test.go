@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	balancertypes "github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapExactAmountInTakerFee() {
+	testCases := []struct {
+		name              string
+		takerFee          sdk.Dec
+		takerFeeOverrides []types.TakerFeeOverride
+		takerFeeRecipient types.TakerFeeRecipient
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		expectedTakerFee  sdk.Coin
+		// whether the taker fee is sent to the txfees module for stakers
+		expectedToStakers bool
+	}{
+		{
+			name:             "no taker fee",
+			takerFee:         sdk.ZeroDec(),
+			tokenIn:          sdk.NewCoin("foo", sdk.NewInt(10000)),
+			tokenOutDenom:    "bar",
+			expectedTakerFee: sdk.NewCoin("foo", sdk.ZeroInt()),
+		},
+		{
+			name:             "taker fee to the community pool",
+			takerFee:         sdk.NewDecWithPrec(1, 2),
+			tokenIn:          sdk.NewCoin("foo", sdk.NewInt(10000)),
+			tokenOutDenom:    "bar",
+			expectedTakerFee: sdk.NewCoin("foo", sdk.NewInt(100)),
+		},
+		{
+			name:     "taker fee override of the denom pair",
+			takerFee: sdk.NewDecWithPrec(1, 2),
+			takerFeeOverrides: []types.TakerFeeOverride{
+				{Denom0: "bar", Denom1: "foo", TakerFee: sdk.NewDecWithPrec(2, 2)},
+			},
+			tokenIn:          sdk.NewCoin("foo", sdk.NewInt(10000)),
+			tokenOutDenom:    "bar",
+			expectedTakerFee: sdk.NewCoin("foo", sdk.NewInt(200)),
+		},
+		{
+			name:              "taker fee in the base denom to stakers",
+			takerFee:          sdk.NewDecWithPrec(1, 2),
+			takerFeeRecipient: types.Stakers,
+			tokenIn:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)),
+			tokenOutDenom:     "foo",
+			expectedTakerFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+			expectedToStakers: true,
+		},
+		{
+			name:              "taker fee in a denom that isn't a fee token to stakers",
+			takerFee:          sdk.NewDecWithPrec(1, 2),
+			takerFeeRecipient: types.Stakers,
+			tokenIn:           sdk.NewCoin("foo", sdk.NewInt(10000)),
+			tokenOutDenom:     sdk.DefaultBondDenom,
+			expectedTakerFee:  sdk.NewCoin("foo", sdk.NewInt(100)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+
+			params := keeper.GetParams(suite.Ctx)
+			params.TakerFee = tc.takerFee
+			if tc.takerFeeOverrides != nil {
+				params.TakerFeeOverrides = tc.takerFeeOverrides
+			}
+			params.TakerFeeRecipient = tc.takerFeeRecipient
+			keeper.SetParams(suite.Ctx, params)
+			err := suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, sdk.DefaultBondDenom)
+			suite.Require().NoError(err)
+
+			poolId := suite.prepareCustomBalancerPool(
+				defaultAcctFunds.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000000))),
+				[]balancertypes.PoolAsset{
+					{Weight: sdk.NewInt(100), Token: sdk.NewCoin(tc.tokenIn.Denom, sdk.NewInt(1000000))},
+					{Weight: sdk.NewInt(100), Token: sdk.NewCoin(tc.tokenOutDenom, sdk.NewInt(1000000))},
+				},
+				defaultPoolParams)
+			pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+
+			nonNativeFeeCollector := suite.App.AccountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			stakersBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, nonNativeFeeCollector)
+			poolLiquidityBefore := pool.GetTotalPoolLiquidity(suite.Ctx)
+
+			_, err = keeper.SwapExactAmountIn(suite.Ctx, sender, poolId, tc.tokenIn, tc.tokenOutDenom, sdk.OneInt())
+			suite.Require().NoError(err)
+
+			// the pool only receives the tokens in left after the taker fee
+			pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			poolLiquidityAfter := pool.GetTotalPoolLiquidity(suite.Ctx)
+			suite.Require().Equal(
+				tc.tokenIn.Amount.Sub(tc.expectedTakerFee.Amount),
+				poolLiquidityAfter.AmountOf(tc.tokenIn.Denom).Sub(poolLiquidityBefore.AmountOf(tc.tokenIn.Denom)))
+
+			communityPoolIncrease := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).Sub(communityPoolBefore)
+			stakersIncrease := suite.App.BankKeeper.GetAllBalances(suite.Ctx, nonNativeFeeCollector).Sub(stakersBefore)
+			expectedTakerFees := sdk.NewCoins(tc.expectedTakerFee)
+			if tc.expectedToStakers {
+				suite.Require().Equal(expectedTakerFees.String(), stakersIncrease.String())
+				suite.Require().True(communityPoolIncrease.IsZero())
+			} else {
+				suite.Require().Equal(sdk.NewDecCoinsFromCoins(expectedTakerFees...).String(), communityPoolIncrease.String())
+				suite.Require().True(stakersIncrease.IsZero())
+			}
+
+			suite.Require().Equal(expectedTakerFees.String(), keeper.GetTakerFeesCollected(suite.Ctx).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOutTakerFee() {
+	keeper := suite.App.GAMMKeeper
+	sender := suite.TestAccs[0]
+
+	params := keeper.GetParams(suite.Ctx)
+	params.TakerFee = sdk.NewDecWithPrec(1, 2)
+	keeper.SetParams(suite.Ctx, params)
+
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+
+	tokenOut := sdk.NewCoin("bar", sdk.NewInt(100))
+	poolTokenIn, err := pool.CalcInAmtGivenOut(suite.Ctx, sdk.NewCoins(tokenOut), "foo", pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	// the taker fee is charged on top of the tokens swapped into the pool
+	expectedTokenInAmount := poolTokenIn.Amount.ToDec().Quo(sdk.NewDecWithPrec(99, 2)).Ceil().TruncateInt()
+
+	// the max amount in accounts for the taker fee
+	_, err = keeper.SwapExactAmountOut(suite.Ctx, sender, poolId, "foo", poolTokenIn.Amount, tokenOut)
+	suite.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	tokenInAmount, err := keeper.SwapExactAmountOut(suite.Ctx, sender, poolId, "foo", expectedTokenInAmount, tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenInAmount, tokenInAmount)
+
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	suite.Require().Equal(tokenInAmount, balanceBefore.Amount.Sub(balanceAfter.Amount))
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("foo", tokenInAmount.Sub(poolTokenIn.Amount))),
+		keeper.GetTakerFeesCollected(suite.Ctx))
+}
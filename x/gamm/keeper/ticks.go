@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// getTickStore returns the store of the initialized ticks of a concentrated
+// liquidity pool.
+func (k Keeper) getTickStore(ctx sdk.Context, poolId uint64) concentrated.TickStore {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolTicks(poolId))
+	return concentrated.NewKVTickStore(store)
+}
+
+// GetPoolTicks returns the initialized ticks of a concentrated liquidity
+// pool, sorted by index.
+func (k Keeper) GetPoolTicks(ctx sdk.Context, poolId uint64) []types.Tick {
+	return k.getTicks(ctx, types.GetKeyPrefixPoolTicks(poolId))
+}
+
+// GetAllTicks returns the initialized ticks of every concentrated liquidity pool.
+func (k Keeper) GetAllTicks(ctx sdk.Context) []types.Tick {
+	return k.getTicks(ctx, types.KeyPrefixTicks)
+}
+
+func (k Keeper) getTicks(ctx sdk.Context, prefix []byte) []types.Tick {
+	iter := k.iterator(ctx, prefix)
+	defer iter.Close()
+
+	ticks := []types.Tick{}
+	for ; iter.Valid(); iter.Next() {
+		tick := types.Tick{}
+		k.cdc.MustUnmarshal(iter.Value(), &tick)
+		ticks = append(ticks, tick)
+	}
+	return ticks
+}
+
+// calcOutAmtGivenIn returns the amount of tokenOutDenom the pool swaps out for
+// tokensIn, reading the ticks of concentrated liquidity pools from their store.
+func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context, pool types.PoolI, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if clPool, ok := pool.(*concentrated.Pool); ok {
+		return clPool.CalcOutAmtGivenInWithTicks(k.getTickStore(ctx, pool.GetId()), tokensIn, tokenOutDenom, swapFee)
+	}
+	return pool.CalcOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+}
+
+// swapOutAmtGivenIn swaps tokensIn against the pool for tokenOutDenom,
+// updating the ticks crossed by swaps of concentrated liquidity pools.
+func (k Keeper) swapOutAmtGivenIn(ctx sdk.Context, pool types.PoolI, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if clPool, ok := pool.(*concentrated.Pool); ok {
+		return clPool.SwapOutAmtGivenInWithTicks(k.getTickStore(ctx, pool.GetId()), tokensIn, tokenOutDenom, swapFee)
+	}
+	return pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+}
+
+// calcInAmtGivenOut returns the amount of tokenInDenom to swap in to the pool
+// for tokensOut, reading the ticks of concentrated liquidity pools from their store.
+func (k Keeper) calcInAmtGivenOut(ctx sdk.Context, pool types.PoolI, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if clPool, ok := pool.(*concentrated.Pool); ok {
+		return clPool.CalcInAmtGivenOutWithTicks(k.getTickStore(ctx, pool.GetId()), tokensOut, tokenInDenom, swapFee)
+	}
+	return pool.CalcInAmtGivenOut(ctx, tokensOut, tokenInDenom, swapFee)
+}
+
+// swapInAmtGivenOut swaps tokenInDenom against the pool for tokensOut,
+// updating the ticks crossed by swaps of concentrated liquidity pools.
+func (k Keeper) swapInAmtGivenOut(ctx sdk.Context, pool types.PoolI, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if clPool, ok := pool.(*concentrated.Pool); ok {
+		return clPool.SwapInAmtGivenOutWithTicks(k.getTickStore(ctx, pool.GetId()), tokensOut, tokenInDenom, swapFee)
+	}
+	return pool.SwapInAmtGivenOut(ctx, tokensOut, tokenInDenom, swapFee)
+}
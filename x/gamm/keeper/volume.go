@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// GetPoolVolume returns the cumulative swap volume of a pool, per denom.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) sdk.Coins {
+	return k.getPrefixedCoins(ctx, types.GetKeyPrefixPoolVolume(poolId))
+}
+
+// GetPoolFeesCollected returns the cumulative swap fees collected by a pool, per denom.
+func (k Keeper) GetPoolFeesCollected(ctx sdk.Context, poolId uint64) sdk.Coins {
+	return k.getPrefixedCoins(ctx, types.GetKeyPrefixPoolFeesCollected(poolId))
+}
+
+// GetAllPoolVolumes returns the swap volume and fees collected of all pools
+// that have been swapped through.
+func (k Keeper) GetAllPoolVolumes(ctx sdk.Context) []types.PoolVolume {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolVolume)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolVolumes := []types.PoolVolume{}
+	for ; iterator.Valid(); iterator.Next() {
+		// keys are the pool id followed by the denom, so the entries of a pool
+		// are iterated together
+		poolId := sdk.BigEndianToUint64(iterator.Key()[:8])
+		if len(poolVolumes) > 0 && poolVolumes[len(poolVolumes)-1].PoolId == poolId {
+			continue
+		}
+		poolVolumes = append(poolVolumes, types.PoolVolume{
+			PoolId:        poolId,
+			Volume:        k.GetPoolVolume(ctx, poolId),
+			FeesCollected: k.GetPoolFeesCollected(ctx, poolId),
+		})
+	}
+	return poolVolumes
+}
+
+// SetPoolVolume sets the swap volume and fees collected of a pool.
+func (k Keeper) SetPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	for _, coin := range poolVolume.Volume {
+		k.setPrefixedCoin(ctx, types.GetKeyPrefixPoolVolume(poolVolume.PoolId), coin)
+	}
+	for _, coin := range poolVolume.FeesCollected {
+		k.setPrefixedCoin(ctx, types.GetKeyPrefixPoolFeesCollected(poolVolume.PoolId), coin)
+	}
+}
+
+// recordPoolVolume adds a swap of tokenIn for tokenOut to the volume of a pool,
+// and the swapFee paid on tokenIn to its fees collected.
+func (k Keeper) recordPoolVolume(ctx sdk.Context, poolId uint64, tokenIn, tokenOut sdk.Coin, swapFee sdk.Dec) {
+	volumePrefix := types.GetKeyPrefixPoolVolume(poolId)
+	k.addPrefixedCoin(ctx, volumePrefix, tokenIn)
+	k.addPrefixedCoin(ctx, volumePrefix, tokenOut)
+
+	fee := tokenIn.Amount.ToDec().Mul(swapFee).TruncateInt()
+	if fee.IsPositive() {
+		k.addPrefixedCoin(ctx, types.GetKeyPrefixPoolFeesCollected(poolId), sdk.NewCoin(tokenIn.Denom, fee))
+	}
+}
+
+// addPrefixedCoin adds coin to the amount of its denom stored under keyPrefix.
+func (k Keeper) addPrefixedCoin(ctx sdk.Context, keyPrefix []byte, coin sdk.Coin) {
+	amount := k.getPrefixedCoinAmount(ctx, keyPrefix, coin.Denom)
+	k.setPrefixedCoin(ctx, keyPrefix, sdk.NewCoin(coin.Denom, amount.Add(coin.Amount)))
+}
+
+func (k Keeper) setPrefixedCoin(ctx sdk.Context, keyPrefix []byte, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(coin.Denom), bz)
+}
+
+func (k Keeper) getPrefixedCoinAmount(ctx sdk.Context, keyPrefix []byte, denom string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) getPrefixedCoins(ctx sdk.Context, keyPrefix []byte) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		// keys are iterated in denom order, so the coins stay sorted
+		coins = append(coins, sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return coins
+}
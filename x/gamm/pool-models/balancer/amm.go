@@ -164,13 +164,43 @@ func (p *Pool) applySwap(ctx sdk.Context, tokensIn sdk.Coins, tokensOut sdk.Coin
 	if err != nil {
 		return err
 	}
+
+	var spotPriceBefore sdk.Dec
+	if p.PoolParams.DynamicSwapFeeParams != nil {
+		spotPriceBefore, err = p.SpotPrice(ctx, tokensIn[0].Denom, tokensOut[0].Denom)
+		if err != nil {
+			return err
+		}
+	}
+
 	inPoolAsset.Token.Amount = inPoolAsset.Token.Amount.Add(tokensIn[0].Amount)
 	outPoolAsset.Token.Amount = outPoolAsset.Token.Amount.Sub(tokensOut[0].Amount)
 
-	return p.UpdatePoolAssetBalances(sdk.NewCoins(
+	err = p.UpdatePoolAssetBalances(sdk.NewCoins(
 		inPoolAsset.Token,
 		outPoolAsset.Token,
 	))
+	if err != nil {
+		return err
+	}
+
+	if p.PoolParams.DynamicSwapFeeParams != nil {
+		return p.recordVolatility(ctx, spotPriceBefore, tokensIn[0].Denom, tokensOut[0].Denom)
+	}
+	return nil
+}
+
+// recordVolatility adds the spot price move of a swap to the pool's volatility,
+// which determines its dynamic swap fee.
+func (p *Pool) recordVolatility(ctx sdk.Context, spotPriceBefore sdk.Dec, baseAsset, quoteAsset string) error {
+	spotPriceAfter, err := p.SpotPrice(ctx, baseAsset, quoteAsset)
+	if err != nil {
+		return err
+	}
+	p.VolatilityAccumulator = types.UpdateVolatilityAccumulator(
+		p.VolatilityAccumulator, p.PoolParams.DynamicSwapFeeParams.DecayPeriod,
+		spotPriceBefore, spotPriceAfter, ctx.BlockTime())
+	return nil
 }
 
 // SpotPrice returns the spot price of the pool
@@ -485,3 +515,30 @@ func (p *Pool) ExitSwapExactAmountOut(
 
 	return sharesIn, nil
 }
+
+// CFMMValueRatio returns the ratio of the pool's value under the balancer
+// CFMM, prod(B_i^(W_i/W)), to its value with reservesBefore and the same
+// weights. This is prod((B_i/B_i_before)^(W_i/W)).
+func (p Pool) CFMMValueRatio(reservesBefore sdk.Coins) (sdk.Dec, error) {
+	totalWeight := p.GetTotalWeight().ToDec()
+	ratio := sdk.OneDec()
+	for _, asset := range p.PoolAssets {
+		before := reservesBefore.AmountOf(asset.Token.Denom)
+		if !before.IsPositive() {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPool, "pool had no %s reserves before", asset.Token.Denom)
+		}
+		if asset.Token.Amount.Equal(before) {
+			continue
+		}
+
+		base := asset.Token.Amount.ToDec().QuoInt(before)
+		exp := asset.Weight.ToDec().Quo(totalWeight)
+		// osmomath.Pow requires its base to be lesser than two
+		if base.GTE(sdk.NewDec(2)) {
+			ratio = ratio.Quo(osmomath.Pow(sdk.OneDec().Quo(base), exp))
+		} else {
+			ratio = ratio.Mul(osmomath.Pow(base, exp))
+		}
+	}
+	return ratio, nil
+}
@@ -436,3 +436,61 @@ func TestCalcJoinPoolShares(t *testing.T) {
 		})
 	}
 }
+
+func TestCFMMValueRatio(t *testing.T) {
+	// osmomath.Pow is approximate
+	tolerance := sdk.NewDecWithPrec(1, 6)
+	testCases := []struct {
+		name           string
+		reservesBefore sdk.Coins
+		expectErr      bool
+		expectRatio    sdk.Dec
+	}{
+		{
+			name:           "unchanged reserves",
+			reservesBefore: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000)),
+			expectRatio:    sdk.OneDec(),
+		},
+		{
+			// (1_000_000/900_000)^0.75 * (1_000_000/1_100_000)^0.25
+			name:           "value increased",
+			reservesBefore: sdk.NewCoins(sdk.NewInt64Coin("uatom", 900_000), sdk.NewInt64Coin("uosmo", 1_100_000)),
+			expectRatio:    sdk.MustNewDecFromStr("1.056744379507810181"),
+		},
+		{
+			// (1_000_000/1_100_000)^0.75 * (1_000_000/900_000)^0.25
+			name:           "value decreased",
+			reservesBefore: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_100_000), sdk.NewInt64Coin("uosmo", 900_000)),
+			expectRatio:    sdk.MustNewDecFromStr("0.955861256221183101"),
+		},
+		{
+			// (1_000_000/100_000)^0.75 * (1_000_000/10_000_000)^0.25
+			name:           "reserves more than doubled",
+			reservesBefore: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expectRatio:    sdk.MustNewDecFromStr("3.162277660168379332"),
+		},
+		{
+			name:           "missing reserves",
+			reservesBefore: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)),
+			expectErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := createTestPool(t, sdk.ZeroDec(), sdk.ZeroDec(),
+				balancer.PoolAsset{Token: sdk.NewInt64Coin("uatom", 1_000_000), Weight: sdk.NewInt(300)},
+				balancer.PoolAsset{Token: sdk.NewInt64Coin("uosmo", 1_000_000), Weight: sdk.NewInt(100)},
+			).(*balancer.Pool)
+
+			ratio, err := pool.CFMMValueRatio(tc.reservesBefore)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, ratio.Sub(tc.expectRatio).Abs().LTE(tolerance),
+				"expected %s, got %s", tc.expectRatio, ratio)
+		})
+	}
+}
@@ -12,12 +12,13 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -136,6 +137,9 @@ type PoolParams struct {
 	SwapFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams              `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// When set, swap_fee is the base swap fee of the pool, which is increased
+	// during volatile periods.
+	DynamicSwapFeeParams *types1.DynamicSwapFeeParams `protobuf:"bytes,4,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -178,6 +182,13 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 	return nil
 }
 
+func (m *PoolParams) GetDynamicSwapFeeParams() *types1.DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -185,7 +196,7 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 type PoolAsset struct {
 	// Coins we are talking about,
 	// the denomination must be unique amongst all PoolAssets for this pool.
-	Token types1.Coin `protobuf:"bytes,1,opt,name=token,proto3" json:"token" yaml:"token"`
+	Token types2.Coin `protobuf:"bytes,1,opt,name=token,proto3" json:"token" yaml:"token"`
 	// Weight that is not normalized. This weight must be less than 2^50
 	Weight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
 }
@@ -223,11 +234,11 @@ func (m *PoolAsset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolAsset proto.InternalMessageInfo
 
-func (m *PoolAsset) GetToken() types1.Coin {
+func (m *PoolAsset) GetToken() types2.Coin {
 	if m != nil {
 		return m.Token
 	}
-	return types1.Coin{}
+	return types2.Coin{}
 }
 
 type Pool struct {
@@ -245,12 +256,15 @@ type Pool struct {
 	// TODO: Further improve these docs
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP tokens sent out
-	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types2.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// These are assumed to be sorted by denomiation.
 	// They contain the pool asset and the information about the weight
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// volatility recorded by the pool's swaps, only set if the pool has
+	// dynamic swap fees.
+	VolatilityAccumulator *types1.VolatilityAccumulator `protobuf:"bytes,8,opt,name=volatility_accumulator,json=volatilityAccumulator,proto3" json:"volatility_accumulator,omitempty" yaml:"volatility_accumulator"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -297,59 +311,66 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xeb, 0x44,
-	0x10, 0x8f, 0x93, 0xb4, 0x79, 0xdd, 0x3c, 0x1e, 0xea, 0xbe, 0x1c, 0xdc, 0x54, 0xc4, 0xd5, 0x22,
-	0xa1, 0x27, 0xf4, 0x62, 0xab, 0x0f, 0x24, 0xa4, 0x1e, 0x40, 0x4d, 0x5b, 0x50, 0x6f, 0xc5, 0x45,
-	0x6a, 0x41, 0x95, 0xac, 0x4d, 0xb2, 0xb1, 0xad, 0xda, 0x5e, 0xcb, 0xbb, 0x49, 0xdb, 0x6f, 0xc0,
-	0xb1, 0xc7, 0x72, 0xeb, 0x9d, 0x2b, 0x7c, 0x87, 0x0a, 0x2e, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x9c,
-	0x38, 0xe6, 0x13, 0xa0, 0xfd, 0xe3, 0x24, 0x2d, 0x89, 0x68, 0xc5, 0x29, 0x3b, 0xb3, 0x33, 0xbf,
-	0xf9, 0xcd, 0xcc, 0x6f, 0x1d, 0xf0, 0x29, 0x65, 0x31, 0x65, 0x21, 0x73, 0x7c, 0x1c, 0xc7, 0x4e,
-	0x4a, 0x69, 0xd4, 0x8e, 0x69, 0x9f, 0x44, 0xcc, 0xe9, 0xe2, 0x08, 0x27, 0x3d, 0x92, 0x4d, 0x0e,
-	0x07, 0x94, 0x46, 0x76, 0x9a, 0x51, 0x4e, 0x61, 0x43, 0x67, 0xd9, 0x22, 0xcb, 0x1e, 0x6d, 0x76,
-	0x09, 0xc7, 0x9b, 0xcd, 0xb5, 0x9e, 0x74, 0x7b, 0x32, 0xc6, 0x51, 0x86, 0x4a, 0x68, 0x36, 0x7c,
-	0xea, 0x53, 0xe5, 0x17, 0x27, 0xed, 0x6d, 0xf9, 0x94, 0xfa, 0x11, 0x71, 0xa4, 0xd5, 0x1d, 0x0e,
-	0x9c, 0xfe, 0x30, 0xc3, 0x3c, 0xa4, 0x89, 0xbe, 0xb7, 0x1e, 0xdf, 0xf3, 0x30, 0x26, 0x8c, 0xe3,
-	0x38, 0x2d, 0x00, 0x54, 0x11, 0x07, 0x0f, 0x79, 0xe0, 0x68, 0x1a, 0xd2, 0x78, 0x74, 0xdf, 0xc5,
-	0x8c, 0x4c, 0xee, 0x7b, 0x34, 0xd4, 0x05, 0xd0, 0xaf, 0x15, 0x60, 0x1e, 0xc6, 0x94, 0xf2, 0xe0,
-	0x88, 0x84, 0x7e, 0xc0, 0x77, 0x02, 0x9c, 0xf8, 0xe4, 0x00, 0x67, 0x38, 0x66, 0xf0, 0x18, 0x00,
-	0xc6, 0x71, 0xc6, 0x3d, 0x51, 0xd5, 0x34, 0x36, 0x8c, 0x37, 0xf5, 0x77, 0x4d, 0x5b, 0x51, 0xb2,
-	0x0b, 0x4a, 0xf6, 0x37, 0x05, 0xa5, 0xce, 0x07, 0x37, 0xb9, 0x55, 0x1a, 0xe7, 0xd6, 0xea, 0x05,
-	0x8e, 0xa3, 0x2d, 0x34, 0xcd, 0x45, 0x97, 0x7f, 0x58, 0x86, 0xbb, 0x22, 0x1d, 0x22, 0x1c, 0x06,
-	0xe0, 0x45, 0xd1, 0xa9, 0x59, 0x96, 0xb8, 0x6b, 0xff, 0xc2, 0xdd, 0xd5, 0x01, 0x9d, 0x4d, 0x01,
-	0xfb, 0x77, 0x6e, 0xc1, 0x22, 0xe5, 0x2d, 0x8d, 0x43, 0x4e, 0xe2, 0x94, 0x5f, 0x8c, 0x73, 0xeb,
-	0x7d, 0x55, 0xac, 0xb8, 0x43, 0x57, 0xa2, 0xd4, 0x04, 0x1d, 0x8e, 0x40, 0x23, 0x4c, 0x42, 0x1e,
-	0xe2, 0xc8, 0x13, 0xbb, 0xf5, 0xce, 0x64, 0x9b, 0xcc, 0xac, 0x6c, 0x54, 0xde, 0xd4, 0xdf, 0x59,
-	0xf6, 0xbc, 0x3d, 0xda, 0x62, 0xd1, 0xdb, 0x8c, 0x11, 0xde, 0xf9, 0x50, 0xb7, 0xb4, 0xae, 0xaa,
-	0xcc, 0x83, 0x42, 0x2e, 0xd4, 0x6e, 0x91, 0xa6, 0xc6, 0xc8, 0x20, 0x03, 0xaf, 0x39, 0xce, 0x7c,
-	0xc2, 0x1f, 0x96, 0xad, 0x3e, 0xad, 0x2c, 0xd2, 0x65, 0x9b, 0xaa, 0xec, 0x1c, 0x24, 0xe4, 0xae,
-	0x2a, 0xef, 0x4c, 0x51, 0xf4, 0x57, 0x19, 0x00, 0x61, 0xeb, 0xfd, 0x9d, 0x80, 0x17, 0xec, 0x0c,
-	0xa7, 0xde, 0x80, 0xa8, 0xed, 0xad, 0x74, 0xb6, 0x05, 0xee, 0xef, 0xb9, 0xf5, 0x91, 0x1f, 0xf2,
-	0x60, 0xd8, 0xb5, 0x7b, 0x34, 0xd6, 0x32, 0xd5, 0x3f, 0x6d, 0xd6, 0x3f, 0x75, 0xf8, 0x45, 0x4a,
-	0x98, 0xbd, 0x4b, 0x7a, 0xd3, 0xf1, 0x16, 0x38, 0xc8, 0xad, 0x89, 0xe3, 0x97, 0x84, 0x08, 0x74,
-	0x72, 0x1e, 0x72, 0x89, 0x5e, 0xfe, 0x7f, 0xe8, 0x05, 0x0e, 0x72, 0x6b, 0xe2, 0x28, 0xd0, 0x7f,
-	0x30, 0xc0, 0x3a, 0x93, 0xc2, 0xd4, 0x1d, 0x7b, 0x3d, 0x29, 0x4d, 0x2f, 0x95, 0xbd, 0x99, 0x15,
-	0xa9, 0x1a, 0x7b, 0xfe, 0x20, 0x17, 0x29, 0xba, 0xf3, 0xf1, 0x4d, 0x6e, 0x19, 0xe3, 0xdc, 0x42,
-	0xba, 0xab, 0xc5, 0x05, 0x90, 0x6b, 0xb2, 0x05, 0x28, 0xe8, 0x47, 0x03, 0xac, 0x4c, 0x76, 0x05,
-	0xf7, 0xc0, 0x12, 0xa7, 0xa7, 0x24, 0xd1, 0x0f, 0x64, 0xcd, 0xd6, 0xef, 0x5e, 0x3c, 0xb9, 0x09,
-	0xa3, 0x1d, 0x1a, 0x26, 0x9d, 0x86, 0xde, 0xea, 0x4b, 0xbd, 0x55, 0x91, 0x85, 0x5c, 0x95, 0x0d,
-	0x8f, 0xc0, 0xb2, 0xe2, 0xa1, 0x87, 0xf9, 0xc5, 0x33, 0x86, 0xb9, 0x9f, 0xf0, 0x71, 0x6e, 0xbd,
-	0xa7, 0x60, 0x15, 0x0a, 0x72, 0x35, 0x1c, 0xfa, 0xb9, 0x0a, 0xaa, 0x82, 0x2d, 0x7c, 0x0b, 0x6a,
-	0xb8, 0xdf, 0xcf, 0x08, 0x63, 0x5a, 0x0d, 0x70, 0x9c, 0x5b, 0xaf, 0x54, 0x92, 0xbe, 0x40, 0x6e,
-	0x11, 0x02, 0x5f, 0x81, 0x72, 0xd8, 0x97, 0x5c, 0xaa, 0x6e, 0x39, 0xec, 0xc3, 0x01, 0xa8, 0x4b,
-	0xfd, 0x3d, 0x98, 0xff, 0xc6, 0x62, 0x21, 0xeb, 0x89, 0x3f, 0x7a, 0x40, 0xc5, 0xa7, 0xd4, 0x9b,
-	0xc1, 0x42, 0x2e, 0x48, 0xa7, 0xa2, 0xfd, 0x1a, 0x34, 0x06, 0x43, 0x3e, 0xcc, 0x88, 0x0a, 0xf1,
-	0xe9, 0x88, 0x64, 0x09, 0xcd, 0xcc, 0xaa, 0xa4, 0x6c, 0x4d, 0xa1, 0xe6, 0x45, 0x21, 0x17, 0x2a,
-	0xb7, 0x60, 0xf0, 0x95, 0x76, 0xc2, 0x6f, 0xc1, 0x4b, 0x4e, 0x39, 0x8e, 0x3c, 0x16, 0xe0, 0x8c,
-	0x30, 0x73, 0xe9, 0xbf, 0x16, 0xb5, 0xae, 0x49, 0xbf, 0x2e, 0x16, 0x35, 0x4d, 0x46, 0x6e, 0x5d,
-	0x9a, 0x87, 0xd2, 0x82, 0x27, 0x7a, 0x2a, 0x58, 0x48, 0x81, 0x99, 0xcb, 0x4f, 0x7b, 0xde, 0x4d,
-	0x8d, 0x0f, 0x15, 0xfe, 0x0c, 0x82, 0x9e, 0x85, 0x0c, 0x63, 0x30, 0x28, 0x88, 0x6b, 0x65, 0xd4,
-	0xe4, 0x0c, 0xf6, 0x9e, 0xad, 0x8c, 0x07, 0x7d, 0x14, 0xfa, 0x50, 0x7d, 0x28, 0x79, 0x6f, 0xad,
-	0x7e, 0x7f, 0x6d, 0x95, 0xae, 0xae, 0xad, 0xd2, 0x2f, 0x3f, 0xb5, 0x97, 0x04, 0xd1, 0xfd, 0xce,
-	0xf1, 0xcd, 0x5d, 0xcb, 0xb8, 0xbd, 0x6b, 0x19, 0x7f, 0xde, 0xb5, 0x8c, 0xcb, 0xfb, 0x56, 0xe9,
-	0xf6, 0xbe, 0x55, 0xfa, 0xed, 0xbe, 0x55, 0xfa, 0xee, 0xf3, 0x99, 0xc2, 0xba, 0xd3, 0x76, 0x84,
-	0xbb, 0xac, 0x30, 0x9c, 0xd1, 0x67, 0xce, 0xf9, 0xe2, 0xff, 0xd3, 0xee, 0xb2, 0xfc, 0xc6, 0x7f,
-	0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xdd, 0x49, 0x11, 0x7b, 0x07, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0xe7, 0x7f, 0x26, 0xa5, 0x28, 0xd3, 0x05, 0x9c, 0x8d, 0xba, 0x8e, 0x06, 0x11, 0x55,
+	0xa5, 0xb1, 0x95, 0x82, 0x84, 0xd4, 0x03, 0x28, 0x6e, 0x0a, 0xea, 0xad, 0x38, 0x88, 0x16, 0x54,
+	0xc9, 0x9a, 0xb5, 0x27, 0xde, 0x51, 0x6d, 0xcf, 0xca, 0x33, 0xbb, 0xed, 0x7e, 0x03, 0xb8, 0xf5,
+	0x58, 0x6e, 0xbd, 0x73, 0xe5, 0x0b, 0x70, 0x8b, 0xe0, 0xd2, 0x23, 0xe2, 0x60, 0x50, 0x72, 0xe3,
+	0x84, 0xf6, 0x13, 0xa0, 0xf9, 0xb7, 0x9b, 0x04, 0xaf, 0x68, 0xc5, 0x29, 0x9e, 0x37, 0xef, 0xfd,
+	0xde, 0xef, 0xbd, 0xf7, 0x7b, 0x93, 0x05, 0x1f, 0x33, 0x5e, 0x30, 0x4e, 0x79, 0x90, 0xe1, 0xa2,
+	0x08, 0x06, 0x8c, 0xe5, 0x7b, 0x05, 0x4b, 0x49, 0xce, 0x83, 0x1e, 0xce, 0x71, 0x99, 0x90, 0x6a,
+	0xfa, 0xf1, 0x80, 0xb1, 0xdc, 0x1f, 0x54, 0x4c, 0x30, 0xd8, 0x36, 0x51, 0xbe, 0x8c, 0xf2, 0x47,
+	0xfb, 0x3d, 0x22, 0xf0, 0x7e, 0x67, 0x2b, 0x51, 0xe6, 0x58, 0xf9, 0x04, 0xfa, 0xa0, 0x03, 0x3a,
+	0xed, 0x8c, 0x65, 0x4c, 0xdb, 0xe5, 0x97, 0xb1, 0x76, 0x33, 0xc6, 0xb2, 0x9c, 0x04, 0xea, 0xd4,
+	0x1b, 0x1e, 0x07, 0xe9, 0xb0, 0xc2, 0x82, 0xb2, 0xd2, 0xdc, 0x7b, 0x97, 0xef, 0x05, 0x2d, 0x08,
+	0x17, 0xb8, 0x18, 0x58, 0x00, 0x9d, 0x24, 0xc0, 0x43, 0xd1, 0x0f, 0x0c, 0x0d, 0x75, 0xb8, 0x74,
+	0xdf, 0xc3, 0x9c, 0x4c, 0xef, 0x13, 0x46, 0x6d, 0x82, 0xdd, 0x0b, 0xd5, 0x5b, 0x87, 0x74, 0x5c,
+	0xe2, 0x82, 0x26, 0xf1, 0x31, 0x21, 0xda, 0x0f, 0xfd, 0xba, 0x08, 0xdc, 0xa3, 0x82, 0x31, 0xd1,
+	0x7f, 0x48, 0x68, 0xd6, 0x17, 0x77, 0xfb, 0xb8, 0xcc, 0xc8, 0x03, 0x5c, 0xe1, 0x82, 0xc3, 0x47,
+	0x00, 0x70, 0x81, 0x2b, 0x11, 0x4b, 0x76, 0xae, 0xb3, 0xe3, 0xdc, 0xd8, 0xb8, 0xdd, 0xf1, 0x35,
+	0x75, 0xdf, 0x52, 0xf7, 0xbf, 0xb2, 0xd4, 0xc3, 0xeb, 0x27, 0xb5, 0xd7, 0x9a, 0xd4, 0xde, 0xe6,
+	0x18, 0x17, 0xf9, 0x1d, 0x34, 0x8b, 0x45, 0xcf, 0xff, 0xf0, 0x9c, 0x68, 0x5d, 0x19, 0xa4, 0x3b,
+	0xec, 0x83, 0x35, 0xdb, 0x11, 0x77, 0x41, 0xe1, 0x6e, 0xfd, 0x0b, 0xf7, 0xd0, 0x38, 0x84, 0xfb,
+	0x12, 0xf6, 0xaf, 0xda, 0x83, 0x36, 0xe4, 0x16, 0x2b, 0xa8, 0x20, 0xc5, 0x40, 0x8c, 0x27, 0xb5,
+	0xf7, 0xb6, 0x4e, 0x66, 0xef, 0xd0, 0x0b, 0x99, 0x6a, 0x8a, 0x0e, 0x47, 0xa0, 0x4d, 0x4b, 0x2a,
+	0x28, 0xce, 0x63, 0xa9, 0x81, 0xf8, 0xa9, 0x2a, 0x93, 0xbb, 0x8b, 0x3b, 0x8b, 0x37, 0x36, 0x6e,
+	0x7b, 0x7e, 0xd3, 0xbc, 0x7d, 0x29, 0x88, 0x03, 0xce, 0x89, 0x08, 0xdf, 0x37, 0x25, 0x6d, 0xeb,
+	0x2c, 0x4d, 0x50, 0x28, 0x82, 0xc6, 0x2c, 0xc3, 0x74, 0x1b, 0x39, 0xe4, 0xe0, 0x9a, 0xc0, 0x55,
+	0x46, 0xc4, 0xc5, 0xb4, 0x4b, 0xaf, 0x97, 0x16, 0x99, 0xb4, 0x1d, 0x9d, 0xb6, 0x01, 0x09, 0x45,
+	0x9b, 0xda, 0x7a, 0x2e, 0x29, 0xfa, 0x7b, 0x11, 0x00, 0x79, 0x36, 0xf3, 0x7b, 0x0c, 0xd6, 0xf8,
+	0x53, 0x3c, 0x90, 0xe3, 0x56, 0xd3, 0x5b, 0x0f, 0x0f, 0x24, 0xee, 0xef, 0xb5, 0xb7, 0x9b, 0x51,
+	0xd1, 0x1f, 0xf6, 0xfc, 0x84, 0x15, 0x46, 0xce, 0xe6, 0xcf, 0x1e, 0x4f, 0x9f, 0x04, 0x62, 0x3c,
+	0x20, 0xdc, 0x3f, 0x24, 0xc9, 0xac, 0xbd, 0x16, 0x07, 0x45, 0xab, 0xf2, 0xf3, 0x73, 0x42, 0x24,
+	0x3a, 0x79, 0x46, 0x85, 0x42, 0x5f, 0xf8, 0x7f, 0xe8, 0x16, 0x07, 0x45, 0xab, 0xf2, 0x53, 0xa2,
+	0xff, 0xe0, 0x80, 0x6d, 0xae, 0x84, 0x69, 0x2a, 0x8e, 0x13, 0x25, 0xcd, 0x78, 0xa0, 0x6a, 0x73,
+	0x17, 0x95, 0x6a, 0xfc, 0xe6, 0x46, 0xce, 0x53, 0x74, 0x78, 0xf3, 0xa4, 0xf6, 0x9c, 0x49, 0xed,
+	0x21, 0x53, 0xd5, 0xfc, 0x04, 0x28, 0x72, 0xf9, 0xbc, 0xbd, 0xf8, 0xde, 0x01, 0xef, 0xd9, 0x55,
+	0xb2, 0x8d, 0xb1, 0xbc, 0x96, 0x14, 0xaf, 0x9b, 0xcd, 0xbc, 0x0e, 0x75, 0xd0, 0x91, 0xee, 0xa0,
+	0xe1, 0xb4, 0x6b, 0x38, 0x75, 0x8d, 0x90, 0x9b, 0x81, 0x51, 0xd4, 0x4e, 0x1b, 0xa2, 0xd1, 0x8f,
+	0x0e, 0x58, 0x9f, 0xea, 0x06, 0xde, 0x03, 0xcb, 0x82, 0x3d, 0x21, 0xa5, 0x59, 0xd6, 0x2d, 0xdf,
+	0xbc, 0x55, 0xf2, 0x99, 0x98, 0xb2, 0xb8, 0xcb, 0x68, 0x19, 0xb6, 0x8d, 0xc2, 0xae, 0x18, 0x85,
+	0xc9, 0x28, 0x14, 0xe9, 0x68, 0xf8, 0x10, 0xac, 0xe8, 0x9e, 0x98, 0xc1, 0x7e, 0xf6, 0x06, 0x83,
+	0xbd, 0x5f, 0x8a, 0x49, 0xed, 0xbd, 0xa5, 0x61, 0x35, 0x0a, 0x8a, 0x0c, 0x1c, 0xfa, 0x79, 0x19,
+	0x2c, 0x49, 0xb6, 0xf0, 0x16, 0x58, 0xc5, 0x69, 0x5a, 0x11, 0xce, 0x8d, 0x32, 0xe1, 0xa4, 0xf6,
+	0xae, 0xea, 0x20, 0x73, 0x81, 0x22, 0xeb, 0x02, 0xaf, 0x82, 0x05, 0x9a, 0x2a, 0x2e, 0x4b, 0xd1,
+	0x02, 0x4d, 0xe1, 0x31, 0xd8, 0x50, 0xbb, 0x70, 0x41, 0x0b, 0x3b, 0xf3, 0x97, 0xca, 0x74, 0xfa,
+	0xd2, 0x32, 0xdb, 0xe7, 0x3f, 0x3e, 0x87, 0x85, 0x22, 0x30, 0x98, 0x2d, 0xd0, 0x97, 0xa0, 0x7d,
+	0x3c, 0x14, 0xc3, 0x8a, 0x68, 0x97, 0x8c, 0x8d, 0x48, 0x55, 0xb2, 0x4a, 0x0d, 0x79, 0x3d, 0xf4,
+	0x66, 0x50, 0x4d, 0x5e, 0x28, 0x82, 0xda, 0x2c, 0x19, 0x7c, 0x61, 0x8c, 0xf0, 0x1b, 0x70, 0x45,
+	0x30, 0x81, 0xf3, 0x98, 0xf7, 0x71, 0x45, 0xb8, 0xbb, 0xfc, 0x5f, 0x83, 0xda, 0x36, 0xa4, 0xaf,
+	0xd9, 0x41, 0xcd, 0x82, 0x51, 0xb4, 0xa1, 0x8e, 0x47, 0xea, 0x04, 0x1f, 0x9b, 0xae, 0x60, 0x29,
+	0x05, 0xee, 0xae, 0xbc, 0xde, 0x53, 0xd3, 0x31, 0xf8, 0x50, 0xe3, 0x9f, 0x43, 0x30, 0xbd, 0x50,
+	0x6e, 0x1c, 0xf6, 0x2d, 0x71, 0xa3, 0x8c, 0x55, 0xd5, 0x83, 0x7b, 0x6f, 0xac, 0x8c, 0x0b, 0x75,
+	0x58, 0x7d, 0xe8, 0x3a, 0xf4, 0xaa, 0xc9, 0xf5, 0x7a, 0x77, 0xc4, 0x72, 0x2c, 0x68, 0x4e, 0xc5,
+	0x38, 0xc6, 0x49, 0x32, 0x2c, 0x86, 0x39, 0x16, 0xac, 0x72, 0xd7, 0x54, 0xb7, 0x3e, 0x6c, 0xae,
+	0xe9, 0xeb, 0x69, 0xcc, 0xc1, 0x2c, 0x24, 0xfc, 0xc0, 0xac, 0xd7, 0x75, 0x9d, 0xb7, 0x19, 0x18,
+	0x45, 0xef, 0x8c, 0x9a, 0xa2, 0xef, 0x6c, 0x7e, 0xf7, 0xd2, 0x6b, 0xbd, 0x78, 0xe9, 0xb5, 0x7e,
+	0xf9, 0x69, 0x6f, 0x59, 0x36, 0xed, 0x7e, 0xf8, 0xe8, 0xe4, 0xb4, 0xeb, 0xbc, 0x3a, 0xed, 0x3a,
+	0x7f, 0x9e, 0x76, 0x9d, 0xe7, 0x67, 0xdd, 0xd6, 0xab, 0xb3, 0x6e, 0xeb, 0xb7, 0xb3, 0x6e, 0xeb,
+	0xdb, 0x4f, 0xcf, 0x35, 0xc1, 0x30, 0xdc, 0xcb, 0x71, 0x8f, 0xdb, 0x43, 0x30, 0xfa, 0x24, 0x78,
+	0x36, 0xff, 0xf7, 0x48, 0x6f, 0x45, 0xfd, 0xef, 0xfb, 0xe8, 0x9f, 0x01, 0x00, 0xd5, 0x59, 0x92,
+	0x60, 0xbb, 0x08, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -439,6 +460,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -537,6 +570,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VolatilityAccumulator != nil {
+		{
+			size, err := m.VolatilityAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -653,6 +698,10 @@ func (m *PoolParams) Size() (n int) {
 		l = m.SmoothWeightChangeParams.Size()
 		n += 1 + l + sovBalancerPool(uint64(l))
 	}
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -698,6 +747,10 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.VolatilityAccumulator != nil {
+		l = m.VolatilityAccumulator.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -1024,6 +1077,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &types1.DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1408,6 +1497,42 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolatilityAccumulator == nil {
+				m.VolatilityAccumulator = &types1.VolatilityAccumulator{}
+			}
+			if err := m.VolatilityAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -72,8 +72,13 @@ func (pa Pool) GetId() uint64 {
 	return pa.Id
 }
 
-func (pa Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
-	return pa.PoolParams.SwapFee
+// GetSwapFee returns the swap fee of the pool, which for pools with dynamic
+// swap fees depends on the volatility recorded by its recent swaps.
+func (pa Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	if pa.PoolParams.DynamicSwapFeeParams == nil {
+		return pa.PoolParams.SwapFee
+	}
+	return pa.PoolParams.DynamicSwapFeeParams.SwapFee(pa.PoolParams.SwapFee, pa.VolatilityAccumulator, ctx.BlockTime())
 }
 
 func (pa Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
@@ -205,6 +210,45 @@ func (pa *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset
 	return nil
 }
 
+// UpdatePoolParams sets the swap and exit fees of the pool, keeping its dynamic
+// swap fee params. If smoothWeightChangeParams is set, the pool's weights
+// start changing from their current values to its target weights, replacing
+// any ongoing weight change. A weight change can't start before curBlockTime.
+func (pa *Pool) UpdatePoolParams(swapFee, exitFee sdk.Dec, smoothWeightChangeParams *SmoothWeightChangeParams, curBlockTime time.Time) error {
+	params := PoolParams{
+		SwapFee:                  swapFee,
+		ExitFee:                  exitFee,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
+		DynamicSwapFeeParams:     pa.PoolParams.DynamicSwapFeeParams,
+	}
+
+	sortedPoolAssets := pa.GetAllPoolAssets()
+	err := params.Validate(sortedPoolAssets)
+	if err != nil {
+		return err
+	}
+
+	if smoothWeightChangeParams == nil {
+		// keep any ongoing weight change
+		params.SmoothWeightChangeParams = pa.PoolParams.SmoothWeightChangeParams
+		pa.PoolParams = params
+		return nil
+	}
+
+	startTime := smoothWeightChangeParams.StartTime
+	if startTime.Unix() > 0 && startTime.Before(curBlockTime) {
+		return fmt.Errorf("weight change start time %s is before the block time %s", startTime, curBlockTime)
+	}
+
+	if err := pa.setInitialPoolParams(params, sortedPoolAssets, curBlockTime); err != nil {
+		return err
+	}
+	// keep the start time in UTC, as it is when the pool is read back from the store
+	startTime = pa.PoolParams.SmoothWeightChangeParams.StartTime
+	pa.PoolParams.SmoothWeightChangeParams.StartTime = startTime.UTC()
+	return nil
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
@@ -470,6 +514,8 @@ func (pa Pool) IsActive(ctx sdk.Context) bool {
 	return true
 }
 
+// NewPoolParams returns balancer pool params with a static swap fee.
+// Dynamic swap fees are opted into by setting DynamicSwapFeeParams.
 func NewPoolParams(swapFee, exitFee sdk.Dec, params *SmoothWeightChangeParams) PoolParams {
 	return PoolParams{
 		SwapFee:                  swapFee,
@@ -495,6 +541,12 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 		return types.ErrTooMuchSwapFee
 	}
 
+	if params.DynamicSwapFeeParams != nil {
+		if err := params.DynamicSwapFeeParams.Validate(params.SwapFee); err != nil {
+			return err
+		}
+	}
+
 	if params.SmoothWeightChangeParams != nil {
 		targetWeights := params.SmoothWeightChangeParams.TargetPoolWeights
 		// Ensure it has the right number of weights
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 var (
@@ -565,3 +567,50 @@ func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 		require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
 	}
 }
+
+func TestBalancerPoolDynamicSwapFee(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockTime(defaultCurBlockTime)
+	poolAssets := []PoolAsset{
+		{Token: sdk.NewInt64Coin("foo", 1000000), Weight: sdk.NewInt(1)},
+		{Token: sdk.NewInt64Coin("bar", 1000000), Weight: sdk.NewInt(1)},
+	}
+
+	// pools with a static swap fee don't record volatility
+	pool, err := NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	_, err = pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)), "bar", pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.Nil(t, pool.VolatilityAccumulator)
+	require.True(t, defaultSwapFee.Equal(pool.GetSwapFee(ctx)))
+
+	params := defaultBalancerPoolParams
+	params.DynamicSwapFeeParams = types.NewDynamicSwapFeeParams(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 2), time.Hour)
+	pool, err = NewBalancerPool(defaultPoolId, params, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	require.True(t, defaultSwapFee.Equal(pool.GetSwapFee(ctx)))
+
+	// a swap moving the price raises the swap fee, until the volatility decays
+	_, err = pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)), "bar", pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.NotNil(t, pool.VolatilityAccumulator)
+	require.True(t, pool.VolatilityAccumulator.Volatility.IsPositive())
+	swapFee := pool.GetSwapFee(ctx)
+	require.True(t, swapFee.GT(defaultSwapFee))
+	require.True(t, swapFee.LTE(params.DynamicSwapFeeParams.MaxSwapFee))
+
+	ctx = ctx.WithBlockTime(defaultCurBlockTime.Add(30 * time.Minute))
+	require.True(t, pool.GetSwapFee(ctx).LT(swapFee))
+	require.True(t, pool.GetSwapFee(ctx).GT(defaultSwapFee))
+	ctx = ctx.WithBlockTime(defaultCurBlockTime.Add(time.Hour))
+	require.True(t, defaultSwapFee.Equal(pool.GetSwapFee(ctx)))
+
+	// swapping in the other direction is volatility too
+	_, err = pool.SwapInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)), "bar", pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.True(t, pool.GetSwapFee(ctx).GT(defaultSwapFee))
+
+	// the max swap fee must be at least the base swap fee
+	params.DynamicSwapFeeParams = types.NewDynamicSwapFeeParams(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 2), time.Hour)
+	_, err = NewBalancerPool(defaultPoolId, params, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 type balancerPoolPretty struct {
@@ -14,6 +16,8 @@ type balancerPoolPretty struct {
 	TotalWeight        sdk.Dec        `json:"total_weight" yaml:"total_weight"`
 	TotalShares        sdk.Coin       `json:"total_shares" yaml:"total_shares"`
 	PoolAssets         []PoolAsset    `json:"pool_assets" yaml:"pool_assets"`
+	// only set for pools with dynamic swap fees
+	VolatilityAccumulator *types.VolatilityAccumulator `json:"volatility_accumulator,omitempty" yaml:"volatility_accumulator,omitempty"`
 }
 
 func (pa Pool) String() string {
@@ -41,6 +45,8 @@ func (pa Pool) MarshalJSON() ([]byte, error) {
 		TotalWeight:        decTotalWeight,
 		TotalShares:        pa.TotalShares,
 		PoolAssets:         pa.PoolAssets,
+
+		VolatilityAccumulator: pa.VolatilityAccumulator,
 	})
 }
 
@@ -58,6 +64,7 @@ func (pa *Pool) UnmarshalJSON(bz []byte) error {
 	pa.TotalWeight = alias.TotalWeight.RoundInt()
 	pa.TotalShares = alias.TotalShares
 	pa.PoolAssets = alias.PoolAssets
+	pa.VolatilityAccumulator = alias.VolatilityAccumulator
 
 	return nil
 }
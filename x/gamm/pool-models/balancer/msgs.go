@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,12 +10,14 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool       = "create_balancer_pool"
+	TypeMsgUpdateBalancerPoolParams = "update_balancer_pool_params"
 )
 
 var (
 	_ sdk.Msg             = &MsgCreateBalancerPool{}
 	_ types.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg             = &MsgUpdateBalancerPoolParams{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -98,3 +102,76 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (typ
 	poolI, err := NewBalancerPool(poolID, *msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, ctx.BlockTime())
 	return &poolI, err
 }
+
+func NewMsgUpdateBalancerPoolParams(
+	sender sdk.AccAddress,
+	poolId uint64,
+	swapFee, exitFee *sdk.Dec,
+	smoothWeightChangeParams *SmoothWeightChangeParams,
+) MsgUpdateBalancerPoolParams {
+	return MsgUpdateBalancerPoolParams{
+		Sender:                   sender.String(),
+		PoolID:                   poolId,
+		SwapFee:                  swapFee,
+		ExitFee:                  exitFee,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
+	}
+}
+
+func (msg MsgUpdateBalancerPoolParams) Route() string { return types.RouterKey }
+func (msg MsgUpdateBalancerPoolParams) Type() string  { return TypeMsgUpdateBalancerPoolParams }
+func (msg MsgUpdateBalancerPoolParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.SwapFee == nil && msg.ExitFee == nil && msg.SmoothWeightChangeParams == nil {
+		return errors.New("at least one of swap fee, exit fee or smooth weight change params must be set")
+	}
+
+	if msg.SwapFee != nil {
+		if msg.SwapFee.IsNegative() {
+			return types.ErrNegativeSwapFee
+		}
+		if msg.SwapFee.GTE(sdk.OneDec()) {
+			return types.ErrTooMuchSwapFee
+		}
+	}
+
+	if msg.ExitFee != nil {
+		if msg.ExitFee.IsNegative() {
+			return types.ErrNegativeExitFee
+		}
+		if msg.ExitFee.GTE(sdk.OneDec()) {
+			return types.ErrTooMuchExitFee
+		}
+	}
+
+	// the target weights are checked against the pool's assets in the keeper
+	if msg.SmoothWeightChangeParams != nil {
+		for _, v := range msg.SmoothWeightChangeParams.TargetPoolWeights {
+			err := ValidateUserSpecifiedWeight(v.Weight)
+			if err != nil {
+				return err
+			}
+		}
+		if msg.SmoothWeightChangeParams.Duration <= 0 {
+			return errors.New("params.SmoothWeightChangeParams must have a positive duration")
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateBalancerPoolParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateBalancerPoolParams) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package balancer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -239,3 +240,140 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateBalancerPoolParams(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+		swapFee := sdk.NewDecWithPrec(1, 2)
+		exitFee := sdk.NewDecWithPrec(1, 2)
+
+		msg := MsgUpdateBalancerPoolParams{
+			Sender:  addr1,
+			PoolID:  1,
+			SwapFee: &swapFee,
+			ExitFee: &exitFee,
+			SmoothWeightChangeParams: &SmoothWeightChangeParams{
+				Duration: time.Hour,
+				TargetPoolWeights: []PoolAsset{
+					{
+						Weight: sdk.NewInt(200),
+						Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+					},
+					{
+						Weight: sdk.NewInt(50),
+						Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+					},
+				},
+			},
+		}
+
+		return after(msg)
+	}
+
+	default_msg := createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "update_balancer_pool_params")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgUpdateBalancerPoolParams
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "only fees",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.SmoothWeightChangeParams = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "only weight change",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.SwapFee = nil
+				msg.ExitFee = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "nothing to update",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.SwapFee = nil
+				msg.ExitFee = nil
+				msg.SmoothWeightChangeParams = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				swapFee := sdk.NewDecWithPrec(-1, 2)
+				msg.SwapFee = &swapFee
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of 1",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				exitFee := sdk.OneDec()
+				msg.ExitFee = &exitFee
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a target weight",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight change duration",
+			msg: createMsg(func(msg MsgUpdateBalancerPoolParams) MsgUpdateBalancerPoolParams {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package balancer
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -141,9 +142,115 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgUpdateBalancerPoolParams
+// MsgUpdateBalancerPoolParams updates the params of a balancer pool. It must be
+// signed by the pool's future_pool_governor address. Unset params are left
+// unchanged.
+type MsgUpdateBalancerPoolParams struct {
+	Sender  string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID  uint64                                  `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SwapFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee,omitempty" yaml:"swap_fee"`
+	ExitFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee,omitempty" yaml:"exit_fee"`
+	// If set, the weights of the pool change from their current values to the
+	// target weights, replacing any ongoing weight change.
+	SmoothWeightChangeParams *SmoothWeightChangeParams `protobuf:"bytes,5,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgUpdateBalancerPoolParams) Reset()         { *m = MsgUpdateBalancerPoolParams{} }
+func (m *MsgUpdateBalancerPoolParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBalancerPoolParams) ProtoMessage()    {}
+func (*MsgUpdateBalancerPoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{2}
+}
+func (m *MsgUpdateBalancerPoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBalancerPoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBalancerPoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBalancerPoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBalancerPoolParams.Merge(m, src)
+}
+func (m *MsgUpdateBalancerPoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBalancerPoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBalancerPoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBalancerPoolParams proto.InternalMessageInfo
+
+func (m *MsgUpdateBalancerPoolParams) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateBalancerPoolParams) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgUpdateBalancerPoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
+type MsgUpdateBalancerPoolParamsResponse struct {
+}
+
+func (m *MsgUpdateBalancerPoolParamsResponse) Reset()         { *m = MsgUpdateBalancerPoolParamsResponse{} }
+func (m *MsgUpdateBalancerPoolParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBalancerPoolParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateBalancerPoolParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{3}
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBalancerPoolParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBalancerPoolParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBalancerPoolParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBalancerPoolParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgUpdateBalancerPoolParams)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateBalancerPoolParams")
+	proto.RegisterType((*MsgUpdateBalancerPoolParamsResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateBalancerPoolParamsResponse")
 }
 
 func init() {
@@ -151,34 +258,45 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x8a, 0xd4, 0x30,
-	0x18, 0xc7, 0x27, 0x3b, 0x43, 0xc5, 0x2c, 0x1e, 0x0c, 0xab, 0x94, 0x11, 0xdb, 0x52, 0x2f, 0xf5,
-	0x30, 0x09, 0x3b, 0x0a, 0x82, 0x07, 0xc5, 0xba, 0xb2, 0xec, 0x61, 0x61, 0xec, 0x69, 0xf1, 0xb2,
-	0xa4, 0xdb, 0x58, 0x07, 0xda, 0xa6, 0x24, 0x99, 0x61, 0x7c, 0x0b, 0x9f, 0xc0, 0xa3, 0x8f, 0xe0,
-	0x33, 0xec, 0x71, 0x8f, 0x9e, 0x8a, 0x74, 0xde, 0x60, 0x9e, 0x40, 0x92, 0xb4, 0x32, 0x42, 0x17,
-	0x05, 0x6f, 0xe9, 0x97, 0xdf, 0xff, 0xff, 0xff, 0xbe, 0xf4, 0x83, 0x33, 0x2e, 0x4b, 0x2e, 0x97,
-	0x92, 0xe4, 0xb4, 0x2c, 0x49, 0xcd, 0x79, 0x31, 0x2b, 0x79, 0xc6, 0x0a, 0x49, 0x52, 0x5a, 0xd0,
-	0xea, 0x8a, 0x09, 0xa2, 0x36, 0x44, 0x6d, 0x70, 0x2d, 0xb8, 0xe2, 0x28, 0xea, 0x70, 0xac, 0x71,
-	0xac, 0x71, 0x4b, 0xe3, 0x9e, 0xc6, 0xeb, 0xe3, 0x94, 0x29, 0x7a, 0x3c, 0x3d, 0xca, 0x79, 0xce,
-	0x8d, 0x88, 0xe8, 0x93, 0xd5, 0x4f, 0x9f, 0xff, 0x3d, 0xae, 0x3f, 0x2c, 0x38, 0x2f, 0xac, 0x2a,
-	0xfc, 0x7e, 0x00, 0x1f, 0x9c, 0xcb, 0xfc, 0xad, 0x60, 0x54, 0xb1, 0x78, 0xef, 0x1e, 0x3d, 0x85,
-	0x8e, 0x64, 0x55, 0xc6, 0x84, 0x0b, 0x02, 0x10, 0xdd, 0x8d, 0xef, 0xef, 0x1a, 0xff, 0xde, 0x67,
-	0x5a, 0x16, 0x2f, 0x43, 0x5b, 0x0f, 0x93, 0x0e, 0x40, 0x17, 0x10, 0xea, 0xbc, 0x05, 0x15, 0xb4,
-	0x94, 0xee, 0x41, 0x00, 0xa2, 0xc3, 0x79, 0x80, 0xff, 0x98, 0xa7, 0xeb, 0x1d, 0x2f, 0x7e, 0x73,
-	0xf1, 0xc3, 0x5d, 0xe3, 0x23, 0x6b, 0xa8, 0xd5, 0x97, 0xb5, 0x29, 0x87, 0xc9, 0x9e, 0x17, 0x7a,
-	0x67, 0x9d, 0xdf, 0x48, 0xc9, 0x94, 0x74, 0xc7, 0xc1, 0x38, 0x3a, 0x9c, 0xfb, 0xb7, 0x3b, 0x1b,
-	0x2e, 0x9e, 0x5c, 0x37, 0xfe, 0x28, 0xd9, 0x13, 0xa2, 0xf7, 0xf0, 0xe8, 0xe3, 0x4a, 0xad, 0x04,
-	0xbb, 0x34, 0x49, 0x39, 0x5f, 0x33, 0x51, 0x71, 0xe1, 0x4e, 0xcc, 0x64, 0xfe, 0xae, 0xf1, 0x1f,
-	0xd9, 0x46, 0x86, 0xa8, 0x30, 0x41, 0xb6, 0xac, 0x13, 0x4e, 0xfb, 0xe2, 0x09, 0x7c, 0x3c, 0xf8,
-	0x6e, 0x09, 0x93, 0x35, 0xaf, 0x24, 0x43, 0x4f, 0xe0, 0x1d, 0x63, 0xb3, 0xcc, 0xcc, 0x03, 0x4e,
-	0x62, 0xd8, 0x36, 0xbe, 0xa3, 0x91, 0xb3, 0x93, 0xc4, 0xd1, 0x57, 0x67, 0xd9, 0xfc, 0x1b, 0x80,
-	0xe3, 0x73, 0x99, 0xa3, 0xaf, 0x00, 0xa2, 0x81, 0x7f, 0xf0, 0x1a, 0xff, 0xeb, 0x52, 0xe0, 0xc1,
-	0x66, 0xa6, 0xa7, 0xff, 0x69, 0xd0, 0x4f, 0x13, 0x5f, 0x5c, 0xb7, 0x1e, 0xb8, 0x69, 0x3d, 0xf0,
-	0xb3, 0xf5, 0xc0, 0x97, 0xad, 0x37, 0xba, 0xd9, 0x7a, 0xa3, 0x1f, 0x5b, 0x6f, 0xf4, 0xe1, 0x55,
-	0xbe, 0x54, 0x9f, 0x56, 0x29, 0xbe, 0xe2, 0x25, 0xe9, 0xc2, 0x66, 0x05, 0x4d, 0x65, 0xff, 0x41,
-	0xd6, 0x2f, 0xc8, 0xe6, 0xf6, 0xa5, 0x4c, 0x1d, 0xb3, 0x88, 0xcf, 0x7e, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x05, 0xfc, 0x34, 0xda, 0x2f, 0x03, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x9b, 0xb6, 0x76, 0x75, 0x16, 0x11, 0xc3, 0x2a, 0xa1, 0x8b, 0x49, 0x99, 0x45, 0xa9,
+	0x42, 0x27, 0x6c, 0x15, 0x04, 0x0f, 0xca, 0x66, 0xeb, 0x2e, 0x7b, 0x28, 0xac, 0x11, 0xf1, 0x0f,
+	0x42, 0x99, 0x36, 0xb3, 0x69, 0x31, 0xe9, 0x84, 0xcc, 0xb4, 0xdb, 0xfd, 0x16, 0x5e, 0xbd, 0xf8,
+	0x19, 0x3c, 0xfa, 0x05, 0x84, 0x1e, 0xf7, 0xa8, 0x1e, 0x82, 0xb4, 0xdf, 0xa0, 0x9f, 0x40, 0x66,
+	0x32, 0x59, 0xaa, 0x34, 0xea, 0xb2, 0x9e, 0x3a, 0x9d, 0x3c, 0xef, 0xef, 0x79, 0xe7, 0xcd, 0x93,
+	0x01, 0x0d, 0xca, 0x42, 0xca, 0x06, 0xcc, 0xf6, 0x71, 0x18, 0xda, 0x11, 0xa5, 0x41, 0x23, 0xa4,
+	0x1e, 0x09, 0x98, 0xdd, 0xc5, 0x01, 0x1e, 0xf6, 0x48, 0x6c, 0xf3, 0x89, 0xcd, 0x27, 0x28, 0x8a,
+	0x29, 0xa7, 0x7a, 0x5d, 0xc9, 0x91, 0x90, 0x23, 0x21, 0x4f, 0xd5, 0x28, 0x53, 0xa3, 0xf1, 0x76,
+	0x97, 0x70, 0xbc, 0x5d, 0xdd, 0xf0, 0xa9, 0x4f, 0x65, 0x91, 0x2d, 0x56, 0x69, 0x7d, 0xf5, 0xc1,
+	0xdf, 0xed, 0xb2, 0xc5, 0x21, 0xa5, 0x41, 0x5a, 0x05, 0x3f, 0x17, 0xc1, 0x8d, 0x36, 0xf3, 0x77,
+	0x63, 0x82, 0x39, 0x71, 0x96, 0x9e, 0xeb, 0x77, 0x41, 0x85, 0x91, 0xa1, 0x47, 0x62, 0x43, 0xab,
+	0x69, 0xf5, 0x2b, 0xce, 0xf5, 0x45, 0x62, 0x5d, 0x3d, 0xc1, 0x61, 0xf0, 0x08, 0xa6, 0xfb, 0xd0,
+	0x55, 0x02, 0xfd, 0x35, 0x58, 0x17, 0x7e, 0x9d, 0x08, 0xc7, 0x38, 0x64, 0x46, 0xb1, 0xa6, 0xd5,
+	0xd7, 0x9b, 0x35, 0xf4, 0xcb, 0x81, 0x54, 0xf3, 0x48, 0xb0, 0x0f, 0xa5, 0xce, 0xb9, 0xb9, 0x48,
+	0x2c, 0x3d, 0x25, 0x2e, 0x95, 0x43, 0x17, 0x44, 0x67, 0x1a, 0x7d, 0x4f, 0xa1, 0x31, 0x63, 0x84,
+	0x33, 0xa3, 0x54, 0x2b, 0xd5, 0xd7, 0x9b, 0x56, 0x3e, 0x7a, 0x47, 0xe8, 0x9c, 0xf2, 0x34, 0xb1,
+	0x0a, 0x29, 0x47, 0x6e, 0x30, 0xfd, 0x19, 0xd8, 0x38, 0x1a, 0xf1, 0x51, 0x4c, 0x3a, 0x12, 0xe7,
+	0xd3, 0x31, 0x89, 0x87, 0x34, 0x36, 0xca, 0xf2, 0x6c, 0xd6, 0x22, 0xb1, 0x36, 0xd3, 0x4e, 0x56,
+	0xa9, 0xa0, 0xab, 0xa7, 0xdb, 0xc2, 0x61, 0x3f, 0xdb, 0x6c, 0x81, 0x5b, 0x2b, 0x27, 0xe7, 0x12,
+	0x16, 0xd1, 0x21, 0x23, 0xfa, 0x16, 0x58, 0x93, 0x98, 0x81, 0x27, 0x47, 0x58, 0x76, 0xc0, 0x2c,
+	0xb1, 0x2a, 0x42, 0x72, 0xd0, 0x72, 0x2b, 0xe2, 0xd1, 0x81, 0x07, 0xbf, 0x94, 0xc0, 0x66, 0x9b,
+	0xf9, 0x2f, 0x22, 0xef, 0x37, 0x8c, 0x1a, 0xc0, 0x39, 0x5e, 0xc3, 0x92, 0x5f, 0x31, 0xcf, 0x4f,
+	0x7f, 0x0b, 0x2e, 0xb3, 0x63, 0x1c, 0x75, 0x8e, 0x08, 0x31, 0x4a, 0x92, 0xb8, 0x33, 0x4d, 0x2c,
+	0xed, 0x7b, 0x62, 0xdd, 0xf1, 0x07, 0xbc, 0x3f, 0xea, 0xa2, 0x1e, 0x0d, 0xed, 0x9e, 0x1c, 0xb0,
+	0xfa, 0x69, 0x30, 0xef, 0x9d, 0xcd, 0x4f, 0x22, 0xc2, 0x50, 0x8b, 0xf4, 0x16, 0x89, 0x75, 0x4d,
+	0xf9, 0x2b, 0x0e, 0x74, 0xd7, 0xc4, 0x72, 0x8f, 0x10, 0x41, 0x27, 0x93, 0x01, 0x97, 0xf4, 0xf2,
+	0xc5, 0xe8, 0x19, 0x07, 0xba, 0x6b, 0x62, 0x29, 0xe8, 0x1f, 0x34, 0xb0, 0xc9, 0x42, 0x4a, 0x79,
+	0xbf, 0x73, 0x4c, 0x06, 0x7e, 0x9f, 0x77, 0x7a, 0x7d, 0x3c, 0xf4, 0x49, 0x16, 0xbc, 0x4b, 0x32,
+	0x78, 0x68, 0x75, 0x3a, 0x9e, 0xcb, 0xc2, 0x97, 0xb2, 0x6e, 0x57, 0x96, 0xa9, 0x18, 0xde, 0x13,
+	0x1d, 0x2e, 0x12, 0x0b, 0xaa, 0x53, 0xe5, 0x1b, 0x40, 0xd7, 0x60, 0x39, 0x14, 0x78, 0x1b, 0x6c,
+	0xfd, 0xe1, 0x35, 0x66, 0x99, 0x68, 0x7e, 0x2b, 0x82, 0x52, 0x9b, 0xf9, 0xfa, 0x47, 0x0d, 0xe8,
+	0x2b, 0x3e, 0xba, 0x27, 0xe8, 0x5f, 0x6f, 0x01, 0xb4, 0x32, 0x7b, 0xd5, 0xfd, 0x0b, 0x02, 0xce,
+	0xc2, 0xfb, 0x49, 0x03, 0x46, 0x6e, 0x28, 0x9f, 0x9e, 0xcb, 0x25, 0x0f, 0x53, 0x6d, 0xff, 0x17,
+	0x4c, 0xd6, 0xb2, 0xf3, 0x6a, 0x3a, 0x33, 0xb5, 0xd3, 0x99, 0xa9, 0xfd, 0x98, 0x99, 0xda, 0xfb,
+	0xb9, 0x59, 0x38, 0x9d, 0x9b, 0x85, 0xaf, 0x73, 0xb3, 0xf0, 0xe6, 0xf1, 0x52, 0xf8, 0x94, 0x65,
+	0x23, 0xc0, 0x5d, 0x96, 0xfd, 0xb1, 0xc7, 0x0f, 0xed, 0x49, 0xfe, 0xc5, 0xd9, 0xad, 0xc8, 0xcb,
+	0xf2, 0xfe, 0xcf, 0x01, 0x00, 0x89, 0x58, 0xba, 0x26, 0xd3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -194,6 +312,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	UpdateBalancerPoolParams(ctx context.Context, in *MsgUpdateBalancerPoolParams, opts ...grpc.CallOption) (*MsgUpdateBalancerPoolParamsResponse, error)
 }
 
 type msgClient struct {
@@ -213,9 +332,19 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) UpdateBalancerPoolParams(ctx context.Context, in *MsgUpdateBalancerPoolParams, opts ...grpc.CallOption) (*MsgUpdateBalancerPoolParamsResponse, error) {
+	out := new(MsgUpdateBalancerPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateBalancerPoolParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	UpdateBalancerPoolParams(context.Context, *MsgUpdateBalancerPoolParams) (*MsgUpdateBalancerPoolParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -225,6 +354,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBalancerPoolParams(ctx context.Context, req *MsgUpdateBalancerPoolParams) (*MsgUpdateBalancerPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBalancerPoolParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -248,6 +380,24 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBalancerPoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBalancerPoolParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBalancerPoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateBalancerPoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBalancerPoolParams(ctx, req.(*MsgUpdateBalancerPoolParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -256,6 +406,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "UpdateBalancerPoolParams",
+			Handler:    _Msg_UpdateBalancerPoolParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
@@ -352,6 +506,100 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateBalancerPoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBalancerPoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBalancerPoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExitFee != nil {
+		{
+			size := m.ExitFee.Size()
+			i -= size
+			if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SwapFee != nil {
+		{
+			size := m.SwapFee.Size()
+			i -= size
+			if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBalancerPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBalancerPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBalancerPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -402,6 +650,43 @@ func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateBalancerPoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.SwapFee != nil {
+		l = m.SwapFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExitFee != nil {
+		l = m.ExitFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBalancerPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -661,6 +946,265 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateBalancerPoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFee = &v
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ExitFee = &v
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &SmoothWeightChangeParams{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBalancerPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,222 @@
+package concentrated
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// swapResult is the pool state after a swap, which is applied to the pool
+// only by the mutative swap methods.
+type swapResult struct {
+	tokenIn          sdk.Coin
+	tokenOut         sdk.Coin
+	sqrtPrice        sdk.Dec
+	tick             int64
+	liquidity        sdk.Dec
+	feeGrowthGlobal0 sdk.Dec
+	feeGrowthGlobal1 sdk.Dec
+	// crossedTicks are the ticks the swap crossed, with their fee growth
+	// outside updated.
+	crossedTicks []types.Tick
+}
+
+// computeSwap swaps tokenSpecified against the pool, stepping through every
+// initialized tick range the price crosses.
+// If exactIn is set, tokenSpecified is the token in and otherDenom the denom
+// out, otherwise tokenSpecified is the token out and otherDenom the denom in.
+// Neither the pool nor its ticks are mutated.
+func (p Pool) computeSwap(ticks TickStore, tokenSpecified sdk.Coin, otherDenom string, exactIn bool, swapFee sdk.Dec) (swapResult, error) {
+	if !(tokenSpecified.Denom == p.Token0 && otherDenom == p.Token1) && !(tokenSpecified.Denom == p.Token1 && otherDenom == p.Token0) {
+		return swapResult{}, fmt.Errorf("pool %d does not trade %s against %s", p.Id, tokenSpecified.Denom, otherDenom)
+	}
+	if !tokenSpecified.Amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "token amount must be positive, was %s", tokenSpecified.Amount)
+	}
+	// zeroForOne swaps token0 in for token1 out, moving the price down.
+	zeroForOne := (tokenSpecified.Denom == p.Token0) == exactIn
+
+	res := swapResult{
+		sqrtPrice:        p.CurrentSqrtPrice,
+		tick:             p.CurrentTick,
+		liquidity:        p.Liquidity,
+		feeGrowthGlobal0: p.FeeGrowthGlobal0,
+		feeGrowthGlobal1: p.FeeGrowthGlobal1,
+	}
+	amountRemaining := tokenSpecified.Amount.ToDec()
+	amountCalculated := sdk.ZeroDec()
+
+	for amountRemaining.IsPositive() {
+		nextTick, found := ticks.NextInitializedTick(res.tick, zeroForOne)
+		sqrtPriceTarget := MaxSqrtPrice
+		switch {
+		case found:
+			sqrtPriceTarget = mustTickToSqrtPrice(nextTick.Index)
+		case zeroForOne:
+			sqrtPriceTarget = MinSqrtPrice
+		}
+
+		step, err := computeSwapStep(res.sqrtPrice, sqrtPriceTarget, res.liquidity, amountRemaining, swapFee, exactIn, zeroForOne)
+		if err != nil {
+			return swapResult{}, err
+		}
+		res.sqrtPrice = step.sqrtPriceNext
+		if exactIn {
+			amountRemaining = amountRemaining.Sub(step.amountIn).Sub(step.feeAmount)
+			amountCalculated = amountCalculated.Add(step.amountOut)
+		} else {
+			amountRemaining = amountRemaining.Sub(step.amountOut)
+			amountCalculated = amountCalculated.Add(step.amountIn).Add(step.feeAmount)
+		}
+
+		// the fee is shared among the liquidity active in this step
+		if res.liquidity.IsPositive() {
+			feeGrowth := step.feeAmount.QuoTruncate(res.liquidity)
+			if zeroForOne {
+				res.feeGrowthGlobal0 = res.feeGrowthGlobal0.Add(feeGrowth)
+			} else {
+				res.feeGrowthGlobal1 = res.feeGrowthGlobal1.Add(feeGrowth)
+			}
+		}
+
+		if !res.sqrtPrice.Equal(sqrtPriceTarget) {
+			res.tick, err = SqrtPriceToTick(res.sqrtPrice)
+			if err != nil {
+				return swapResult{}, err
+			}
+			continue
+		}
+		if !found {
+			// the price reached the end of the supported range
+			break
+		}
+
+		// cross the tick, updating the active liquidity
+		crossed := nextTick
+		crossed.FeeGrowthOutside0 = res.feeGrowthGlobal0.Sub(nextTick.FeeGrowthOutside0)
+		crossed.FeeGrowthOutside1 = res.feeGrowthGlobal1.Sub(nextTick.FeeGrowthOutside1)
+		res.crossedTicks = append(res.crossedTicks, crossed)
+		if zeroForOne {
+			res.liquidity = res.liquidity.Sub(nextTick.LiquidityNet)
+			res.tick = nextTick.Index - 1
+		} else {
+			res.liquidity = res.liquidity.Add(nextTick.LiquidityNet)
+			res.tick = nextTick.Index
+		}
+	}
+
+	if amountRemaining.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity,
+			"pool %d does not have enough liquidity to swap %s", p.Id, tokenSpecified)
+	}
+
+	if exactIn {
+		res.tokenIn = tokenSpecified
+		res.tokenOut = sdk.NewCoin(otherDenom, amountCalculated.TruncateInt())
+	} else {
+		res.tokenIn = sdk.NewCoin(otherDenom, amountCalculated.Ceil().TruncateInt())
+		res.tokenOut = tokenSpecified
+	}
+	if res.tokenOut.Amount.GT(p.PoolLiquidity.AmountOf(res.tokenOut.Denom)) {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
+			"pool %d holds %s, cannot swap out %s", p.Id, p.PoolLiquidity, res.tokenOut)
+	}
+
+	return res, nil
+}
+
+// applySwap updates the pool state and its ticks to the result of a swap.
+func (p *Pool) applySwap(ticks TickStore, res swapResult) {
+	p.CurrentSqrtPrice = res.sqrtPrice
+	p.CurrentTick = res.tick
+	p.Liquidity = res.liquidity
+	p.FeeGrowthGlobal0 = res.feeGrowthGlobal0
+	p.FeeGrowthGlobal1 = res.feeGrowthGlobal1
+	for _, crossed := range res.crossedTicks {
+		ticks.SetTick(crossed)
+	}
+	p.PoolLiquidity = p.PoolLiquidity.Add(res.tokenIn).Sub(sdk.NewCoins(res.tokenOut))
+}
+
+// errSwapWithoutTicks is returned by the swap methods of types.PoolI, as the
+// ticks of a concentrated liquidity pool are not part of the pool. Swaps go
+// through the gamm keeper, which calls the WithTicks methods instead.
+var errSwapWithoutTicks = sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are swapped against along with their tick store")
+
+// CalcOutAmtGivenIn is not supported, see CalcOutAmtGivenInWithTicks.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	return sdk.Coin{}, errSwapWithoutTicks
+}
+
+// SwapOutAmtGivenIn is not supported, see SwapOutAmtGivenInWithTicks.
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapWithoutTicks
+}
+
+// CalcInAmtGivenOut is not supported, see CalcInAmtGivenOutWithTicks.
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapWithoutTicks
+}
+
+// SwapInAmtGivenOut is not supported, see SwapInAmtGivenOutWithTicks.
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapWithoutTicks
+}
+
+// CalcOutAmtGivenInWithTicks calculates the amount of tokenOutDenom swapped
+// out for tokensIn, stepping through the ticks the price crosses.
+func (p Pool) CalcOutAmtGivenInWithTicks(ticks TickStore, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if tokensIn.Len() != 1 {
+		return sdk.Coin{}, errors.New("concentrated liquidity pool only supports swapping one token in")
+	}
+	res, err := p.computeSwap(ticks, tokensIn[0], tokenOutDenom, true, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.tokenOut, nil
+}
+
+// SwapOutAmtGivenInWithTicks is a mutative method for CalcOutAmtGivenInWithTicks,
+// which includes the actual swap. The ticks crossed are updated in ticks.
+func (p *Pool) SwapOutAmtGivenInWithTicks(ticks TickStore, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	if tokensIn.Len() != 1 {
+		return sdk.Coin{}, errors.New("concentrated liquidity pool only supports swapping one token in")
+	}
+	res, err := p.computeSwap(ticks, tokensIn[0], tokenOutDenom, true, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	p.applySwap(ticks, res)
+	return res.tokenOut, nil
+}
+
+// CalcInAmtGivenOutWithTicks calculates the amount of tokenInDenom to swap
+// in to receive tokensOut, stepping through the ticks the price crosses.
+func (p Pool) CalcInAmtGivenOutWithTicks(ticks TickStore, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	if tokensOut.Len() != 1 {
+		return sdk.Coin{}, errors.New("concentrated liquidity pool only supports swapping one token out")
+	}
+	res, err := p.computeSwap(ticks, tokensOut[0], tokenInDenom, false, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.tokenIn, nil
+}
+
+// SwapInAmtGivenOutWithTicks is a mutative method for CalcInAmtGivenOutWithTicks,
+// which includes the actual swap. The ticks crossed are updated in ticks.
+func (p *Pool) SwapInAmtGivenOutWithTicks(ticks TickStore, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	if tokensOut.Len() != 1 {
+		return sdk.Coin{}, errors.New("concentrated liquidity pool only supports swapping one token out")
+	}
+	res, err := p.computeSwap(ticks, tokensOut[0], tokenInDenom, false, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	p.applySwap(ticks, res)
+	return res.tokenIn, nil
+}
@@ -0,0 +1,203 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+const (
+	denom0 = "bar"
+	denom1 = "foo"
+)
+
+var defaultSwapFee = sdk.NewDecWithPrec(3, 3)
+
+// newTestTickStore returns an empty tick store, backed by an in-memory store.
+func newTestTickStore() TickStore {
+	return NewKVTickStore(dbadapter.Store{DB: dbm.NewMemDB()})
+}
+
+// countTicks returns the number of initialized ticks in ticks.
+func countTicks(ticks TickStore) int {
+	count := 0
+	for tick, found := ticks.NextInitializedTick(MinTick-1, false); found; tick, found = ticks.NextInitializedTick(tick.Index, false) {
+		count++
+	}
+	return count
+}
+
+// newTestPool returns a pool at a price of 1 foo per bar, with a full range
+// position and a narrow position around the current price, along with its ticks.
+func newTestPool(t *testing.T) (Pool, TickStore, []types.Position) {
+	pool, err := NewConcentratedPool(1, denom0, denom1, 10, defaultSwapFee, sdk.OneDec())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), pool.CurrentTick)
+	ticks := newTestTickStore()
+
+	positions := []types.Position{
+		types.NewPosition(1, "alice", -100000, 100000),
+		types.NewPosition(1, "bob", -100, 100),
+	}
+	for i := range positions {
+		liquidity, err := pool.GetLiquidityForAmounts(positions[i].LowerTick, positions[i].UpperTick, sdk.NewInt(1000000), sdk.NewInt(1000000))
+		require.NoError(t, err)
+		tokensIn, fees, err := pool.UpdatePosition(ticks, &positions[i], liquidity)
+		require.NoError(t, err)
+		require.True(t, fees.IsZero())
+		// an in-range position deposits both tokens, at most the desired amounts
+		require.Equal(t, 2, tokensIn.Len())
+		require.True(t, tokensIn.IsAllLTE(sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000000), sdk.NewInt64Coin(denom1, 1000000))))
+	}
+	return pool, ticks, positions
+}
+
+func TestUpdatePosition(t *testing.T) {
+	pool, ticks, positions := newTestPool(t)
+	require.Equal(t, 4, countTicks(ticks))
+	require.Equal(t, positions[0].Liquidity.Add(positions[1].Liquidity), pool.Liquidity)
+
+	// a position above the current price only holds token0
+	above := types.NewPosition(1, "carol", 200, 300)
+	liquidity, err := pool.GetLiquidityForAmounts(above.LowerTick, above.UpperTick, sdk.NewInt(1000), sdk.NewInt(1000))
+	require.NoError(t, err)
+	tokensIn, _, err := pool.UpdatePosition(ticks, &above, liquidity)
+	require.NoError(t, err)
+	require.Equal(t, denom0, tokensIn.GetDenomByIndex(0))
+	require.Equal(t, 1, tokensIn.Len())
+	require.Equal(t, 6, countTicks(ticks))
+
+	// a position below the current price only holds token1
+	below := types.NewPosition(1, "carol", -300, -200)
+	liquidity, err = pool.GetLiquidityForAmounts(below.LowerTick, below.UpperTick, sdk.NewInt(1000), sdk.NewInt(1000))
+	require.NoError(t, err)
+	tokensIn, _, err = pool.UpdatePosition(ticks, &below, liquidity)
+	require.NoError(t, err)
+	require.Equal(t, denom1, tokensIn.GetDenomByIndex(0))
+	require.Equal(t, 1, tokensIn.Len())
+
+	// withdrawing returns at most what was deposited, and clears unused ticks
+	tokensOut, fees, err := pool.UpdatePosition(ticks, &below, below.Liquidity.Neg())
+	require.NoError(t, err)
+	require.True(t, fees.IsZero())
+	require.True(t, tokensOut.IsAllLTE(tokensIn))
+	require.True(t, below.Liquidity.IsZero())
+	require.Equal(t, 6, countTicks(ticks))
+
+	// ticks must be aligned to the tick spacing, and liquidity can't go negative
+	misaligned := types.NewPosition(1, "carol", -15, 10)
+	_, _, err = pool.UpdatePosition(ticks, &misaligned, sdk.OneDec())
+	require.Error(t, err)
+	_, _, err = pool.UpdatePosition(ticks, &above, above.Liquidity.Add(sdk.OneDec()).Neg())
+	require.Error(t, err)
+}
+
+func TestCalcOutAmtGivenIn(t *testing.T) {
+	pool, ticks, _ := newTestPool(t)
+	poolBefore := pool.String()
+
+	tokenIn := sdk.NewInt64Coin(denom0, 1000)
+	tokenOut, err := pool.CalcOutAmtGivenInWithTicks(ticks, sdk.NewCoins(tokenIn), denom1, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, denom1, tokenOut.Denom)
+	// the price is ~1, so the fee and price impact make the output a bit smaller
+	require.True(t, tokenOut.Amount.LT(tokenIn.Amount))
+	require.True(t, tokenOut.Amount.GT(sdk.NewInt(990)))
+	require.Equal(t, poolBefore, pool.String())
+
+	swapped, err := pool.SwapOutAmtGivenInWithTicks(ticks, sdk.NewCoins(tokenIn), denom1, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, tokenOut, swapped)
+	require.True(t, pool.CurrentSqrtPrice.LT(sdk.OneDec()))
+	require.True(t, pool.FeeGrowthGlobal0.IsPositive())
+	require.True(t, pool.FeeGrowthGlobal1.IsZero())
+}
+
+func TestCalcInAmtGivenOut(t *testing.T) {
+	pool, ticks, _ := newTestPool(t)
+	poolBefore := pool.String()
+
+	tokenOut := sdk.NewInt64Coin(denom0, 1000)
+	tokenIn, err := pool.CalcInAmtGivenOutWithTicks(ticks, sdk.NewCoins(tokenOut), denom1, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, denom1, tokenIn.Denom)
+	require.True(t, tokenIn.Amount.GT(tokenOut.Amount))
+	require.True(t, tokenIn.Amount.LT(sdk.NewInt(1010)))
+	require.Equal(t, poolBefore, pool.String())
+
+	// swapping the amount in back out of a copy of the pool yields at most the requested amount
+	outGivenIn, err := pool.CalcOutAmtGivenInWithTicks(ticks, sdk.NewCoins(tokenIn), denom0, defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, outGivenIn.Amount.GTE(tokenOut.Amount.SubRaw(1)))
+
+	swapped, err := pool.SwapInAmtGivenOutWithTicks(ticks, sdk.NewCoins(tokenOut), denom1, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, tokenIn, swapped)
+	require.True(t, pool.CurrentSqrtPrice.GT(sdk.OneDec()))
+}
+
+func TestSwapCrossingTicks(t *testing.T) {
+	pool, ticks, positions := newTestPool(t)
+	fullRangeLiquidity := positions[0].Liquidity
+
+	// a swap large enough to push the price out of the narrow position's range
+	tokenIn := sdk.NewInt64Coin(denom0, 1500000)
+	_, err := pool.SwapOutAmtGivenInWithTicks(ticks, sdk.NewCoins(tokenIn), denom1, defaultSwapFee)
+	require.NoError(t, err)
+	require.Less(t, pool.CurrentTick, positions[1].LowerTick)
+	require.Equal(t, fullRangeLiquidity, pool.Liquidity)
+
+	// both positions earned fees, which they collect when they are updated
+	for i := range positions {
+		tokensOut, fees, err := pool.UpdatePosition(ticks, &positions[i], positions[i].Liquidity.Neg())
+		require.NoError(t, err)
+		require.True(t, fees.AmountOf(denom0).IsPositive())
+		require.True(t, fees.AmountOf(denom1).IsZero())
+		require.False(t, tokensOut.IsZero())
+	}
+	require.True(t, pool.Liquidity.IsZero())
+	require.Zero(t, countTicks(ticks))
+	// rounding leaves dust in the pool's favor
+	require.True(t, pool.PoolLiquidity.IsAllPositive() || pool.PoolLiquidity.IsZero())
+}
+
+func TestSwapInsufficientLiquidity(t *testing.T) {
+	pool, err := NewConcentratedPool(1, denom0, denom1, 10, defaultSwapFee, sdk.OneDec())
+	require.NoError(t, err)
+
+	_, err = pool.CalcOutAmtGivenInWithTicks(newTestTickStore(), sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000)), denom1, defaultSwapFee)
+	require.Error(t, err)
+
+	pool, ticks, _ := newTestPool(t)
+	_, err = pool.CalcInAmtGivenOutWithTicks(ticks, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000000)), denom0, defaultSwapFee)
+	require.Error(t, err)
+
+	_, err = pool.CalcOutAmtGivenInWithTicks(ticks, sdk.NewCoins(sdk.NewInt64Coin("baz", 1000)), denom1, defaultSwapFee)
+	require.Error(t, err)
+
+	// the swap methods of types.PoolI can't reach the ticks
+	_, err = pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000)), denom1, defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+}
+
+func TestSpotPrice(t *testing.T) {
+	pool, err := NewConcentratedPool(1, denom0, denom1, 10, defaultSwapFee, sdk.NewDec(4))
+	require.NoError(t, err)
+
+	price, err := pool.SpotPrice(sdk.Context{}, denom1, denom0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), price)
+
+	price, err = pool.SpotPrice(sdk.Context{}, denom0, denom1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), price)
+
+	_, err = pool.SpotPrice(sdk.Context{}, denom0, "baz")
+	require.Error(t, err)
+}
@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/concentrated/concentrated_pool.proto
+
+package concentrated
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool is the concentrated liquidity Pool struct. Its initialized ticks are
+// kept in their own store, keyed by pool and tick, rather than in the pool.
+type Pool struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id      uint64                                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// token0 and token1 are the two denoms of the pool, sorted
+	// lexicographically. Prices are expressed as token1 per token0.
+	Token0 string `protobuf:"bytes,4,opt,name=token0,proto3" json:"token0,omitempty" yaml:"token0"`
+	Token1 string `protobuf:"bytes,5,opt,name=token1,proto3" json:"token1,omitempty" yaml:"token1"`
+	// tick_spacing is the distance that position ticks must be a multiple of.
+	TickSpacing uint64 `protobuf:"varint,6,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	// current_sqrt_price is the square root of the current token1 per token0
+	// price.
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	CurrentTick      int64                                  `protobuf:"varint,8,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// liquidity is the liquidity of all positions in range of the current tick.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee_growth_global0 and fee_growth_global1 are the swap fees collected
+	// per unit of liquidity over the entire life of the pool.
+	FeeGrowthGlobal0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+	// assets in the pool, including swap fees that have not been collected
+	// by positions yet
+	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/concentrated/concentrated_pool.proto", fileDescriptor_5f6d4f20db5d256d)
+}
+
+var fileDescriptor_5f6d4f20db5d256d = []byte{
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xb7, 0xfb, 0x2f, 0xad, 0xd3, 0x96, 0xd4, 0x20, 0xe1, 0x74, 0xb0, 0x23, 0x0f, 0x28, 0x48,
+	0x8d, 0x1d, 0xc3, 0x80, 0x14, 0x89, 0x01, 0x83, 0xa8, 0x10, 0x0c, 0x95, 0xcb, 0x84, 0x2a, 0x19,
+	0xff, 0xb9, 0xb8, 0xa7, 0xd8, 0x3e, 0xc7, 0x77, 0x69, 0xc9, 0x1b, 0x30, 0x32, 0x32, 0x76, 0x66,
+	0xe6, 0x21, 0xaa, 0x4e, 0x1d, 0x11, 0x83, 0x41, 0xc9, 0x1b, 0xe4, 0x09, 0xd0, 0xf9, 0xce, 0x6d,
+	0x50, 0x2a, 0x44, 0x05, 0x93, 0xfd, 0x7d, 0xdf, 0xef, 0xdf, 0x9d, 0xf4, 0x9d, 0xf4, 0x14, 0xe1,
+	0x04, 0x61, 0x88, 0xcd, 0xc8, 0x4b, 0x12, 0x33, 0x43, 0x28, 0xee, 0x24, 0x28, 0x04, 0x31, 0x36,
+	0x03, 0x94, 0x06, 0x20, 0x25, 0xb9, 0x47, 0x40, 0xf8, 0x5b, 0xe1, 0x52, 0x94, 0x91, 0xe5, 0x88,
+	0x20, 0x79, 0x8f, 0xd3, 0x0d, 0x4a, 0x37, 0xe8, 0x80, 0xb1, 0x8d, 0x79, 0x82, 0x71, 0x62, 0xf9,
+	0x80, 0x78, 0xd6, 0x6e, 0x33, 0x28, 0xe1, 0x6e, 0xc9, 0x35, 0x59, 0xc1, 0x84, 0x76, 0xef, 0x45,
+	0x28, 0x42, 0xac, 0x4f, 0xff, 0x78, 0x57, 0x65, 0x18, 0xd3, 0xf7, 0x30, 0x30, 0xb9, 0x8a, 0x19,
+	0x20, 0x98, 0xb2, 0xb9, 0x7e, 0x51, 0x93, 0x56, 0x0e, 0x10, 0x8a, 0xe5, 0x3d, 0xa9, 0xe6, 0x85,
+	0x61, 0x0e, 0x30, 0x56, 0xc4, 0x96, 0xd8, 0xde, 0xb0, 0xe5, 0x59, 0xa1, 0x6d, 0x8f, 0xbd, 0x24,
+	0xee, 0xe9, 0x7c, 0xa0, 0x3b, 0x15, 0x44, 0xde, 0x96, 0x96, 0x60, 0xa8, 0x2c, 0xb5, 0xc4, 0xf6,
+	0x8a, 0xb3, 0x04, 0x43, 0xf9, 0x48, 0x5a, 0xc7, 0xa7, 0x5e, 0xe6, 0xf6, 0x01, 0x50, 0x96, 0x4b,
+	0xfa, 0xb3, 0xf3, 0x42, 0x13, 0xbe, 0x17, 0xda, 0x83, 0x08, 0x92, 0xe3, 0x91, 0x6f, 0x04, 0x28,
+	0xe1, 0x79, 0xf9, 0xa7, 0x83, 0xc3, 0x81, 0x49, 0xc6, 0x19, 0xc0, 0xc6, 0x0b, 0x10, 0xcc, 0x0a,
+	0xed, 0x0e, 0x33, 0xab, 0x74, 0x74, 0xa7, 0x46, 0x7f, 0x5f, 0x02, 0x20, 0x3f, 0x94, 0xd6, 0x08,
+	0x1a, 0x80, 0xb4, 0xab, 0xac, 0x94, 0xda, 0x3b, 0xb3, 0x42, 0xdb, 0x62, 0x68, 0xd6, 0xd7, 0x1d,
+	0x0e, 0xb8, 0x82, 0x5a, 0xca, 0xea, 0x8d, 0x50, 0xab, 0x82, 0x5a, 0x72, 0x4f, 0xda, 0x24, 0x30,
+	0x18, 0xb8, 0x38, 0xf3, 0x02, 0x98, 0x46, 0xca, 0x1a, 0x3d, 0x8d, 0x7d, 0x7f, 0x56, 0x68, 0x77,
+	0x39, 0x61, 0x6e, 0xaa, 0x3b, 0x75, 0x5a, 0x1e, 0xb2, 0x4a, 0x1e, 0x4b, 0x72, 0x30, 0xca, 0x73,
+	0x90, 0x12, 0x17, 0x0f, 0x73, 0xe2, 0x66, 0x39, 0x0c, 0x80, 0x52, 0x2b, 0x2d, 0x5f, 0xdf, 0xfa,
+	0xe4, 0x4d, 0xe6, 0xb7, 0xa8, 0xa8, 0x3b, 0x0d, 0xde, 0x3c, 0x1c, 0xe6, 0xe4, 0x80, 0xb6, 0x68,
+	0xec, 0x0a, 0x48, 0x13, 0x29, 0xeb, 0x2d, 0xb1, 0xbd, 0x3c, 0x1f, 0x7b, 0x7e, 0xaa, 0x3b, 0x75,
+	0x5e, 0xbe, 0x85, 0xc1, 0x40, 0x7e, 0x2f, 0x6d, 0xc4, 0x70, 0x38, 0x82, 0x21, 0x24, 0x63, 0x65,
+	0xa3, 0x4c, 0x6b, 0xdf, 0x3a, 0x6d, 0x83, 0xd9, 0x5c, 0x09, 0xe9, 0xce, 0xb5, 0x28, 0xbd, 0x98,
+	0x3e, 0x00, 0x6e, 0x94, 0xa3, 0x53, 0x72, 0xec, 0x46, 0x31, 0xf2, 0xbd, 0xb8, 0xab, 0x48, 0xff,
+	0x76, 0x31, 0x8b, 0x8a, 0xba, 0xd3, 0xe8, 0x03, 0xb0, 0x5f, 0xf6, 0xf6, 0x59, 0xeb, 0x46, 0x6b,
+	0x4b, 0xa9, 0xff, 0x67, 0x6b, 0x6b, 0xd1, 0xda, 0x92, 0x73, 0x69, 0x9b, 0x6e, 0xae, 0x7b, 0x7d,
+	0xb9, 0x9b, 0xad, 0xe5, 0x76, 0xfd, 0x51, 0xd3, 0xe0, 0x2b, 0x4a, 0xd7, 0xaf, 0x5a, 0x62, 0xe3,
+	0x39, 0x82, 0xa9, 0xdd, 0xa5, 0x89, 0xbe, 0xfc, 0xd0, 0xda, 0x7f, 0x91, 0x88, 0x12, 0xb0, 0xb3,
+	0x45, 0x2d, 0xde, 0x54, 0x0e, 0xbd, 0x9d, 0x8f, 0x67, 0x9a, 0xf0, 0xf9, 0x4c, 0x13, 0x2e, 0xbe,
+	0x76, 0x56, 0xe9, 0x0a, 0xbf, 0xb2, 0x8f, 0xce, 0x27, 0xaa, 0x78, 0x39, 0x51, 0xc5, 0x9f, 0x13,
+	0x55, 0xfc, 0x34, 0x55, 0x85, 0xcb, 0xa9, 0x2a, 0x7c, 0x9b, 0xaa, 0xc2, 0x3b, 0x7b, 0xce, 0x85,
+	0x3f, 0x38, 0x9d, 0xd8, 0xf3, 0x71, 0x55, 0x98, 0x27, 0x4f, 0xcc, 0x0f, 0x7f, 0x7e, 0xc1, 0xfc,
+	0xb5, 0xf2, 0xc5, 0x78, 0xfc, 0x6b, 0x00, 0x9d, 0x1c, 0xc2, 0xb8, 0xf1, 0x04, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolLiquidity) > 0 {
+		for iNdEx := len(m.PoolLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.FeeGrowthGlobal1.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FeeGrowthGlobal0.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CurrentTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TickSpacing != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConcentratedPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConcentratedPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Id))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.TickSpacing))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.CurrentTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if len(m.PoolLiquidity) > 0 {
+		for _, e := range m.PoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConcentratedPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConcentratedPool(x uint64) (n int) {
+	return sovConcentratedPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConcentratedPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConcentratedPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConcentratedPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConcentratedPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConcentratedPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConcentratedPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConcentratedPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,7 @@
+/*
+Package concentrated implements concentrated liquidity AMMs, satisfying the AMM pool interface from
+x/gamm/types. Liquidity providers deposit into positions over a price range [1.0001^lower, 1.0001^upper),
+and swaps step through the initialized ticks the price crosses, only using the liquidity of the
+positions in range. Please refer to the README for further information.
+*/
+package concentrated
@@ -0,0 +1,241 @@
+package concentrated
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+const (
+	// MinTick and MaxTick bound the price range of a pool to
+	// [1.0001^MinTick, 1.0001^MaxTick], roughly [2e-9, 4.8e8].
+	MinTick int64 = -200000
+	MaxTick int64 = 200000
+)
+
+var (
+	// sqrtTickBase is sqrt(1.0001), the ratio between the square root prices
+	// of two adjacent ticks.
+	sqrtTickBase = sdk.MustNewDecFromStr("1.000049998750062496")
+
+	MinSqrtPrice = mustTickToSqrtPrice(MinTick)
+	MaxSqrtPrice = mustTickToSqrtPrice(MaxTick)
+
+	decPrecisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+)
+
+// TickToSqrtPrice returns the square root of the price at the given tick,
+// sqrt(1.0001^tick).
+func TickToSqrtPrice(tick int64) (sdk.Dec, error) {
+	if tick < MinTick || tick > MaxTick {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTick, "tick %d is out of range [%d, %d]", tick, MinTick, MaxTick)
+	}
+	if tick >= 0 {
+		return sqrtTickBase.Power(uint64(tick)), nil
+	}
+	return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick))), nil
+}
+
+func mustTickToSqrtPrice(tick int64) sdk.Dec {
+	sqrtPrice, err := TickToSqrtPrice(tick)
+	if err != nil {
+		panic(err)
+	}
+	return sqrtPrice
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is not
+// greater than sqrtPrice.
+func SqrtPriceToTick(sqrtPrice sdk.Dec) (int64, error) {
+	if sqrtPrice.LT(MinSqrtPrice) || sqrtPrice.GT(MaxSqrtPrice) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidTick, "sqrt price %s is out of range [%s, %s]", sqrtPrice, MinSqrtPrice, MaxSqrtPrice)
+	}
+
+	lo, hi := MinTick, MaxTick
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if mustTickToSqrtPrice(mid).LTE(sqrtPrice) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, nil
+}
+
+// getAmount0Delta returns the amount of token0 that liquidity represents
+// between the two square root prices, L * (sqrtB - sqrtA) / (sqrtA * sqrtB).
+func getAmount0Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return mulRoundUp(liquidity, diff).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return liquidity.MulTruncate(diff).QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// getAmount1Delta returns the amount of token1 that liquidity represents
+// between the two square root prices, L * (sqrtB - sqrtA).
+func getAmount1Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return mulRoundUp(liquidity, diff)
+	}
+	return liquidity.MulTruncate(diff)
+}
+
+// mulRoundUp returns a * b, rounded up rather than to the nearest even like
+// sdk.Dec.Mul. Both a and b must be non-negative.
+func mulRoundUp(a, b sdk.Dec) sdk.Dec {
+	product := new(big.Int).Mul(a.BigInt(), b.BigInt())
+	quo, rem := product.QuoRem(product, decPrecisionMultiplier, new(big.Int))
+	if rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdk.NewDecFromBigIntWithPrec(quo, sdk.Precision)
+}
+
+// getLiquidityForAmount0 returns the liquidity that amount0 of token0 provides
+// between the two square root prices, rounded down.
+func getLiquidityForAmount0(sqrtPriceA, sqrtPriceB sdk.Dec, amount0 sdk.Int) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return amount0.ToDec().MulTruncate(sqrtPriceA).MulTruncate(sqrtPriceB).QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// getLiquidityForAmount1 returns the liquidity that amount1 of token1 provides
+// between the two square root prices, rounded down.
+func getLiquidityForAmount1(sqrtPriceA, sqrtPriceB sdk.Dec, amount1 sdk.Int) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return amount1.ToDec().QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// getNextSqrtPriceFromAmount0 returns the square root price after adding
+// (or removing) amount of token0 to the active liquidity.
+// The result is rounded up, so that the price moves at least as far as the
+// exact amount would move it.
+func getNextSqrtPriceFromAmount0(sqrtPrice, liquidity, amount sdk.Dec, add bool) (sdk.Dec, error) {
+	if amount.IsZero() {
+		return sqrtPrice, nil
+	}
+	numerator := mulRoundUp(liquidity, sqrtPrice)
+	if add {
+		return numerator.QuoRoundUp(liquidity.Add(amount.MulTruncate(sqrtPrice))), nil
+	}
+	denominator := liquidity.Sub(mulRoundUp(amount, sqrtPrice))
+	if !denominator.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "cannot remove %s token0 from liquidity %s", amount, liquidity)
+	}
+	return numerator.QuoRoundUp(denominator), nil
+}
+
+// getNextSqrtPriceFromAmount1 returns the square root price after adding
+// (or removing) amount of token1 to the active liquidity.
+// The result is rounded down, so that the price moves at least as far as the
+// exact amount would move it.
+func getNextSqrtPriceFromAmount1(sqrtPrice, liquidity, amount sdk.Dec, add bool) (sdk.Dec, error) {
+	if add {
+		return sqrtPrice.Add(amount.QuoTruncate(liquidity)), nil
+	}
+	quotient := amount.QuoRoundUp(liquidity)
+	if sqrtPrice.LTE(quotient) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "cannot remove %s token1 from liquidity %s", amount, liquidity)
+	}
+	return sqrtPrice.Sub(quotient), nil
+}
+
+// swapStep is the result of swapping within a single tick range.
+type swapStep struct {
+	sqrtPriceNext sdk.Dec
+	amountIn      sdk.Dec
+	amountOut     sdk.Dec
+	feeAmount     sdk.Dec
+}
+
+// computeSwapStep swaps amountRemaining against liquidity, starting from
+// sqrtPriceCurrent and moving at most to sqrtPriceTarget.
+// amountRemaining is the amount of token in left to swap when exactIn is set,
+// and the amount of token out left to receive otherwise.
+// The amount in is rounded up and the amount out rounded down, in the pool's
+// favor.
+func computeSwapStep(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec, exactIn, zeroForOne bool) (swapStep, error) {
+	var (
+		step        = swapStep{}
+		err         error
+		reachTarget bool
+	)
+
+	if exactIn {
+		amountRemainingLessFee := amountRemaining.MulTruncate(sdk.OneDec().Sub(swapFee))
+		if zeroForOne {
+			step.amountIn = getAmount0Delta(sqrtPriceTarget, sqrtPriceCurrent, liquidity, true)
+		} else {
+			step.amountIn = getAmount1Delta(sqrtPriceCurrent, sqrtPriceTarget, liquidity, true)
+		}
+		if amountRemainingLessFee.GTE(step.amountIn) {
+			step.sqrtPriceNext = sqrtPriceTarget
+			reachTarget = true
+		} else if zeroForOne {
+			step.sqrtPriceNext, err = getNextSqrtPriceFromAmount0(sqrtPriceCurrent, liquidity, amountRemainingLessFee, true)
+		} else {
+			step.sqrtPriceNext, err = getNextSqrtPriceFromAmount1(sqrtPriceCurrent, liquidity, amountRemainingLessFee, true)
+		}
+	} else {
+		if zeroForOne {
+			step.amountOut = getAmount1Delta(sqrtPriceTarget, sqrtPriceCurrent, liquidity, false)
+		} else {
+			step.amountOut = getAmount0Delta(sqrtPriceCurrent, sqrtPriceTarget, liquidity, false)
+		}
+		if amountRemaining.GTE(step.amountOut) {
+			step.sqrtPriceNext = sqrtPriceTarget
+			reachTarget = true
+		} else if zeroForOne {
+			step.sqrtPriceNext, err = getNextSqrtPriceFromAmount1(sqrtPriceCurrent, liquidity, amountRemaining, false)
+		} else {
+			step.sqrtPriceNext, err = getNextSqrtPriceFromAmount0(sqrtPriceCurrent, liquidity, amountRemaining, false)
+		}
+	}
+	if err != nil {
+		return swapStep{}, err
+	}
+
+	if zeroForOne {
+		if !(reachTarget && exactIn) {
+			step.amountIn = getAmount0Delta(step.sqrtPriceNext, sqrtPriceCurrent, liquidity, true)
+		}
+		if !(reachTarget && !exactIn) {
+			step.amountOut = getAmount1Delta(step.sqrtPriceNext, sqrtPriceCurrent, liquidity, false)
+		}
+	} else {
+		if !(reachTarget && exactIn) {
+			step.amountIn = getAmount1Delta(sqrtPriceCurrent, step.sqrtPriceNext, liquidity, true)
+		}
+		if !(reachTarget && !exactIn) {
+			step.amountOut = getAmount0Delta(sqrtPriceCurrent, step.sqrtPriceNext, liquidity, false)
+		}
+	}
+
+	// When the target isn't reached, the price was moved by at least the
+	// amount remaining, so the step consumes all of it.
+	if !exactIn && !reachTarget {
+		step.amountOut = amountRemaining
+	}
+	if exactIn && !reachTarget {
+		step.feeAmount = amountRemaining.Sub(step.amountIn)
+	} else {
+		step.feeAmount = mulRoundUp(step.amountIn, swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+	}
+
+	return step, nil
+}
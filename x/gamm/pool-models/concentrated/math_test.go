@@ -0,0 +1,176 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	tolerance := sdk.NewDecWithPrec(1, 15)
+
+	tests := map[string]struct {
+		tick          int64
+		expectedPrice sdk.Dec
+		expectErr     bool
+	}{
+		"tick 0":         {tick: 0, expectedPrice: sdk.OneDec()},
+		"tick 1":         {tick: 1, expectedPrice: sdk.MustNewDecFromStr("1.0001")},
+		"tick -1":        {tick: -1, expectedPrice: sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.0001"))},
+		"tick 10":        {tick: 10, expectedPrice: sdk.MustNewDecFromStr("1.0001").Power(10)},
+		"tick -10":       {tick: -10, expectedPrice: sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.0001").Power(10))},
+		"tick 6932":      {tick: 6932, expectedPrice: sdk.MustNewDecFromStr("2.000036323830948")},
+		"above max tick": {tick: MaxTick + 1, expectErr: true},
+		"below min tick": {tick: MinTick - 1, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPrice, err := TickToSqrtPrice(tc.tick)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			price := sqrtPrice.Mul(sqrtPrice)
+			diff := price.Sub(tc.expectedPrice).Abs()
+			// compare relatively to the expected price
+			require.True(t, diff.LTE(tc.expectedPrice.Mul(tolerance).Add(sdk.NewDecWithPrec(1, 6))),
+				"expected price %s, got %s", tc.expectedPrice, price)
+		})
+	}
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tick := range []int64{MinTick, -100000, -4321, -1, 0, 1, 4321, 100000, MaxTick} {
+		sqrtPrice, err := TickToSqrtPrice(tick)
+		require.NoError(t, err)
+
+		// the exact sqrt price of a tick maps back to it
+		actualTick, err := SqrtPriceToTick(sqrtPrice)
+		require.NoError(t, err)
+		require.Equal(t, tick, actualTick)
+
+		if tick == MaxTick {
+			continue
+		}
+		// any sqrt price up to the next tick rounds down to the tick
+		nextSqrtPrice, err := TickToSqrtPrice(tick + 1)
+		require.NoError(t, err)
+		actualTick, err = SqrtPriceToTick(nextSqrtPrice.Sub(sdk.SmallestDec()))
+		require.NoError(t, err)
+		require.Equal(t, tick, actualTick)
+	}
+
+	_, err := SqrtPriceToTick(MinSqrtPrice.Sub(sdk.SmallestDec()))
+	require.Error(t, err)
+	_, err = SqrtPriceToTick(MaxSqrtPrice.Add(sdk.SmallestDec()))
+	require.Error(t, err)
+}
+
+func TestGetAmountDeltaRounding(t *testing.T) {
+	// 2.5e-18 and 0.5e-18 are products that sdk.Dec.Mul rounds down, to the
+	// nearest even 2e-18 and 0 respectively
+	liquidity := sdk.MustNewDecFromStr("0.5")
+	for _, diff := range []sdk.Dec{sdk.NewDecWithPrec(5, 18), sdk.NewDecWithPrec(1, 18)} {
+		sqrtPriceA := sdk.OneDec()
+		sqrtPriceB := sqrtPriceA.Add(diff)
+
+		amount1Up := getAmount1Delta(sqrtPriceA, sqrtPriceB, liquidity, true)
+		amount1Down := getAmount1Delta(sqrtPriceA, sqrtPriceB, liquidity, false)
+		require.Equal(t, amount1Down.Add(sdk.SmallestDec()), amount1Up, "diff %s", diff)
+
+		amount0Up := getAmount0Delta(sqrtPriceA, sqrtPriceB, liquidity, true)
+		amount0Down := getAmount0Delta(sqrtPriceA, sqrtPriceB, liquidity, false)
+		require.True(t, amount0Up.GT(amount0Down), "diff %s", diff)
+	}
+
+	// exact products are not rounded
+	require.Equal(t, sdk.NewDec(6), mulRoundUp(sdk.NewDec(2), sdk.NewDec(3)))
+}
+
+func TestComputeSwapStep(t *testing.T) {
+	liquidity := sdk.NewDec(1000000)
+	sqrtPriceCurrent := sdk.OneDec()
+	swapFee := sdk.NewDecWithPrec(3, 3)
+
+	tests := map[string]struct {
+		sqrtPriceTarget sdk.Dec
+		amountRemaining sdk.Dec
+		exactIn         bool
+		zeroForOne      bool
+
+		expectReachTarget bool
+	}{
+		"exact in, token0 for token1, within the range": {
+			sqrtPriceTarget: sdk.MustNewDecFromStr("0.9"),
+			amountRemaining: sdk.NewDec(1000),
+			exactIn:         true,
+			zeroForOne:      true,
+		},
+		"exact in, token0 for token1, to the target": {
+			sqrtPriceTarget: sdk.MustNewDecFromStr("0.9999"),
+			amountRemaining: sdk.NewDec(1000),
+			exactIn:         true,
+			zeroForOne:      true,
+
+			expectReachTarget: true,
+		},
+		"exact in, token1 for token0, within the range": {
+			sqrtPriceTarget: sdk.MustNewDecFromStr("1.1"),
+			amountRemaining: sdk.NewDec(1000),
+			exactIn:         true,
+			zeroForOne:      false,
+		},
+		"exact out, token0 for token1, within the range": {
+			sqrtPriceTarget: sdk.MustNewDecFromStr("0.9"),
+			amountRemaining: sdk.NewDec(1000),
+			exactIn:         false,
+			zeroForOne:      true,
+		},
+		"exact out, token1 for token0, to the target": {
+			sqrtPriceTarget: sdk.MustNewDecFromStr("1.0001"),
+			amountRemaining: sdk.NewDec(1000),
+			exactIn:         false,
+			zeroForOne:      false,
+
+			expectReachTarget: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			step, err := computeSwapStep(sqrtPriceCurrent, tc.sqrtPriceTarget, liquidity, tc.amountRemaining, swapFee, tc.exactIn, tc.zeroForOne)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectReachTarget, step.sqrtPriceNext.Equal(tc.sqrtPriceTarget))
+			if tc.zeroForOne {
+				require.True(t, step.sqrtPriceNext.LT(sqrtPriceCurrent))
+				require.True(t, step.sqrtPriceNext.GTE(tc.sqrtPriceTarget))
+			} else {
+				require.True(t, step.sqrtPriceNext.GT(sqrtPriceCurrent))
+				require.True(t, step.sqrtPriceNext.LTE(tc.sqrtPriceTarget))
+			}
+
+			// the amounts never exceed what remains to be swapped
+			if tc.exactIn {
+				require.True(t, step.amountIn.Add(step.feeAmount).LTE(tc.amountRemaining))
+				if !tc.expectReachTarget {
+					require.Equal(t, tc.amountRemaining, step.amountIn.Add(step.feeAmount))
+				}
+			} else {
+				require.True(t, step.amountOut.LTE(tc.amountRemaining))
+				if !tc.expectReachTarget {
+					require.Equal(t, tc.amountRemaining, step.amountOut)
+				}
+			}
+
+			// at a price of ~1, the amount out is less than the amount in
+			require.True(t, step.amountOut.LT(step.amountIn.Add(step.feeAmount)))
+			require.True(t, step.feeAmount.IsPositive())
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+)
+
+var _ sdk.Msg = &MsgCreateConcentratedPool{}
+
+func NewMsgCreateConcentratedPool(
+	sender sdk.AccAddress,
+	denom0, denom1 string,
+	tickSpacing uint64,
+	swapFee sdk.Dec,
+	initialSpotPrice sdk.Dec,
+) MsgCreateConcentratedPool {
+	return MsgCreateConcentratedPool{
+		Sender:           sender.String(),
+		Denom0:           denom0,
+		Denom1:           denom1,
+		TickSpacing:      tickSpacing,
+		SwapFee:          swapFee,
+		InitialSpotPrice: initialSpotPrice,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return types.RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(msg.Denom1); err != nil {
+		return err
+	}
+	if msg.Denom0 >= msg.Denom1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom0 (%s) must sort before denom1 (%s)", msg.Denom0, msg.Denom1)
+	}
+
+	if msg.TickSpacing == 0 || int64(msg.TickSpacing) > MaxTick {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "tick spacing must be in (0, %d], was %d", MaxTick, msg.TickSpacing)
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+	if msg.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+
+	if msg.InitialSpotPrice.IsNil() || !msg.InitialSpotPrice.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "initial spot price must be positive")
+	}
+	sqrtPrice, err := msg.InitialSpotPrice.ApproxSqrt()
+	if err != nil {
+		return err
+	}
+	if sqrtPrice.LT(MinSqrtPrice) || sqrtPrice.GT(MaxSqrtPrice) {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "initial spot price %s is out of the supported range", msg.InitialSpotPrice)
+	}
+
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+// CreatePool creates a concentrated liquidity pool without any positions.
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	pool, err := NewConcentratedPool(poolId, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.SwapFee, msg.InitialSpotPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return types.RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := validateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if !msg.TokensDesired.IsValid() || !msg.TokensDesired.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensDesired.String())
+	}
+	if msg.TokensDesired.Len() > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "at most 2 tokens can be deposited, got %s", msg.TokensDesired)
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() ||
+		msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrNotPositiveCriteria, "min token amounts must not be negative")
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return types.RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := validateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return types.ErrNotPositiveLiquidity
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateTickRange is the stateless part of validating a position's ticks,
+// the tick spacing is checked against the pool.
+func validateTickRange(lowerTick, upperTick int64) error {
+	if lowerTick >= upperTick {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "lower tick %d must be less than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "ticks [%d, %d] are out of range [%d, %d]", lowerTick, upperTick, MinTick, MaxTick)
+	}
+	return nil
+}
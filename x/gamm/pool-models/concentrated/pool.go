@@ -0,0 +1,321 @@
+package concentrated
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var _ types.PoolI = &Pool{}
+
+// NewConcentratedPool returns a concentrated liquidity pool without any
+// positions, with its price initialized to initialSpotPrice (denom1 per denom0).
+// Invariants that are assumed to be satisfied and not checked:
+// * denom0 < denom1
+// * poolID doesn't already exist
+func NewConcentratedPool(poolId uint64, denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec, initialSpotPrice sdk.Dec) (Pool, error) {
+	sqrtPrice, err := initialSpotPrice.ApproxSqrt()
+	if err != nil {
+		return Pool{}, err
+	}
+	currentTick, err := SqrtPriceToTick(sqrtPrice)
+	if err != nil {
+		return Pool{}, err
+	}
+
+	pool := Pool{
+		Address:          types.NewPoolAddress(poolId).String(),
+		Id:               poolId,
+		SwapFee:          swapFee,
+		Token0:           denom0,
+		Token1:           denom1,
+		TickSpacing:      tickSpacing,
+		CurrentSqrtPrice: sqrtPrice,
+		CurrentTick:      currentTick,
+		Liquidity:        sdk.ZeroDec(),
+		FeeGrowthGlobal0: sdk.ZeroDec(),
+		FeeGrowthGlobal1: sdk.ZeroDec(),
+		PoolLiquidity:    sdk.Coins{},
+	}
+
+	return pool, nil
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// GetExitFee returns zero, as liquidity is withdrawn from positions
+// rather than by exiting LP shares.
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// GetTotalPoolLiquidity returns the coins held by the pool, including swap
+// fees that positions have not collected yet.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+// GetTotalShares returns zero, as concentrated liquidity pools do not issue
+// LP shares. Liquidity is instead tracked per position.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// PokePool is a no-op, concentrated liquidity pools have no time-dependent
+// parameters.
+func (p *Pool) PokePool(blockTime time.Time) {}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote
+// asset, derived from the current square root price of token0 in token1.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	var ratio sdk.Dec
+	switch {
+	case baseAssetDenom == p.Token1 && quoteAssetDenom == p.Token0:
+		ratio = price
+	case baseAssetDenom == p.Token0 && quoteAssetDenom == p.Token1:
+		if price.IsZero() {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "spot price of pool %d is zero", p.Id)
+		}
+		ratio = sdk.OneDec().Quo(price)
+	default:
+		return sdk.Dec{}, fmt.Errorf("pool %d does not trade %s against %s", p.Id, baseAssetDenom, quoteAssetDenom)
+	}
+	return osmomath.SigFigRound(ratio, types.SigFigs), nil
+}
+
+// JoinPool is not supported, liquidity is provided through MsgCreatePosition.
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	return sdk.Int{}, sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are joined by creating a position")
+}
+
+// CalcJoinPoolShares is not supported, liquidity is provided through MsgCreatePosition.
+func (p Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return sdk.Int{}, sdk.Coins{}, sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are joined by creating a position")
+}
+
+// ExitPool is not supported, liquidity is removed through MsgWithdrawPosition.
+func (p *Pool) ExitPool(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return sdk.Coins{}, sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are exited by withdrawing a position")
+}
+
+// CalcExitPoolShares is not supported, liquidity is removed through MsgWithdrawPosition.
+func (p Pool) CalcExitPoolShares(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return sdk.Coins{}, sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are exited by withdrawing a position")
+}
+
+// validateTicks checks that [lowerTick, upperTick) is a non-empty range of
+// ticks aligned to the pool's tick spacing.
+func (p Pool) validateTicks(lowerTick, upperTick int64) error {
+	if err := validateTickRange(lowerTick, upperTick); err != nil {
+		return err
+	}
+	spacing := int64(p.TickSpacing)
+	if lowerTick%spacing != 0 || upperTick%spacing != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "ticks [%d, %d] are not multiples of the tick spacing %d", lowerTick, upperTick, spacing)
+	}
+	return nil
+}
+
+// getOrInitTick returns the tick at index, or a newly initialized one if
+// there is none. By convention, all fee growth is assumed to have happened
+// below a newly initialized tick.
+func (p Pool) getOrInitTick(ticks TickStore, index int64) types.Tick {
+	if tick, found := ticks.GetTick(index); found {
+		return tick
+	}
+
+	tick := types.Tick{
+		PoolId:            p.Id,
+		Index:             index,
+		LiquidityGross:    sdk.ZeroDec(),
+		LiquidityNet:      sdk.ZeroDec(),
+		FeeGrowthOutside0: sdk.ZeroDec(),
+		FeeGrowthOutside1: sdk.ZeroDec(),
+	}
+	if index <= p.CurrentTick {
+		tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0
+		tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1
+	}
+	return tick
+}
+
+// getFeeGrowthInside returns the fee growth per unit of liquidity that
+// happened within [lowerTick, upperTick). Both ticks must be initialized.
+func (p Pool) getFeeGrowthInside(ticks TickStore, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec) {
+	lower, _ := ticks.GetTick(lowerTick)
+	upper, _ := ticks.GetTick(upperTick)
+
+	below0, below1 := lower.FeeGrowthOutside0, lower.FeeGrowthOutside1
+	if p.CurrentTick < lowerTick {
+		below0 = p.FeeGrowthGlobal0.Sub(below0)
+		below1 = p.FeeGrowthGlobal1.Sub(below1)
+	}
+
+	above0, above1 := upper.FeeGrowthOutside0, upper.FeeGrowthOutside1
+	if p.CurrentTick >= upperTick {
+		above0 = p.FeeGrowthGlobal0.Sub(above0)
+		above1 = p.FeeGrowthGlobal1.Sub(above1)
+	}
+
+	return p.FeeGrowthGlobal0.Sub(below0).Sub(above0), p.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+}
+
+// updateTick adds liquidityDelta to the tick's gross liquidity, and to (or
+// from, for an upper tick) its net liquidity.
+func (p Pool) updateTick(ticks TickStore, index int64, liquidityDelta sdk.Dec, upper bool) error {
+	tick := p.getOrInitTick(ticks, index)
+
+	tick.LiquidityGross = tick.LiquidityGross.Add(liquidityDelta)
+	if tick.LiquidityGross.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "tick %d has negative liquidity", index)
+	}
+	if upper {
+		tick.LiquidityNet = tick.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tick.LiquidityNet = tick.LiquidityNet.Add(liquidityDelta)
+	}
+	ticks.SetTick(tick)
+	return nil
+}
+
+// clearTickIfUnused removes the tick once no position references it anymore.
+func (p Pool) clearTickIfUnused(ticks TickStore, index int64) {
+	if tick, found := ticks.GetTick(index); found && tick.LiquidityGross.IsZero() {
+		ticks.DeleteTick(index)
+	}
+}
+
+// GetLiquidityForAmounts returns the largest liquidity that can be provided
+// to [lowerTick, upperTick) with at most amount0 of token0 and amount1 of
+// token1, at the current price.
+func (p Pool) GetLiquidityForAmounts(lowerTick, upperTick int64, amount0, amount1 sdk.Int) (sdk.Dec, error) {
+	if err := p.validateTicks(lowerTick, upperTick); err != nil {
+		return sdk.Dec{}, err
+	}
+	sqrtPriceLower, sqrtPriceUpper := mustTickToSqrtPrice(lowerTick), mustTickToSqrtPrice(upperTick)
+
+	switch {
+	case p.CurrentSqrtPrice.LTE(sqrtPriceLower):
+		return getLiquidityForAmount0(sqrtPriceLower, sqrtPriceUpper, amount0), nil
+	case p.CurrentSqrtPrice.GTE(sqrtPriceUpper):
+		return getLiquidityForAmount1(sqrtPriceLower, sqrtPriceUpper, amount1), nil
+	default:
+		liquidity0 := getLiquidityForAmount0(p.CurrentSqrtPrice, sqrtPriceUpper, amount0)
+		liquidity1 := getLiquidityForAmount1(sqrtPriceLower, p.CurrentSqrtPrice, amount1)
+		return sdk.MinDec(liquidity0, liquidity1), nil
+	}
+}
+
+// UpdatePosition adds liquidityDelta, which may be negative, to the position
+// and the ticks it spans, in ticks, and pays out the swap fees the position accrued
+// since its last update.
+// It returns the tokens that must be deposited into the pool when liquidity
+// is added, or that are withdrawn from it when liquidity is removed, rounded
+// in the pool's favor, along with the fees collected.
+func (p *Pool) UpdatePosition(ticks TickStore, position *types.Position, liquidityDelta sdk.Dec) (tokens sdk.Coins, fees sdk.Coins, err error) {
+	if position.PoolId != p.Id {
+		return sdk.Coins{}, sdk.Coins{}, fmt.Errorf("position of pool %d updated against pool %d", position.PoolId, p.Id)
+	}
+	if err := p.validateTicks(position.LowerTick, position.UpperTick); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	newPositionLiquidity := position.Liquidity.Add(liquidityDelta)
+	if newPositionLiquidity.IsNegative() {
+		return sdk.Coins{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity,
+			"position has %s liquidity, attempted to remove %s", position.Liquidity, liquidityDelta.Neg())
+	}
+
+	if err := p.updateTick(ticks, position.LowerTick, liquidityDelta, false); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	if err := p.updateTick(ticks, position.UpperTick, liquidityDelta, true); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	// collect the fees accrued since the last update, before the ticks can be cleared
+	feeGrowthInside0, feeGrowthInside1 := p.getFeeGrowthInside(ticks, position.LowerTick, position.UpperTick)
+	fees = sdk.NewCoins(
+		sdk.NewCoin(p.Token0, position.Liquidity.MulTruncate(feeGrowthInside0.Sub(position.FeeGrowthInside0Last)).TruncateInt()),
+		sdk.NewCoin(p.Token1, position.Liquidity.MulTruncate(feeGrowthInside1.Sub(position.FeeGrowthInside1Last)).TruncateInt()),
+	)
+	position.Liquidity = newPositionLiquidity
+	position.FeeGrowthInside0Last = feeGrowthInside0
+	position.FeeGrowthInside1Last = feeGrowthInside1
+
+	p.clearTickIfUnused(ticks, position.LowerTick)
+	p.clearTickIfUnused(ticks, position.UpperTick)
+
+	// compute the token amounts backing liquidityDelta
+	sqrtPriceLower, sqrtPriceUpper := mustTickToSqrtPrice(position.LowerTick), mustTickToSqrtPrice(position.UpperTick)
+	roundUp := liquidityDelta.IsPositive()
+	absLiquidityDelta := liquidityDelta.Abs()
+	amount0, amount1 := sdk.ZeroDec(), sdk.ZeroDec()
+	switch {
+	case p.CurrentTick < position.LowerTick:
+		amount0 = getAmount0Delta(sqrtPriceLower, sqrtPriceUpper, absLiquidityDelta, roundUp)
+	case p.CurrentTick < position.UpperTick:
+		amount0 = getAmount0Delta(p.CurrentSqrtPrice, sqrtPriceUpper, absLiquidityDelta, roundUp)
+		amount1 = getAmount1Delta(sqrtPriceLower, p.CurrentSqrtPrice, absLiquidityDelta, roundUp)
+		p.Liquidity = p.Liquidity.Add(liquidityDelta)
+	default:
+		amount1 = getAmount1Delta(sqrtPriceLower, sqrtPriceUpper, absLiquidityDelta, roundUp)
+	}
+
+	if roundUp {
+		tokens = sdk.NewCoins(sdk.NewCoin(p.Token0, amount0.Ceil().TruncateInt()), sdk.NewCoin(p.Token1, amount1.Ceil().TruncateInt()))
+		p.PoolLiquidity = p.PoolLiquidity.Add(tokens...)
+	} else {
+		tokens = sdk.NewCoins(sdk.NewCoin(p.Token0, amount0.TruncateInt()), sdk.NewCoin(p.Token1, amount1.TruncateInt()))
+		if err := p.removeLiquidity(tokens); err != nil {
+			return sdk.Coins{}, sdk.Coins{}, err
+		}
+	}
+	if err := p.removeLiquidity(fees); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	return tokens, fees, nil
+}
+
+// removeLiquidity removes coins leaving the pool from its liquidity.
+func (p *Pool) removeLiquidity(coins sdk.Coins) error {
+	newLiquidity, hasNeg := p.PoolLiquidity.SafeSub(coins)
+	if hasNeg {
+		return sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "pool %d holds %s, cannot remove %s", p.Id, p.PoolLiquidity, coins)
+	}
+	p.PoolLiquidity = newLiquidity
+	return nil
+}
@@ -0,0 +1,81 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// TickStore holds the initialized ticks of a single pool. Ticks are kept out
+// of the pool itself, so that the pool does not grow with its positions, and
+// swaps only read and write the ticks they cross.
+type TickStore interface {
+	// GetTick returns the tick at index, and whether it is initialized.
+	GetTick(index int64) (types.Tick, bool)
+	// SetTick stores an initialized tick.
+	SetTick(tick types.Tick)
+	// DeleteTick removes the tick at index, once no position references it.
+	DeleteTick(index int64)
+	// NextInitializedTick returns the closest initialized tick the price
+	// moves towards from index, and whether there is one. When moving down,
+	// a tick at index is included.
+	NextInitializedTick(index int64, zeroForOne bool) (types.Tick, bool)
+}
+
+var _ TickStore = kvTickStore{}
+
+// kvTickStore is a TickStore backed by a KVStore holding only the ticks of
+// the pool, keyed by their index.
+type kvTickStore struct {
+	store sdk.KVStore
+}
+
+// NewKVTickStore returns a TickStore of the ticks of a pool in store, which
+// must be prefixed by the pool, e.g. by types.GetKeyPrefixPoolTicks.
+func NewKVTickStore(store sdk.KVStore) TickStore {
+	return kvTickStore{store: store}
+}
+
+func (s kvTickStore) GetTick(index int64) (types.Tick, bool) {
+	bz := s.store.Get(types.TickIndexToBytes(index))
+	if bz == nil {
+		return types.Tick{}, false
+	}
+	return mustUnmarshalTick(bz), true
+}
+
+func (s kvTickStore) SetTick(tick types.Tick) {
+	bz, err := tick.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	s.store.Set(types.TickIndexToBytes(tick.Index), bz)
+}
+
+func (s kvTickStore) DeleteTick(index int64) {
+	s.store.Delete(types.TickIndexToBytes(index))
+}
+
+func (s kvTickStore) NextInitializedTick(index int64, zeroForOne bool) (types.Tick, bool) {
+	// ticks are at most MaxTick, so index+1 can't overflow
+	var iter sdk.Iterator
+	if zeroForOne {
+		iter = s.store.ReverseIterator(nil, types.TickIndexToBytes(index+1))
+	} else {
+		iter = s.store.Iterator(types.TickIndexToBytes(index+1), nil)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.Tick{}, false
+	}
+	return mustUnmarshalTick(iter.Value()), true
+}
+
+func mustUnmarshalTick(bz []byte) types.Tick {
+	tick := types.Tick{}
+	if err := tick.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return tick
+}
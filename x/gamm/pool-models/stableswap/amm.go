@@ -2,321 +2,121 @@ package stableswap
 
 import (
 	"errors"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/internal/cfmm_common"
 	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 var (
-	cubeRootTwo, _   = sdk.NewDec(2).ApproxRoot(3)
-	threeCubeRootTwo = cubeRootTwo.MulInt64(3)
+	// maxScaledReserve bounds the scaled reserves the solver accepts, so that
+	// none of its intermediate values overflow a BigDec.
+	maxScaledReserve = osmomath.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), 120))
+	// maxReserveRatio bounds how many times smaller the y reserve can get in
+	// a swap, for the same reason.
+	maxReserveRatio = osmomath.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100))
 )
 
-// solidly CFMM is xy(x^2 + y^2) = k
-func cfmmConstant(xReserve, yReserve sdk.Dec) sdk.Dec {
-	xy := xReserve.Mul(yReserve)
-	x2 := xReserve.Mul(xReserve)
-	y2 := yReserve.Mul(yReserve)
-	return xy.Mul(x2.Add(y2))
-}
-
-// multi-asset CFMM is xyu(x^2 + y^2 + v) = k,
-// where u is the product of the reserves of assets
-// outside of x and y (e.g. u = wz), and v is the sum
-// of their squares (e.g. v = w^2 + z^2).
-// When u = 1 and v = 0, this is equivalent to solidly's CFMM
-func cfmmConstantMulti(xReserve, yReserve, uReserve, vSumSquares sdk.Dec) sdk.Dec {
-	xyu := xReserve.Mul(yReserve.Mul(uReserve))
-	x2 := xReserve.Mul(xReserve)
-	y2 := yReserve.Mul(yReserve)
-	return xyu.Mul(x2.Add(y2).Add(vSumSquares))
-}
-
-// solidly CFMM is xy(x^2 + y^2) = k
-// So we want to solve for a given addition of `b` units of y into the pool,
-// how many units `a` of x do we get out.
-// So we solve the following expression for `a`
-// xy(x^2 + y^2) = (x - a)(y + b)((x - a)^2 + (y + b)^2)
-func solveCfmm(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
-	if !yReserve.Add(yIn).IsPositive() {
-		panic("invalid yReserve, yIn combo")
-	}
-
-	// use the following wolfram alpha link to solve the equation
-	// https://www.wolframalpha.com/input?i=solve+for+a%2C+xy%28x%5E2+%2B+y%5E2%29+%3D+%28x+-+a%29%28y+%2B+b%29%28%28x+-+a%29%5E2+%2B+%28y+%2Bb%29%5E2%29+
-	// This returns (copied from wolfram):
-	// assuming (correctly) that b + y!=0
-	// a = (-27 b^2 x^3 y - 27 b^2 x y^3 + sqrt((-27 b^2 x^3 y - 27 b^2 x y^3 - 54 b x^3 y^2 - 54 b x y^4 - 27 x^3 y^3 - 27 x y^5)^2 + 4 (3 b^4 + 12 b^3 y + 18 b^2 y^2 + 12 b y^3 + 3 y^4)^3) - 54 b x^3 y^2 - 54 b x y^4 - 27 x^3 y^3 - 27 x y^5)^(1/3)/(3 2^(1/3) (b + y)) - (2^(1/3) (3 b^4 + 12 b^3 y + 18 b^2 y^2 + 12 b y^3 + 3 y^4))/(3 (b + y) (-27 b^2 x^3 y - 27 b^2 x y^3 + sqrt((-27 b^2 x^3 y - 27 b^2 x y^3 - 54 b x^3 y^2 - 54 b x y^4 - 27 x^3 y^3 - 27 x y^5)^2 + 4 (3 b^4 + 12 b^3 y + 18 b^2 y^2 + 12 b y^3 + 3 y^4)^3) - 54 b x^3 y^2 - 54 b x y^4 - 27 x^3 y^3 - 27 x y^5)^(1/3)) + (b x + x y)/(b + y) and b + y!=0
-	// We simplify and separate out terms to get that its the following:
-	// The key substitutions are that 3(b+y)^4 = 3 b^4 + 12 b^3 y + 18 b^2 y^2 + 12 b y^3 + 3 y^4
-	// and -27 x y (b + y)^2 (x^2 + y^2) = -27 b^2 x^3 y - 27 b^2 x y^3 - 54 b x^3 y^2 - 54 b x y^4 - 27 x^3 y^3 - 27 x y^5
-	// I added {} myself, making better distinctions between entirely distinct terms.
-	// a = {(-27 x y (b + y)^2 (x^2 + y^2)
-	//			+ sqrt(
-	//				(-27 x y (b + y)^2 (x^2 + y^2))^2
-	//				+ 108 ((b+y)^4)^3
-	// 			)^(1/3)
-	// 		  / (3 2^(1/3) (b + y))}
-	//		- {(2^(1/3) (3 (b + y)^4))
-	//		  /(3 (b + y)
-	// 			(-27 x y (b + y)^2 (x^2 + y^2)
-	// 				+ sqrt(
-	//					(-27 x y (b + y)^2 (x^2 + y^2))^2
-	//					+ 108 ((b+y)^4)^3)
-	// 			)^(1/3))}
-	//      + {(b x + x y)/(b + y)}
-	// we further simplify, and call:
-	// foo = (-27 x y (b + y)^2 (x^2 + y^2)
-	// 			+ sqrt(
-	//				(-27 x y (b + y)^2 (x^2 + y^2))^2
-	//				+ 108 ((b+y)^4)^3)
-	//		 )^(1/3)
-	// Thus, a is then:
-	// a = {foo / (3 2^(1/3) (b + y))}
-	//		- {(3 * 2^(1/3) (b+y)^4)
-	//		  /(3 (b + y) foo)}
-	//      + {(b x + x y)/(b + y)}
-	// Let:
-	// term1 = {foo / (3 2^(1/3) (b + y))}
-	// term2 = {(3 * 2^(1/3) (b+y)^4) /(3 (b + y) foo)} =  2^(1/3) (b+y)^3 / foo
-	// term3 = {(b x + x y)/(b + y)}
-
-	// prelude, compute all the xy cross terms. Consider keeping these precomputed in the struct,
-	// and maybe in state.
-	x := xReserve
-	y := yReserve
-	x2py2 := x.Mul(x).AddMut(y.Mul(y))
-
-	xy := x.Mul(y)
-
-	b := yIn
-
-	bpy := b.Add(y)
-	bpy2 := bpy.Mul(bpy)
-	bpy3 := bpy2.Mul(bpy)
-	bpy4 := bpy2.Mul(bpy2)
-
-	// TODO: Come back and optimize alot of the calculations
-
-	// Now we compute foo
-	// foo = (-27 x y (b + y)^2 (x^2 + y^2)
-	// 			+ sqrt(
-	//				(-27 x y (b + y)^2 (x^2 + y^2))^2
-	//				+ 108 ((b+y)^4)^3)
-	//		 )^(1/3)
-	// This has a y^12 term in it, which is unappealing, so we spend some energy reducing this max bitlen.
-	// foo = (-27 x y (b + y)^2 (x^2 + y^2)
-	// 			+ (b + y)^2 sqrt(
-	//				729 (x y (x^2 + y^2))^2
-	//				+ 108 (b+y)^8)
-	//		 )^(1/3)
-	// let e = x y (x^2 + y^2))
-	// foo = (-27 (b + y)^2 e
-	// 			+ (b + y)^2 sqrt(
-	//				729 e^2 + 108 (b+y)^8)
-	//		 )^(1/3)
-
-	e := xy.Mul(x2py2) // xy(x^2 + y^2)
-
-	// t1 = -27 (b + y)^2 e
-	t1 := e.Mul(bpy2).MulInt64Mut(-27)
-
-	// compute d = (b + y)^2 sqrt(729 e^2 + 108 (b+y)^8)
-	bpy8 := bpy4.Mul(bpy4)
-	sqrt_inner := e.MulMut(e).MulInt64Mut(729).AddMut(bpy8.MulInt64Mut(108)) // 729 e^2 + 108 (b+y)^8
-	sqrt, err := sqrt_inner.ApproxSqrt()
-	if err != nil {
-		panic(err)
-	}
-	d := sqrt.MulMut(bpy2)
-
-	// foo = (t1 + d)^(1/3)
-	foo3 := t1.AddMut(d)
-	foo, _ := foo3.ApproxRoot(3)
-
-	// a = {foo / (3 2^(1/3) (b + y))}
-	//		- {(2^(1/3) banana) / (3 (b + y) foo}
-	//      + {(b x + x y)/(b + y)}
-
-	// term1 := {foo / (3 2^(1/3) (b + y))}
-	term1Denominator := threeCubeRootTwo.Mul(bpy)
-	term1 := foo.Quo(term1Denominator)
-	// term2 := {(2^(1/3) (b+y)^3) / (foo}
-	term2 := cubeRootTwo.Mul(bpy3)
-	term2 = term2.Quo(foo)
-	// term3 := {(b x + x y)/(b + y)}
-	term3Numerator := b.Mul(x).Add(xy)
-	term3 := term3Numerator.Quo(bpy)
-
-	a := term1.Sub(term2).Add(term3)
-	return a
-}
-
-// Our multi-asset CFMM is xyz(x^2 + y^2 + w) = k
-// So we want to solve for a given addition of `b` units of y into the pool,
-// how many units `a` of x do we get out.
-// So we solve the following expression for `a`
-// xyz(x^2 + y^2 + w) = (x - a)(y + b)z((x - a)^2 + (y + b)^2 + w)
-func solveCfmmMulti(xReserve, yReserve, wSumSquares, yIn sdk.Dec) sdk.Dec {
-	if !yReserve.Add(yIn).IsPositive() {
-		panic("invalid yReserve, yIn combo")
-	}
-
-	// Use the following wolfram alpha link to solve the equation
-	// https://www.wolframalpha.com/input?i=solve+for+a%2C+xyz%28x%5E2+%2B+y%5E2+%2B+w%29+%3D+%28x+-+a%29%28y+%2B+b%29z%28%28x+-+a%29%5E2+%2B+%28y+%2Bb%29%5E2+%2B+w%29
-	// This returns (copied from wolfram):
-	// assuming (correctly) that b + y!=0
-	// a = (-27 b^2 w x y - 27 b^2 x^3 y - 27 b^2 x y^3 + sqrt((-27 b^2 w x y - 27 b^2 x^3 y - 27 b^2 x y^3 - 54 b w x y^2 - 54 b x^3 y^2 - 54 b x y^4 - 27 w x y^3 - 27 x^3 y^3 - 27 x y^5)^2 + 4 (3 b^4 + 12 b^3 y + 3 b^2 w + 18 b^2 y^2 + 6 b w y + 12 b y^3 + 3 w y^2 + 3 y^4)^3) - 54 b w x y^2 - 54 b x^3 y^2 - 54 b x y^4 - 27 w x y^3 - 27 x^3 y^3 - 27 x y^5)^(1/3)/(3 2^(1/3) (b + y)) - (2^(1/3) (3 b^4 + 12 b^3 y + 3 b^2 w + 18 b^2 y^2 + 6 b w y + 12 b y^3 + 3 w y^2 + 3 y^4))/(3 (b + y) (-27 b^2 w x y - 27 b^2 x^3 y - 27 b^2 x y^3 + sqrt((-27 b^2 w x y - 27 b^2 x^3 y - 27 b^2 x y^3 - 54 b w x y^2 - 54 b x^3 y^2 - 54 b x y^4 - 27 w x y^3 - 27 x^3 y^3 - 27 x y^5)^2 + 4 (3 b^4 + 12 b^3 y + 3 b^2 w + 18 b^2 y^2 + 6 b w y + 12 b y^3 + 3 w y^2 + 3 y^4)^3) - 54 b w x y^2 - 54 b x^3 y^2 - 54 b x y^4 - 27 w x y^3 - 27 x^3 y^3 - 27 x y^5)^(1/3)) + (b x + x y)/(b + y) and b + y!=0
-	//
-	// The key substitutions are (where w represents the sum of the squares as represented in the multi-asset CFMM function):
-	// 1. S1: 3 (b + y)^2 (b^2 + 2 b y + y^2 + w) = 3 b^4 + 12 b^3 y + 3b^2w + 18 b^2 y^2 + 6bwy + 12 b y^3 + 3wy^2 + 3 y^4
-	// 2. S2: -27 x y (b + y)^2 (x^2 + y^2 + w) = -27b^2xyw - 27b^2x^3y - 27b^2xy^3 - 54bxy^2w - 54bx^3y^2 - 54bxy^4 - 27xy^3w - 27x^3y^3 - 27xy^5
-	//
-	// This is the simplified version using the substitutions above, to be expanded afterwards below:
-	// a = (1 / [3 * 2^(1/3) * (b + y)])
-	// 			* [S2 + sqrt(S2^2 + 4*(S1^3))]^(1/3)
-	//			- {2^(1/3) * S1 /
-	// 				[3 * (b + y) * (S2 + sqrt(S2^2 + 4*(S1^3)))^(1/3)]}
-	// 			+ [(bx + xy) / (b + y)]
-	//
-	// To further simplify, let:
-	// foo = (S2 + sqrt(S2^2 + 4*(S1^3)))^(1/3)
-	// bpy = (b + y)
-	//
-	// Thus, a further simplifies to:
-	// a = (foo / (3 * 2^(1/3) * bpy))
-	//			- (2^(1/3) * S1 / (3 * bpy * foo))
-	// 			+ ((bx + xy) / bpy)
-	//
-	// Finally, let:
-	// term1 = (foo / (3 * 2^(1/3) * bpy))
-	// term2 = (2^(1/3) * S1 / (3 * bpy * foo))
-	// term3 = ((bx + xy) / bpy)
-	//
-	// The final result should be:
-	// a = term1 - term2 + term3
+const (
+	// maxUpperboundIterations bounds how many times the initial guess of the
+	// solver is doubled until it is above the solution.
+	maxUpperboundIterations = 256
+	// maxNewtonIterations bounds the Newton iterations of the solver.
+	maxNewtonIterations = 256
+)
 
-	// Prelude, compute all the xy cross terms. Consider keeping these precomputed in the struct,
-	// and maybe in state.
+// The multi-asset CFMM is xyu(x^2 + y^2 + w) = k, where u is the product of
+// the reserves of assets outside of x and y (e.g. u = vz), and w is the sum of
+// their squares (e.g. w = v^2 + z^2). With two assets, u = 1 and w = 0, and
+// this is solidly's CFMM xy(x^2 + y^2) = k.
+//
+// solveCFMM returns how many units of x are taken out of the pool when yIn
+// units of y are added to it, keeping the CFMM constant:
+// xyu(x^2 + y^2 + w) = x'y'u(x'^2 + y'^2 + w), with y' = y + yIn.
+// remReserves are the reserves of all other assets, and a negative yIn
+// returns the negative amount of x that must be added to take -yIn units
+// of y out.
+//
+// u cancels out, so the new x reserve x' solves g(x') = T, where
+// g(x') = x'^3 + c x', c = y'^2 + w and T = xy(x^2 + y^2 + w) / y'.
+// g is increasing and convex for x' > 0, so Newton's method started above
+// the solution converges to it from above. Every step rounds so that x' stays
+// above the solution, hence the result always rounds in favor of the pool:
+// less x out for a positive yIn, more x in for a negative one.
+func solveCFMM(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) (osmomath.BigDec, error) {
+	reserves := append([]osmomath.BigDec{xReserve, yReserve}, remReserves...)
+	for _, reserve := range reserves {
+		if !reserve.IsPositive() {
+			return osmomath.BigDec{}, sdkerrors.Wrapf(types.ErrInvalidPool, "reserve %s is not positive", reserve)
+		}
+		if reserve.GT(maxScaledReserve) {
+			return osmomath.BigDec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "reserve %s is too large", reserve)
+		}
+	}
+	yFinal := yReserve.Add(yIn)
+	if !yFinal.IsPositive() {
+		return osmomath.BigDec{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "cannot take %s out of a reserve of %s", yIn.Neg(), yReserve)
+	}
+	if yFinal.GT(maxScaledReserve) {
+		return osmomath.BigDec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "reserve %s is too large", yFinal)
+	}
+	if yReserve.QuoRoundUp(yFinal).GT(maxReserveRatio) {
+		return osmomath.BigDec{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "cannot take %s out of a reserve of %s", yIn.Neg(), yReserve)
+	}
 
+	// c is rounded down and T up, so that x' is only overestimated
+	wSumSquaresDown, wSumSquaresUp := osmomath.ZeroDec(), osmomath.ZeroDec()
+	for _, reserve := range remReserves {
+		wSumSquaresDown = wSumSquaresDown.Add(reserve.MulTruncate(reserve))
+		wSumSquaresUp = wSumSquaresUp.Add(reserve.MulRoundUp(reserve))
+	}
 	x := xReserve
-	x2 := x.Mul(x)
-	y := yReserve
-	y2 := y.Mul(y)
-	w := wSumSquares
-	b := yIn
-
-	xy := x.Mul(y)
-
-	bpy := b.Add(y)
-	bpy2 := bpy.Mul(bpy)
-	// bpy3 := bpy2.Mul(bpy)
-	// bpy4 := bpy2.Mul(bpy2)
-
-	// S1 = 3 (b + y)^2 (b^2 + 2 b y + y^2 + w)
-	s1_inner := bpy2.Add(w) // (b^2 + 2 b y + y^2 + w) = (b+y)^2 + w
-	s1 := bpy2.MulInt64(3).Mul(s1_inner)
-	// S2 = -27 x y (b + y)^2 (x^2 + y^2 + w)
-	s2_inner := x2.Add(y2).Add(w) // (x^2 + y^2 + w)
-	s2 := bpy2.MulInt64(-27).Mul(xy).Mul(s2_inner)
-
-	// Calculating foo directly causes an integer overflow due to having a y^12 term, so we expand and factor to reduce its max bitlen:
-	// Original foo = (S2 + sqrt(S2^2 + 4*(S1^3)))^(1/3)
-	// Expand:
-	// foo = (S2 + sqrt((-27 x y (b + y)^2 (x^2 + y^2 + w))^2 +
-	// 					4*(3 (b + y)^2 (b^2 + 2 b y + y^2 + w))^3)
-	// 		 )^(1/3)
-	// Factor (b+y)^2 out from within the square root:
-	// foo = (S2 + (b + y)^2
-	// 			* sqrt((-27 x y (x^2 + y^2 + w))^2 +
-	// 					4 * ((b + y)^2) * (3 (b^2 + 2 b y + y^2 + w))^3)
-	// 		 )^(1/3)
-	sqrt_inner_term1 := xy.MulInt64(-27).Mul(s2_inner)                // (-27 x y (x^2 + y^2 + w))
-	sqrt_inner_term1_2 := sqrt_inner_term1.Mul(sqrt_inner_term1)      // sqrt_inner_term1^2
-	s1_inner_3 := (s1_inner).Mul(s1_inner).Mul(s1_inner).MulInt64(27) // (3 (b^2 + 2 b y + y^2 + w))^3 = 27 s1_inner^3
-	sqrt_inner_term2 := s1_inner_3.MulInt64(4).Mul(bpy2)              // 4 * ((b + y)^2) * s1_inner_3
-	sqrt_inner := sqrt_inner_term1_2.Add(sqrt_inner_term2)            // sqrt_inner_term1 + sqrt_inner_term2
-	sqrt, err := sqrt_inner.ApproxSqrt()
-	if err != nil {
-		panic(err)
+	c := yFinal.MulTruncate(yFinal).Add(wSumSquaresDown)
+	sumSquares := x.MulRoundUp(x).Add(yReserve.MulRoundUp(yReserve)).Add(wSumSquaresUp)
+	target := x.MulRoundUp(sumSquares).MulRoundUp(yReserve).QuoRoundUp(yFinal)
+	// g is rounded down as well
+	g := func(xFinal osmomath.BigDec) osmomath.BigDec {
+		return xFinal.MulTruncate(xFinal).MulTruncate(xFinal).Add(c.MulTruncate(xFinal))
 	}
-	foo3 := s2.Add(bpy2.Mul(sqrt))
-	foo, _ := foo3.ApproxRoot(3)
 
-	// term1 = (foo / (3 * 2^(1/3) * bar))
-	term1Denominator := threeCubeRootTwo.Mul(bpy) // 3 * 2^(1/3) * (b + y)
-	term1 := foo.Quo(term1Denominator)
-	// term2 = (2^(1/3) * S1 / (3 * bar * foo))
-	term2 := (cubeRootTwo.Mul(s1)).Quo(foo.Mul(bpy).MulInt64(3))
-	// term3 = ((bx + xy) / bpy)
-	term3Numerator := b.Mul(x).Add(xy)
-	term3 := term3Numerator.Quo(bpy)
-
-	a := term1.Sub(term2).Add(term3)
-
-	return a
-}
-
-func approxDecEqual(a, b, tol sdk.Dec) bool {
-	diff := a.Sub(b).Abs()
-	return diff.Quo(a).LTE(tol) && diff.Quo(b).LTE(tol)
-}
-
-var (
-	twodec    = sdk.MustNewDecFromStr("2.0")
-	threshold = sdk.NewDecWithPrec(1, 10) // Correct within a factor of 1 * 10^{-10}
-)
-
-// solveCFMMBinarySearch searches the correct dx using binary search over constant K.
-// added for future extension
-func solveCFMMBinarySearch(constantFunction func(sdk.Dec, sdk.Dec) sdk.Dec) func(sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec {
-	return func(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
-		k := constantFunction(xReserve, yReserve)
-		yf := yReserve.Add(yIn)
-		x_low_est := sdk.ZeroDec()
-		x_high_est := xReserve
-		x_est := (x_high_est.Add(x_low_est)).Quo(twodec)
-		cur_k := constantFunction(x_est, yf)
-		for !approxDecEqual(cur_k, k, threshold) { // cap max iteration to 256
-			if cur_k.GT(k) {
-				x_high_est = x_est
-			} else if cur_k.LT(k) {
-				x_low_est = x_est
-			}
-			x_est = (x_high_est.Add(x_low_est)).Quo(twodec)
-			cur_k = constantFunction(x_est, yf)
+	xFinal := x
+	for i := 0; g(xFinal).LT(target); i++ {
+		if i == maxUpperboundIterations {
+			return osmomath.BigDec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "could not bound the stableswap solution")
 		}
-		return xReserve.Sub(x_est)
+		xFinal = xFinal.MulInt64(2)
 	}
-}
 
-// solveCFMMBinarySearch searches the correct dx using binary search over constant K.
-// added for future extension
-func solveCFMMBinarySearchMulti(constantFunction func(sdk.Dec, sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec) func(sdk.Dec, sdk.Dec, sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec {
-	return func(xReserve, yReserve, uReserve, wSumSquares, yIn sdk.Dec) sdk.Dec {
-		k := constantFunction(xReserve, yReserve, uReserve, wSumSquares)
-		yf := yReserve.Add(yIn)
-		x_low_est := sdk.ZeroDec()
-		x_high_est := xReserve
-		x_est := (x_high_est.Add(x_low_est)).Quo(twodec)
-		cur_k := constantFunction(x_est, yf, uReserve, wSumSquares)
-		for !approxDecEqual(cur_k, k, threshold) { // cap max iteration to 256
-			if cur_k.GT(k) {
-				x_high_est = x_est
-			} else if cur_k.LT(k) {
-				x_low_est = x_est
-			}
-			x_est = (x_high_est.Add(x_low_est)).Quo(twodec)
-			cur_k = constantFunction(x_est, yf, uReserve, wSumSquares)
+	for i := 0; ; i++ {
+		if i == maxNewtonIterations {
+			return osmomath.BigDec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "stableswap solver did not converge")
+		}
+		// g'(x') = 3x'^2 + c, the step is truncated so that it doesn't overshoot
+		derivative := xFinal.MulRoundUp(xFinal).MulInt64(3).Add(c)
+		step := g(xFinal).Sub(target).QuoTruncate(derivative)
+		if !step.IsPositive() {
+			break
 		}
-		return xReserve.Sub(x_est)
+		next := xFinal.Sub(step)
+		if !next.IsPositive() || g(next).LT(target) {
+			break
+		}
+		xFinal = next
 	}
+
+	// rounding can keep x' above the x reserve for a tiny yIn, in which case
+	// no x is taken out
+	if yIn.IsPositive() && xFinal.GT(xReserve) {
+		return osmomath.ZeroDec(), nil
+	}
+	return xReserve.Sub(xFinal), nil
 }
 
-//nolint:unused
-func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
+func spotPrice(baseReserve, quoteReserve osmomath.BigDec, remReserves []osmomath.BigDec) (osmomath.BigDec, error) {
 	// y = baseAsset, x = quoteAsset
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
@@ -325,42 +125,60 @@ func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
 	// The spot price equation of y in terms of x is X_SUPPLY/Y_SUPPLY.
 	// You can work out that it follows from the above relation!
 	//
-	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2).
+	// Now we have to work this out for the much more complex CFMM xyu(x^2 + y^2 + w).
 	// Or we can sidestep this, by just picking a small value a, and computing f_{y -> x}(a) / a,
 	// and accept the precision error.
 
 	// We arbitrarily choose a = 1, and anticipate that this is a small value at the scale of
 	// xReserve & yReserve.
-	a := sdk.OneDec()
+	a := osmomath.OneDec()
 	// no need to divide by a, since a = 1.
-	return solveCfmm(baseReserve, quoteReserve, a)
+	return solveCFMM(baseReserve, quoteReserve, remReserves, a)
 }
 
-// returns outAmt as a decimal
+// returns outAmt as a decimal, rounded down
 func (pa *Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(tokenIn.Denom, tokenOutDenom)
+	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
+	tokenOutSupply, tokenInSupply, remReserves, err := pa.getScaledSwapReserves(tokenOutDenom, tokenIn.Denom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply := reserves[0], reserves[1]
-	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, tokenIn.Amount.ToDec())
-	outAmt := pa.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
-	return outAmt, nil
+	scaledTokenIn, err := pa.getScaledAmt(tokenIn.Denom, osmomath.BigDecFromSDKInt(tokenIn.Amount))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	cfmmOut, err := solveCFMM(tokenOutSupply, tokenInSupply, remReserves, scaledTokenIn)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	outAmt, err := pa.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return outAmt.SDKDec(), nil
 }
 
-// returns inAmt as a decimal
+// returns inAmt as a decimal, rounded up
 func (pa *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(tokenInDenom, tokenOut.Denom)
+	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
+	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
+	tokenInSupply, tokenOutSupply, remReserves, err := pa.getScaledSwapReserves(tokenInDenom, tokenOut.Denom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply := reserves[0], reserves[1]
-	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
-	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, tokenOut.Amount.ToDec().Neg())
-	inAmt := pa.getDescaledPoolAmt(tokenInDenom, cfmmIn.NegMut())
-	return inAmt, nil
+	scaledTokenOut, err := pa.getScaledAmt(tokenOut.Denom, osmomath.BigDecFromSDKInt(tokenOut.Amount))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	cfmmIn, err := solveCFMM(tokenInSupply, tokenOutSupply, remReserves, scaledTokenOut.Neg())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	inAmt, err := pa.getDescaledPoolAmt(tokenInDenom, cfmmIn.Neg())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return inAmt.SDKDecRoundUp(), nil
 }
 
 func (pa *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, swapFee sdk.Dec) (sdk.Int, error) {
@@ -403,3 +221,178 @@ func (pa *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swap
 
 	return numShares, tokensIn, nil
 }
+
+// maxUpperboundDoublings bounds how many times the upperbound of a search
+// for the amount in of a share denominated join is doubled.
+const maxUpperboundDoublings = 64
+
+// searchMinInput returns the smallest input in (lowerbound, upperbound] for
+// which the monotonically increasing f reaches target, given that
+// f(lowerbound) < target <= f(upperbound).
+func searchMinInput(f func(input sdk.Int) (sdk.Int, error), lowerbound, upperbound, target sdk.Int) (sdk.Int, error) {
+	for upperbound.Sub(lowerbound).GT(sdk.OneInt()) {
+		mid := lowerbound.Add(upperbound).QuoRaw(2)
+		output, err := f(mid)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if output.GTE(target) {
+			upperbound = mid
+		} else {
+			lowerbound = mid
+		}
+	}
+	return upperbound, nil
+}
+
+// CalcTokenInShareAmountOut returns the smallest amount of tokenInDenom which
+// joins the pool for at least shareOutAmount shares.
+// This method does not mutate the pool.
+func (pa *Pool) CalcTokenInShareAmountOut(
+	ctx sdk.Context,
+	tokenInDenom string,
+	shareOutAmount sdk.Int,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	if !shareOutAmount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "share out amount %s", shareOutAmount)
+	}
+	poolAmts, err := pa.getPoolAmts(tokenInDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	sharesOutGivenTokenIn := func(tokenInAmount sdk.Int) (sdk.Int, error) {
+		return pa.calcSingleAssetJoinShares(sdk.NewCoin(tokenInDenom, tokenInAmount), swapFee)
+	}
+
+	// Joining with only this share of the tokenInDenom liquidity mints less than
+	// shareOutAmount shares, as joining at the pool ratio would need the other
+	// assets too.
+	lowerbound := shareOutAmount.Mul(poolAmts[0]).Quo(pa.GetTotalShares())
+	upperbound := lowerbound.MulRaw(2).AddRaw(1)
+	for i := 0; ; i++ {
+		sharesOut, err := sharesOutGivenTokenIn(upperbound)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if sharesOut.GTE(shareOutAmount) {
+			break
+		}
+		if i == maxUpperboundDoublings {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "could not find the amount of %s to join for %s shares", tokenInDenom, shareOutAmount)
+		}
+		lowerbound, upperbound = upperbound, upperbound.MulRaw(2)
+	}
+
+	return searchMinInput(sharesOutGivenTokenIn, lowerbound, upperbound, shareOutAmount)
+}
+
+// JoinPoolTokenInMaxShareAmountOut joins the pool for exactly shareOutAmount
+// shares with a single asset, returning the amount of tokenInDenom joined.
+func (pa *Pool) JoinPoolTokenInMaxShareAmountOut(
+	ctx sdk.Context,
+	tokenInDenom string,
+	shareOutAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	tokenInAmount, err = pa.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, pa.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	pa.updatePoolForJoin(sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)), shareOutAmount)
+	return tokenInAmount, nil
+}
+
+// ExitSwapExactAmountOut exits the smallest amount of shares, up to
+// shareInMaxAmount, whose exited coins swapped to tokenOut's denom are at
+// least tokenOut. The pool pays out exactly tokenOut, keeping the remainder.
+func (pa *Pool) ExitSwapExactAmountOut(
+	ctx sdk.Context,
+	tokenOut sdk.Coin,
+	shareInMaxAmount sdk.Int,
+) (shareInAmount sdk.Int, err error) {
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "token out amount %s", tokenOut.Amount)
+	}
+	if _, err := pa.getPoolAmts(tokenOut.Denom); err != nil {
+		return sdk.Int{}, err
+	}
+
+	exitFee := pa.GetExitFee(ctx)
+	swapFee := pa.GetSwapFee(ctx)
+	// Returns how many tokens you'd get, if you exited sharesIn shares and
+	// swapped all exited coins to tokenOut.Denom.
+	tokenOutGivenSharesIn := func(sharesIn sdk.Int) (sdk.Int, error) {
+		paCopy := pa.Copy()
+		exitedCoins, err := paCopy.ExitPool(ctx, sharesIn, exitFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOutAmount := exitedCoins.AmountOf(tokenOut.Denom)
+		for _, coin := range exitedCoins {
+			if coin.Denom == tokenOut.Denom {
+				continue
+			}
+			swapOutAmount, err := paCopy.calcOutAmtGivenIn(coin, tokenOut.Denom, swapFee)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			swapOut := sdk.NewCoin(tokenOut.Denom, swapOutAmount.TruncateInt())
+			paCopy.updatePoolLiquidityForSwap(sdk.NewCoins(coin), sdk.NewCoins(swapOut))
+			tokenOutAmount = tokenOutAmount.Add(swapOut.Amount)
+		}
+		return tokenOutAmount, nil
+	}
+
+	// the pool can't be exited entirely
+	maxSharesIn := pa.GetTotalShares().SubRaw(1)
+	maxTokenOut, err := tokenOutGivenSharesIn(maxSharesIn)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if maxTokenOut.LT(tokenOut.Amount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "can exit at most %s%s", maxTokenOut, tokenOut.Denom)
+	}
+
+	shareInAmount, err = searchMinInput(tokenOutGivenSharesIn, sdk.ZeroInt(), maxSharesIn, tokenOut.Amount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if shareInAmount.GT(shareInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", shareInAmount, shareInMaxAmount)
+	}
+
+	pa.TotalShares.Amount = pa.TotalShares.Amount.Sub(shareInAmount)
+	pa.updatePoolLiquidityForExit(sdk.NewCoins(tokenOut))
+	return shareInAmount, nil
+}
+
+// CFMMValueRatio returns the ratio of the pool's value under the stableswap
+// CFMM, prod(x_i) * sum(x_i^2) on scaled reserves, to its value with
+// reservesBefore and the same scaling factors.
+// The ratio is computed term by term, rather than as a ratio of two CFMM
+// values, so that it doesn't overflow for large reserves.
+func (pa Pool) CFMMValueRatio(reservesBefore sdk.Coins) (sdk.Dec, error) {
+	productRatio := sdk.OneDec()
+	sumSquares, sumSquaresBefore := sdk.ZeroDec(), sdk.ZeroDec()
+	for i, coin := range pa.PoolLiquidity {
+		before := reservesBefore.AmountOf(coin.Denom)
+		if !before.IsPositive() {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPool, "pool had no %s reserves before", coin.Denom)
+		}
+
+		scalingFactor := int64(pa.GetScalingFactorByLiquidityIndex(i))
+		scaled := coin.Amount.ToDec().QuoInt64(scalingFactor)
+		scaledBefore := before.ToDec().QuoInt64(scalingFactor)
+		productRatio = productRatio.Mul(coin.Amount.ToDec().QuoInt(before))
+		sumSquares = sumSquares.Add(scaled.Mul(scaled))
+		sumSquaresBefore = sumSquaresBefore.Add(scaledBefore.Mul(scaledBefore))
+	}
+	if !sumSquaresBefore.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "pool reserves before are too small")
+	}
+	return productRatio.Mul(sumSquares).Quo(sumSquaresBefore), nil
+}
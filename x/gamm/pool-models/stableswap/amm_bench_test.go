@@ -4,25 +4,29 @@ import (
 	"math/rand"
 	"testing"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
 )
 
 func BenchmarkCFMM(b *testing.B) {
-	// Uses solveCfmm
 	for i := 0; i < b.N; i++ {
-		runCalc(solveCfmm)
+		runCalc(nil)
 	}
 }
 
-func BenchmarkBinarySearch(b *testing.B) {
+func BenchmarkCFMMMultiAsset(b *testing.B) {
+	remReserves := []osmomath.BigDec{
+		osmomath.NewBigDec(rand.Int63n(100000) + 50000),
+		osmomath.NewBigDec(rand.Int63n(100000) + 50000),
+		osmomath.NewBigDec(rand.Int63n(100000) + 50000),
+	}
 	for i := 0; i < b.N; i++ {
-		runCalc(solveCFMMBinarySearch(cfmmConstant))
+		runCalc(remReserves)
 	}
 }
 
-func runCalc(solve func(sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec) {
-	xReserve := sdk.NewDec(rand.Int63n(100000) + 50000)
-	yReserve := sdk.NewDec(rand.Int63n(100000) + 50000)
-	yIn := sdk.NewDec(rand.Int63n(100000))
-	solve(xReserve, yReserve, yIn)
+func runCalc(remReserves []osmomath.BigDec) {
+	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	yIn := osmomath.NewBigDec(rand.Int63n(100000))
+	_, _ = solveCFMM(xReserve, yReserve, remReserves, yIn)
 }
@@ -1,102 +1,212 @@
 package stableswap
 
 import (
-	fmt "fmt"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
-// Replace with https://github.com/cosmos/cosmos-sdk/blob/master/types/decimal.go#L892-L895
-// once our SDK branch is up to date with it
-func decApproxEq(t *testing.T, exp sdk.Dec, actual sdk.Dec, errTolerance sdk.Dec) {
-	// We want |exp - actual| < errTolerance
-	diff := exp.Sub(actual).Abs()
-	require.True(t, diff.LTE(errTolerance), "expected %s, got %s, maximum errTolerance %s", exp, actual, errTolerance)
+// exactCFMMConstant returns the multi-asset CFMM prod(x_i) * sum(x_i^2) of
+// reserves without rounding, on their underlying integers
+func exactCFMMConstant(reserves []osmomath.BigDec) *big.Int {
+	product, sumSquares := big.NewInt(1), big.NewInt(0)
+	for _, reserve := range reserves {
+		product.Mul(product, reserve.BigInt())
+		sumSquares.Add(sumSquares, new(big.Int).Mul(reserve.BigInt(), reserve.BigInt()))
+	}
+	return product.Mul(product, sumSquares)
 }
 
-func TestCFMMInvariantTwoAssets(t *testing.T) {
-	kErrTolerance := sdk.OneDec()
-
+func TestSolveCFMM(t *testing.T) {
+	dec := osmomath.MustNewDecFromStr
 	tests := []struct {
-		xReserve sdk.Dec
-		yReserve sdk.Dec
-		yIn      sdk.Dec
+		name        string
+		xReserve    osmomath.BigDec
+		yReserve    osmomath.BigDec
+		remReserves []osmomath.BigDec
+		yIn         osmomath.BigDec
+		expectErr   bool
 	}{
 		{
-			sdk.NewDec(100),
-			sdk.NewDec(100),
-			sdk.NewDec(1),
+			name:     "two assets, small swap",
+			xReserve: dec("100"),
+			yReserve: dec("100"),
+			yIn:      dec("1"),
+		},
+		{
+			name:     "two assets, swap larger than the reserves",
+			xReserve: dec("100"),
+			yReserve: dec("100"),
+			yIn:      dec("1000"),
+		},
+		{
+			name:     "two assets, large reserves",
+			xReserve: dec("1000000000000"),
+			yReserve: dec("1000000000000"),
+			yIn:      dec("500000000000"),
+		},
+		{
+			name:     "two assets, uneven reserves",
+			xReserve: dec("1000000000000000000000000000000"),
+			yReserve: dec("100"),
+			yIn:      dec("1"),
+		},
+		{
+			name:     "two assets, taking y out",
+			xReserve: dec("100"),
+			yReserve: dec("100"),
+			yIn:      dec("-99.99"),
+		},
+		{
+			name:        "three assets",
+			xReserve:    dec("100"),
+			yReserve:    dec("100"),
+			remReserves: []osmomath.BigDec{dec("100")},
+			yIn:         dec("1000"),
+		},
+		{
+			name:        "four assets",
+			xReserve:    dec("1000000"),
+			yReserve:    dec("1000000"),
+			remReserves: []osmomath.BigDec{dec("1000000"), dec("1000000")},
+			yIn:         dec("1"),
+		},
+		{
+			name:        "five assets",
+			xReserve:    dec("1000000"),
+			yReserve:    dec("2000000"),
+			remReserves: []osmomath.BigDec{dec("3000000"), dec("1500000"), dec("1000000")},
+			yIn:         dec("12345"),
+		},
+		{
+			name:        "five assets, taking y out",
+			xReserve:    dec("1000000"),
+			yReserve:    dec("2000000"),
+			remReserves: []osmomath.BigDec{dec("3000000"), dec("1500000"), dec("1000000")},
+			yIn:         dec("-12345"),
+		},
+		{
+			name:        "five assets, large reserves",
+			xReserve:    dec("1000000000000000"),
+			yReserve:    dec("2000000000000000"),
+			remReserves: []osmomath.BigDec{dec("3000000000000000"), dec("1500000000000000"), dec("1000000000000000")},
+			yIn:         dec("123456789"),
+		},
+		{
+			name:      "taking all of y out",
+			xReserve:  dec("100"),
+			yReserve:  dec("100"),
+			yIn:       dec("-100"),
+			expectErr: true,
 		},
 		{
-			sdk.NewDec(100),
-			sdk.NewDec(100),
-			sdk.NewDec(1000),
+			name:      "empty reserve",
+			xReserve:  dec("100"),
+			yReserve:  dec("0"),
+			yIn:       dec("1"),
+			expectErr: true,
+		},
+		{
+			name:      "reserve too large",
+			xReserve:  dec("1000000000000000000000000000000000000000"),
+			yReserve:  dec("100"),
+			yIn:       dec("1"),
+			expectErr: true,
 		},
-		// {
-		// 	sdk.NewDec(100000),
-		// 	sdk.NewDec(100000),
-		// 	sdk.NewDec(10000),
-		// },
 	}
 
 	for _, test := range tests {
-		// using two-asset cfmm
-		k0 := cfmmConstant(test.xReserve, test.yReserve)
-		xOut := solveCfmm(test.xReserve, test.yReserve, test.yIn)
-		fmt.Println("xOut", xOut)
-		k1 := cfmmConstant(test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn))
-		decApproxEq(t, k0, k1, kErrTolerance)
-
-		// using multi-asset cfmm (should be equivalent with u = 1, w = 0)
-		k2 := cfmmConstantMulti(test.xReserve, test.yReserve, sdk.OneDec(), sdk.ZeroDec())
-		decApproxEq(t, k2, k0, kErrTolerance)
-		xOut2 := solveCfmmMulti(test.xReserve, test.yReserve, sdk.ZeroDec(), test.yIn)
-		fmt.Println(xOut2)
-		k3 := cfmmConstantMulti(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), sdk.OneDec(), sdk.ZeroDec())
-		decApproxEq(t, k2, k3, kErrTolerance)
+		xOut, err := solveCFMM(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+		if test.expectErr {
+			require.Error(t, err, "test: %v", test.name)
+			continue
+		}
+		require.NoError(t, err, "test: %v", test.name)
+		require.Equal(t, test.yIn.IsPositive(), xOut.IsPositive(), "test: %v", test.name)
+
+		yFinal := test.yReserve.Add(test.yIn)
+		k0 := exactCFMMConstant(append([]osmomath.BigDec{test.xReserve, test.yReserve}, test.remReserves...))
+		k1 := exactCFMMConstant(append([]osmomath.BigDec{test.xReserve.Sub(xOut), yFinal}, test.remReserves...))
+		// the CFMM constant doesn't decrease, and does if one more unit of x is taken out
+		require.True(t, k1.Cmp(k0) >= 0, "test: %v", test.name)
+		xFinal := test.xReserve.Sub(xOut).Sub(osmomath.SmallestDec())
+		k2 := exactCFMMConstant(append([]osmomath.BigDec{xFinal, yFinal}, test.remReserves...))
+		require.True(t, k2.Cmp(k0) < 0, "test: %v", test.name)
 	}
 }
 
-func TestCFMMInvariantMultiAssets(t *testing.T) {
-	kErrTolerance := sdk.OneDec()
-
-	tests := []struct {
-		xReserve    sdk.Dec
-		yReserve    sdk.Dec
-		uReserve    sdk.Dec
-		wSumSquares sdk.Dec
-		yIn         sdk.Dec
-	}{
-		{
-			sdk.NewDec(100),
-			sdk.NewDec(100),
-			// represents a 4-asset pool with 100 in each reserve
-			sdk.NewDec(200),
-			sdk.NewDec(20000),
-			sdk.NewDec(1),
-		},
-		{
-			sdk.NewDec(100),
-			sdk.NewDec(100),
-			sdk.NewDec(200),
-			sdk.NewDec(20000),
-			sdk.NewDec(1000),
-		},
-		// {
-		// 	sdk.NewDec(100000),
-		// 	sdk.NewDec(100000),
-		// 	sdk.NewDec(10000),
-		// },
+func TestCalcAmtGivenMultiAsset(t *testing.T) {
+	pool := Pool{
+		PoolLiquidity: sdk.NewCoins(
+			sdk.NewInt64Coin("atom", 1_000_000_000_000),
+			sdk.NewInt64Coin("bar", 1_000_000_000_000),
+			sdk.NewInt64Coin("baz", 1_000_000_000),
+			sdk.NewInt64Coin("foo", 1_000_000_000_000),
+			sdk.NewInt64Coin("osmo", 1_000_000_000_000),
+		),
+		ScalingFactor: []uint64{1000, 1000, 1, 1000, 1000},
 	}
+	swapFee := sdk.ZeroDec()
 
-	for _, test := range tests {
-		// using multi-asset cfmm
-		k2 := cfmmConstantMulti(test.xReserve, test.yReserve, test.uReserve, test.wSumSquares)
-		xOut2 := solveCfmmMulti(test.xReserve, test.yReserve, test.wSumSquares, test.yIn)
-		fmt.Println(xOut2)
-		k3 := cfmmConstantMulti(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), test.uReserve, test.wSumSquares)
-		decApproxEq(t, k2, k3, kErrTolerance)
+	spotPrice, err := pool.SpotPrice(sdk.Context{}, "atom", "bar")
+	require.NoError(t, err)
+	decApproxEq(t, sdk.NewDec(1000), spotPrice, sdk.NewDecWithPrec(1, 3))
+
+	tokenIn := sdk.NewInt64Coin("baz", 1_000_000)
+	outAmt, err := pool.calcOutAmtGivenIn(tokenIn, "foo", swapFee)
+	require.NoError(t, err)
+	decApproxEq(t, sdk.NewDec(1_000_000_000), outAmt, sdk.NewDec(1_000_000))
+
+	// swapping the amount out back in for the same amount out costs at least as much
+	tokenOut := sdk.NewCoin("foo", outAmt.TruncateInt())
+	inAmt, err := pool.calcInAmtGivenOut(tokenOut, "baz", swapFee)
+	require.NoError(t, err)
+	require.True(t, inAmt.LTE(tokenIn.Amount.ToDec()), "in %s, out %s", inAmt, outAmt)
+	require.True(t, inAmt.Ceil().TruncateInt().Equal(tokenIn.Amount), "in %s, out %s", inAmt, outAmt)
+
+	_, err = pool.calcInAmtGivenOut(sdk.NewInt64Coin("foo", 1_000_000_000_000), "baz", swapFee)
+	require.Error(t, err)
+
+	pool.ScalingFactor = []uint64{1, 1}
+	_, err = pool.calcOutAmtGivenIn(tokenIn, "foo", swapFee)
+	require.ErrorIs(t, err, types.ErrInvalidStableswapScalingFactors)
+}
+
+// Replace with https://github.com/cosmos/cosmos-sdk/blob/master/types/decimal.go#L892-L895
+// once our SDK branch is up to date with it
+func decApproxEq(t *testing.T, exp sdk.Dec, actual sdk.Dec, errTolerance sdk.Dec) {
+	// We want |exp - actual| < errTolerance
+	diff := exp.Sub(actual).Abs()
+	require.True(t, diff.LTE(errTolerance), "expected %s, got %s, maximum errTolerance %s", exp, actual, errTolerance)
+}
+
+func TestCFMMValueRatio(t *testing.T) {
+	pool := Pool{
+		PoolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("bar", 1100), sdk.NewInt64Coin("foo", 900)),
+		ScalingFactor: []uint64{1, 1},
 	}
+
+	// 1100 * 900 * (1100^2 + 900^2) / (1000 * 1000 * (1000^2 + 1000^2))
+	ratio, err := pool.CFMMValueRatio(sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.9999"), ratio)
+
+	ratio, err = pool.CFMMValueRatio(pool.PoolLiquidity)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), ratio)
+
+	// scaling factors weigh the sum of squares
+	pool.ScalingFactor = []uint64{10, 1}
+	// 1100 * 900 * (110^2 + 900^2) / (1000 * 1000 * (100^2 + 1000^2))
+	ratio, err = pool.CFMMValueRatio(sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.805820792079207921"), ratio)
+
+	_, err = pool.CFMMValueRatio(sdk.NewCoins(sdk.NewInt64Coin("bar", 1000)))
+	require.Error(t, err)
 }
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/StableswapPool", nil)
 	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "osmosis/gamm/create-stableswap-pool", nil)
 	cdc.RegisterConcrete(&MsgStableSwapAdjustScalingFactors{}, "osmosis/gamm/stableswap-adjust-scaling-factors", nil)
+	cdc.RegisterConcrete(&MsgStableSwapStartScalingFactorRamp{}, "osmosis/gamm/stableswap-start-scaling-factor-ramp", nil)
+	cdc.RegisterConcrete(&MsgStableSwapCancelScalingFactorRamp{}, "osmosis/gamm/stableswap-cancel-scaling-factor-ramp", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/StableswapPoolParams", nil)
 }
 
@@ -28,6 +30,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateStableswapPool{},
 		&MsgStableSwapAdjustScalingFactors{},
+		&MsgStableSwapStartScalingFactorRamp{},
+		&MsgStableSwapCancelScalingFactorRamp{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
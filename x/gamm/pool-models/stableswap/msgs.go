@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,8 +10,10 @@ import (
 )
 
 const (
-	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
-	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgCreateStableswapPool              = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors    = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapStartScalingFactorRamp  = "stable_swap_start_scaling_factor_ramp"
+	TypeMsgStableSwapCancelScalingFactorRamp = "stable_swap_cancel_scaling_factor_ramp"
 )
 
 var (
@@ -22,12 +26,14 @@ func NewMsgCreateStableswapPool(
 	poolParams PoolParams,
 	initialLiquidity sdk.Coins,
 	futurePoolGovernor string,
+	scalingFactorGovernor string,
 ) MsgCreateStableswapPool {
 	return MsgCreateStableswapPool{
-		Sender:               sender.String(),
-		PoolParams:           &poolParams,
-		InitialPoolLiquidity: initialLiquidity,
-		FuturePoolGovernor:   futurePoolGovernor,
+		Sender:                sender.String(),
+		PoolParams:            &poolParams,
+		InitialPoolLiquidity:  initialLiquidity,
+		FuturePoolGovernor:    futurePoolGovernor,
+		ScalingFactorGovernor: scalingFactorGovernor,
 	}
 }
 
@@ -45,10 +51,9 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 	}
 
 	// validation for pool initial liquidity
-	// TO DO: expand this check to accommodate multi-asset pools for stableswap
-	if len(msg.InitialPoolLiquidity) < 2 {
+	if len(msg.InitialPoolLiquidity) < types.MinPoolAssets {
 		return types.ErrTooFewPoolAssets
-	} else if len(msg.InitialPoolLiquidity) > 2 {
+	} else if len(msg.InitialPoolLiquidity) > types.MaxPoolAssets {
 		return types.ErrTooManyPoolAssets
 	}
 
@@ -57,6 +62,12 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 		return err
 	}
 
+	if msg.ScalingFactorGovernor != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.ScalingFactorGovernor); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid scaling factor governor address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
@@ -95,6 +106,7 @@ func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolId uint64) (t
 	if err != nil {
 		return nil, err
 	}
+	stableswapPool.ScalingFactorGovernor = msg.ScalingFactorGovernor
 
 	return &stableswapPool, nil
 }
@@ -142,3 +154,100 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapStartScalingFactorRamp{}
+
+func NewMsgStableSwapStartScalingFactorRamp(
+	sender string,
+	poolID uint64,
+	targetScalingFactors []uint64,
+	startTime time.Time,
+	duration time.Duration,
+) MsgStableSwapStartScalingFactorRamp {
+	return MsgStableSwapStartScalingFactorRamp{
+		Sender:               sender,
+		PoolID:               poolID,
+		TargetScalingFactors: targetScalingFactors,
+		StartTime:            startTime,
+		Duration:             duration,
+	}
+}
+
+func (msg MsgStableSwapStartScalingFactorRamp) Route() string { return types.RouterKey }
+func (msg MsgStableSwapStartScalingFactorRamp) Type() string {
+	return TypeMsgStableSwapStartScalingFactorRamp
+}
+
+func (msg MsgStableSwapStartScalingFactorRamp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.TargetScalingFactors) == 0 {
+		return types.ErrInvalidStableswapScalingFactors
+	}
+	for _, scalingFactor := range msg.TargetScalingFactors {
+		if scalingFactor == 0 {
+			return sdkerrors.Wrap(types.ErrInvalidScalingFactorRamp, "scaling factors must be positive")
+		}
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidScalingFactorRamp, "duration must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapStartScalingFactorRamp) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapStartScalingFactorRamp) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
+
+var _ sdk.Msg = &MsgStableSwapCancelScalingFactorRamp{}
+
+func NewMsgStableSwapCancelScalingFactorRamp(
+	sender string,
+	poolID uint64,
+) MsgStableSwapCancelScalingFactorRamp {
+	return MsgStableSwapCancelScalingFactorRamp{
+		Sender: sender,
+		PoolID: poolID,
+	}
+}
+
+func (msg MsgStableSwapCancelScalingFactorRamp) Route() string { return types.RouterKey }
+func (msg MsgStableSwapCancelScalingFactorRamp) Type() string {
+	return TypeMsgStableSwapCancelScalingFactorRamp
+}
+
+func (msg MsgStableSwapCancelScalingFactorRamp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapCancelScalingFactorRamp) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapCancelScalingFactorRamp) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
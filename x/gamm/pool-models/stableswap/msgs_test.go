@@ -1,6 +1,7 @@
 package stableswap
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -99,6 +100,17 @@ func TestMsgCreateStableswapPool(t *testing.T) {
 				}
 				return msg
 			}),
+			expectPass: true,
+		},
+		{
+			name: "has too many coins in InitialPoolLiquidity",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{}
+				for i := 0; i <= types.MaxPoolAssets; i++ {
+					msg.InitialPoolLiquidity = append(msg.InitialPoolLiquidity, sdk.NewCoin(fmt.Sprintf("token%d", i), sdk.NewInt(100)))
+				}
+				return msg
+			}),
 			expectPass: false,
 		},
 		{
@@ -168,6 +180,22 @@ func TestMsgCreateStableswapPool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid scaling factor governor",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.ScalingFactorGovernor = addr1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid scaling factor governor",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.ScalingFactorGovernor = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "zero swap fee, zero exit fee",
 			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
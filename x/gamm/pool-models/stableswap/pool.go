@@ -4,16 +4,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/internal/cfmm_common"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
-var _ types.PoolI = &Pool{}
+var (
+	_ types.PoolI                  = &Pool{}
+	_ types.PoolAmountOutExtension = &Pool{}
+)
 
 func (pa Pool) GetAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(pa.Address)
@@ -35,8 +40,13 @@ func (pa Pool) GetId() uint64 {
 	return pa.Id
 }
 
+// GetSwapFee returns the swap fee of the pool, which for pools with dynamic
+// swap fees depends on the volatility recorded by its recent swaps.
 func (pa Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
-	return pa.PoolParams.SwapFee
+	if pa.PoolParams.DynamicSwapFeeParams == nil {
+		return pa.PoolParams.SwapFee
+	}
+	return pa.PoolParams.DynamicSwapFeeParams.SwapFee(pa.PoolParams.SwapFee, pa.VolatilityAccumulator, ctx.BlockTime())
 }
 
 func (pa Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
@@ -83,32 +93,77 @@ func (pa Pool) getPoolAmts(denoms ...string) ([]sdk.Int, error) {
 	return result, nil
 }
 
+// getScalingFactor returns the scaling factor of denom, erroring if the
+// scaling factors of the pool don't match its liquidity.
+func (pa Pool) getScalingFactor(denom string) (int64, error) {
+	if len(pa.ScalingFactor) != pa.PoolLiquidity.Len() {
+		return 0, types.ErrInvalidStableswapScalingFactors
+	}
+	liquidityIndex, ok := pa.getLiquidityIndexMap()[denom]
+	if !ok {
+		return 0, fmt.Errorf("denom %s does not exist in pool", denom)
+	}
+	scalingFactor := pa.GetScalingFactorByLiquidityIndex(liquidityIndex)
+	if scalingFactor == 0 || scalingFactor > math.MaxInt64 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidStableswapScalingFactors, "invalid scaling factor %d for %s", scalingFactor, denom)
+	}
+	return int64(scalingFactor), nil
+}
+
+// getScaledAmt returns amount of denom scaled by its scaling factor
+func (pa Pool) getScaledAmt(denom string, amount osmomath.BigDec) (osmomath.BigDec, error) {
+	scalingFactor, err := pa.getScalingFactor(denom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return amount.QuoInt64(scalingFactor), nil
+}
+
 // getScaledPoolAmts returns scaled amount of pool liquidity based on each asset's precisions
-func (pa Pool) getScaledPoolAmts(denoms ...string) ([]sdk.Dec, error) {
-	result := make([]sdk.Dec, len(denoms))
+func (pa Pool) getScaledPoolAmts(denoms ...string) ([]osmomath.BigDec, error) {
+	result := make([]osmomath.BigDec, len(denoms))
 	poolLiquidity := pa.PoolLiquidity
-	liquidityIndexes := pa.getLiquidityIndexMap()
 
 	for i, denom := range denoms {
-		liquidityIndex := liquidityIndexes[denom]
-
 		amt := poolLiquidity.AmountOf(denom)
 		if amt.IsZero() {
-			return []sdk.Dec{}, fmt.Errorf("denom %s does not exist in pool", denom)
+			return []osmomath.BigDec{}, fmt.Errorf("denom %s does not exist in pool", denom)
 		}
-		scalingFactor := pa.GetScalingFactorByLiquidityIndex(liquidityIndex)
-		result[i] = amt.ToDec().QuoInt64Mut(int64(scalingFactor))
+		scaledAmt, err := pa.getScaledAmt(denom, osmomath.BigDecFromSDKInt(amt))
+		if err != nil {
+			return []osmomath.BigDec{}, err
+		}
+		result[i] = scaledAmt
 	}
 	return result, nil
 }
 
-// getDescaledPoolAmts gets descaled amount of given denom and amount
-func (pa Pool) getDescaledPoolAmt(denom string, amount sdk.Dec) sdk.Dec {
-	liquidityIndexes := pa.getLiquidityIndexMap()
-	liquidityIndex := liquidityIndexes[denom]
+// getScaledSwapReserves returns the scaled reserves of xDenom and yDenom, and
+// of all other assets of the pool
+func (pa Pool) getScaledSwapReserves(xDenom, yDenom string) (xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, err error) {
+	if xDenom == yDenom {
+		return osmomath.BigDec{}, osmomath.BigDec{}, nil, fmt.Errorf("cannot swap %s for itself", xDenom)
+	}
+	denoms := []string{xDenom, yDenom}
+	for _, coin := range pa.PoolLiquidity {
+		if coin.Denom != xDenom && coin.Denom != yDenom {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+	reserves, err := pa.getScaledPoolAmts(denoms...)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, nil, err
+	}
+	return reserves[0], reserves[1], reserves[2:], nil
+}
 
-	scalingFactor := pa.GetScalingFactorByLiquidityIndex(liquidityIndex)
-	return amount.MulInt64(int64(scalingFactor))
+// getDescaledPoolAmts gets descaled amount of given denom and amount
+func (pa Pool) getDescaledPoolAmt(denom string, amount osmomath.BigDec) (osmomath.BigDec, error) {
+	scalingFactor, err := pa.getScalingFactor(denom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return amount.MulInt64(scalingFactor), nil
 }
 
 // getLiquidityIndexMap creates a map of denoms to its index in pool liquidity
@@ -135,6 +190,30 @@ func (p *Pool) updatePoolLiquidityForSwap(tokensIn sdk.Coins, tokensOut sdk.Coin
 	}
 }
 
+// applySwap updates the pool liquidity for a swap, and for pools with dynamic
+// swap fees, records the spot price move of the swap in the pool's volatility.
+func (p *Pool) applySwap(ctx sdk.Context, tokensIn sdk.Coins, tokensOut sdk.Coins) error {
+	if p.PoolParams.DynamicSwapFeeParams == nil {
+		p.updatePoolLiquidityForSwap(tokensIn, tokensOut)
+		return nil
+	}
+
+	spotPriceBefore, err := p.SpotPrice(ctx, tokensIn[0].Denom, tokensOut[0].Denom)
+	if err != nil {
+		return err
+	}
+	p.updatePoolLiquidityForSwap(tokensIn, tokensOut)
+	spotPriceAfter, err := p.SpotPrice(ctx, tokensIn[0].Denom, tokensOut[0].Denom)
+	if err != nil {
+		return err
+	}
+
+	p.VolatilityAccumulator = types.UpdateVolatilityAccumulator(
+		p.VolatilityAccumulator, p.PoolParams.DynamicSwapFeeParams.DecayPeriod,
+		spotPriceBefore, spotPriceAfter, ctx.BlockTime())
+	return nil
+}
+
 // updatePoolLiquidityForExit updates the pool liquidity after an exit.
 // The function sanity checks that not all tokens of a given denom are removed,
 // and panics if thats the case.
@@ -147,6 +226,11 @@ func (p *Pool) updatePoolForJoin(tokensIn sdk.Coins, newShares sdk.Int) {
 	p.TotalShares.Amount = p.TotalShares.Amount.Add(newShares)
 }
 
+// IncreaseLiquidity increases the pool's liquidity by the specified sharesOut and coinsIn.
+func (p *Pool) IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins) {
+	p.updatePoolForJoin(coinsIn, sharesOut)
+}
+
 // TODO: These should all get moved to amm.go
 func (pa Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
 	if tokenIn.Len() != 1 {
@@ -171,7 +255,10 @@ func (pa *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDe
 		return sdk.Coin{}, err
 	}
 
-	pa.updatePoolLiquidityForSwap(tokenIn, sdk.NewCoins(tokenOut))
+	err = pa.applySwap(ctx, tokenIn, sdk.NewCoins(tokenOut))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	return tokenOut, nil
 }
@@ -202,20 +289,29 @@ func (pa *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDe
 		return sdk.Coin{}, err
 	}
 
-	pa.updatePoolLiquidityForSwap(sdk.NewCoins(tokenIn), tokenOut)
+	err = pa.applySwap(ctx, sdk.NewCoins(tokenIn), tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	return tokenIn, nil
 }
 
 func (pa Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(baseAssetDenom, quoteAssetDenom)
+	baseReserve, quoteReserve, remReserves, err := pa.getScaledSwapReserves(baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	scaledSpotPrice, err := spotPrice(baseReserve, quoteReserve, remReserves)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	spotPrice, err := pa.getDescaledPoolAmt(baseAssetDenom, scaledSpotPrice)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	scaledSpotPrice := spotPrice(reserves[0], reserves[1])
-	spotPrice := pa.getDescaledPoolAmt(baseAssetDenom, scaledSpotPrice)
 
-	return spotPrice, nil
+	return spotPrice.SDKDec(), nil
 }
 
 func (pa Pool) Copy() Pool {
@@ -250,5 +346,94 @@ func (pa Pool) CalcExitPoolShares(ctx sdk.Context, exitingShares sdk.Int, exitFe
 	return cfmm_common.CalcExitPool(ctx, &pa, exitingShares, exitFee)
 }
 
-// no-op for stableswap
-func (pa *Pool) PokePool(blockTime time.Time) {}
+// PokePool updates the scaling factors of a pool with a scaling factor ramp.
+func (pa *Pool) PokePool(blockTime time.Time) {
+	if pa.ScalingFactorRamp == nil {
+		return
+	}
+
+	ramp := *pa.ScalingFactorRamp
+
+	// The scaling factors s(t) for the pool at time `t` are defined in one of
+	// three possible ways:
+	//
+	// 1. t <= start_time: s(t) = initial_scaling_factors
+	//
+	// 2. start_time < t < start_time + duration:
+	//     s(t) = initial_scaling_factors + (t - start_time) *
+	//       (target_scaling_factors - initial_scaling_factors) / (duration)
+	//
+	// 3. t >= start_time + duration: s(t) = target_scaling_factors
+	switch {
+	case !blockTime.After(ramp.StartTime):
+		// case 1: t <= start_time
+		return
+
+	case !blockTime.Before(ramp.StartTime.Add(ramp.Duration)):
+		// case 3: t >= start_time + duration
+
+		pa.ScalingFactor = append([]uint64{}, ramp.TargetScalingFactors...)
+
+		// we've finished updating the scaling factors, so reset the ramp
+		pa.ScalingFactorRamp = nil
+
+	default:
+		// case 2: start_time < t < start_time + duration
+		shiftedBlockTime := blockTime.Sub(ramp.StartTime).Milliseconds()
+		percentDurationElapsed := sdk.NewDec(shiftedBlockTime).QuoInt64(ramp.Duration.Milliseconds())
+
+		scalingFactors := make([]uint64, len(ramp.TargetScalingFactors))
+		for i, target := range ramp.TargetScalingFactors {
+			initial := sdk.NewIntFromUint64(ramp.InitialScalingFactors[i])
+			diff := sdk.NewIntFromUint64(target).Sub(initial)
+			scalingFactors[i] = initial.Add(percentDurationElapsed.MulInt(diff).TruncateInt()).Uint64()
+		}
+		pa.ScalingFactor = scalingFactors
+	}
+}
+
+// StartScalingFactorRamp starts changing the scaling factors of the pool
+// linearly to targetScalingFactors over duration, from startTime.
+// A zero startTime starts the ramp at blockTime.
+// Any ramp the pool already had is replaced, starting from the scaling
+// factors it reached.
+func (pa *Pool) StartScalingFactorRamp(targetScalingFactors []uint64, startTime time.Time, duration time.Duration, blockTime time.Time) error {
+	if len(targetScalingFactors) != pa.PoolLiquidity.Len() || len(pa.ScalingFactor) != pa.PoolLiquidity.Len() {
+		return types.ErrInvalidStableswapScalingFactors
+	}
+	for _, scalingFactor := range targetScalingFactors {
+		if scalingFactor == 0 {
+			return sdkerrors.Wrap(types.ErrInvalidScalingFactorRamp, "scaling factors must be positive")
+		}
+	}
+	if duration <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidScalingFactorRamp, "duration must be positive")
+	}
+
+	if startTime.IsZero() {
+		startTime = blockTime
+	} else if startTime.Before(blockTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactorRamp, "start time %s is before the block time %s", startTime, blockTime)
+	}
+
+	pa.PokePool(blockTime)
+	pa.ScalingFactorRamp = &ScalingFactorRamp{
+		StartTime:             startTime,
+		Duration:              duration,
+		InitialScalingFactors: append([]uint64{}, pa.ScalingFactor...),
+		TargetScalingFactors:  append([]uint64{}, targetScalingFactors...),
+	}
+	return nil
+}
+
+// CancelScalingFactorRamp stops the scaling factor ramp of the pool, keeping
+// the scaling factors it reached at blockTime.
+func (pa *Pool) CancelScalingFactorRamp(blockTime time.Time) error {
+	if pa.ScalingFactorRamp == nil {
+		return types.ErrNoScalingFactorRamp
+	}
+
+	pa.PokePool(blockTime)
+	pa.ScalingFactorRamp = nil
+	return nil
+}
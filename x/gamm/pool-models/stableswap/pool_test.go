@@ -0,0 +1,226 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var defaultCurBlockTime = time.Unix(1618700000, 0)
+
+func rampTestPool() Pool {
+	return Pool{
+		PoolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000)),
+		ScalingFactor: []uint64{100, 100},
+	}
+}
+
+func TestScalingFactorRamp(t *testing.T) {
+	tests := []struct {
+		name           string
+		blockTime      time.Time
+		expectedFactor []uint64
+		rampFinished   bool
+	}{
+		{
+			name:           "before start time",
+			blockTime:      defaultCurBlockTime.Add(-time.Hour),
+			expectedFactor: []uint64{100, 100},
+		},
+		{
+			name:           "at start time",
+			blockTime:      defaultCurBlockTime,
+			expectedFactor: []uint64{100, 100},
+		},
+		{
+			name:           "a quarter of the duration in",
+			blockTime:      defaultCurBlockTime.Add(time.Hour),
+			expectedFactor: []uint64{150, 80},
+		},
+		{
+			name:           "half of the duration in",
+			blockTime:      defaultCurBlockTime.Add(2 * time.Hour),
+			expectedFactor: []uint64{200, 60},
+		},
+		{
+			name:           "at end time",
+			blockTime:      defaultCurBlockTime.Add(4 * time.Hour),
+			expectedFactor: []uint64{300, 20},
+			rampFinished:   true,
+		},
+		{
+			name:           "after end time",
+			blockTime:      defaultCurBlockTime.Add(5 * time.Hour),
+			expectedFactor: []uint64{300, 20},
+			rampFinished:   true,
+		},
+	}
+
+	for _, test := range tests {
+		pool := rampTestPool()
+		err := pool.StartScalingFactorRamp([]uint64{300, 20}, defaultCurBlockTime, 4*time.Hour, defaultCurBlockTime.Add(-time.Hour))
+		require.NoError(t, err, "test: %v", test.name)
+
+		pool.PokePool(test.blockTime)
+		require.Equal(t, test.expectedFactor, pool.GetScalingFactors(), "test: %v", test.name)
+		require.Equal(t, test.rampFinished, pool.ScalingFactorRamp == nil, "test: %v", test.name)
+	}
+}
+
+func TestStartScalingFactorRamp(t *testing.T) {
+	tests := []struct {
+		name                 string
+		targetScalingFactors []uint64
+		startTime            time.Time
+		duration             time.Duration
+		expectedErr          error
+	}{
+		{
+			name:                 "start at block time",
+			targetScalingFactors: []uint64{200, 100},
+			duration:             time.Hour,
+		},
+		{
+			name:                 "start in the future",
+			targetScalingFactors: []uint64{200, 100},
+			startTime:            defaultCurBlockTime.Add(time.Hour),
+			duration:             time.Hour,
+		},
+		{
+			name:                 "start in the past",
+			targetScalingFactors: []uint64{200, 100},
+			startTime:            defaultCurBlockTime.Add(-time.Hour),
+			duration:             time.Hour,
+			expectedErr:          types.ErrInvalidScalingFactorRamp,
+		},
+		{
+			name:                 "wrong number of scaling factors",
+			targetScalingFactors: []uint64{200},
+			duration:             time.Hour,
+			expectedErr:          types.ErrInvalidStableswapScalingFactors,
+		},
+		{
+			name:                 "zero scaling factor",
+			targetScalingFactors: []uint64{200, 0},
+			duration:             time.Hour,
+			expectedErr:          types.ErrInvalidScalingFactorRamp,
+		},
+		{
+			name:                 "zero duration",
+			targetScalingFactors: []uint64{200, 100},
+			expectedErr:          types.ErrInvalidScalingFactorRamp,
+		},
+	}
+
+	for _, test := range tests {
+		pool := rampTestPool()
+		err := pool.StartScalingFactorRamp(test.targetScalingFactors, test.startTime, test.duration, defaultCurBlockTime)
+		if test.expectedErr != nil {
+			require.ErrorIs(t, err, test.expectedErr, "test: %v", test.name)
+			require.Nil(t, pool.ScalingFactorRamp, "test: %v", test.name)
+			continue
+		}
+		require.NoError(t, err, "test: %v", test.name)
+
+		expectedStartTime := test.startTime
+		if expectedStartTime.IsZero() {
+			expectedStartTime = defaultCurBlockTime
+		}
+		require.Equal(t, &ScalingFactorRamp{
+			StartTime:             expectedStartTime,
+			Duration:              test.duration,
+			InitialScalingFactors: []uint64{100, 100},
+			TargetScalingFactors:  test.targetScalingFactors,
+		}, pool.ScalingFactorRamp, "test: %v", test.name)
+	}
+}
+
+func TestCancelScalingFactorRamp(t *testing.T) {
+	pool := rampTestPool()
+	require.ErrorIs(t, pool.CancelScalingFactorRamp(defaultCurBlockTime), types.ErrNoScalingFactorRamp)
+
+	err := pool.StartScalingFactorRamp([]uint64{300, 100}, defaultCurBlockTime, 4*time.Hour, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	// the scaling factors reached by the ramp are kept
+	err = pool.CancelScalingFactorRamp(defaultCurBlockTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Nil(t, pool.ScalingFactorRamp)
+	require.Equal(t, []uint64{150, 100}, pool.GetScalingFactors())
+
+	pool.PokePool(defaultCurBlockTime.Add(4 * time.Hour))
+	require.Equal(t, []uint64{150, 100}, pool.GetScalingFactors())
+}
+
+func shareAmountOutTestPool() Pool {
+	return Pool{
+		PoolParams: PoolParams{
+			SwapFee: sdk.ZeroDec(),
+			ExitFee: sdk.ZeroDec(),
+		},
+		TotalShares:   sdk.NewCoin(types.GetPoolShareDenom(1), types.InitPoolSharesSupply),
+		PoolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("bar", 10000), sdk.NewInt64Coin("foo", 10000)),
+		ScalingFactor: []uint64{1, 1},
+	}
+}
+
+func TestJoinPoolTokenInMaxShareAmountOut(t *testing.T) {
+	ctx := sdk.Context{}
+	pool := shareAmountOutTestPool()
+	// 1% of the pool's shares
+	shareOutAmount := types.InitPoolSharesSupply.QuoRaw(100)
+
+	tokenInAmount, err := pool.CalcTokenInShareAmountOut(ctx, "foo", shareOutAmount, pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.Equal(t, shareAmountOutTestPool(), pool)
+	// about 1% of the value of the pool
+	decApproxEq(t, sdk.NewDec(200), tokenInAmount.ToDec(), sdk.NewDec(2))
+	sharesOut, err := pool.calcSingleAssetJoinShares(sdk.NewCoin("foo", tokenInAmount), pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.True(t, sharesOut.GTE(shareOutAmount))
+
+	joinedAmount, err := pool.JoinPoolTokenInMaxShareAmountOut(ctx, "foo", shareOutAmount)
+	require.NoError(t, err)
+	require.Equal(t, tokenInAmount, joinedAmount)
+	require.Equal(t, sdk.NewInt(10000).Add(tokenInAmount), pool.PoolLiquidity.AmountOf("foo"))
+	require.Equal(t, sdk.NewInt(10000), pool.PoolLiquidity.AmountOf("bar"))
+	require.Equal(t, types.InitPoolSharesSupply.Add(shareOutAmount), pool.GetTotalShares())
+
+	_, err = pool.CalcTokenInShareAmountOut(ctx, "baz", shareOutAmount, pool.GetSwapFee(ctx))
+	require.Error(t, err)
+}
+
+func TestExitSwapExactAmountOut(t *testing.T) {
+	ctx := sdk.Context{}
+	tokenOut := sdk.NewInt64Coin("foo", 100)
+
+	pool := shareAmountOutTestPool()
+	shareInAmount, err := pool.ExitSwapExactAmountOut(ctx, tokenOut, types.InitPoolSharesSupply)
+	require.NoError(t, err)
+	// about 0.5% of the pool's shares, rounded up for the truncated swap
+	decApproxEq(t, types.InitPoolSharesSupply.QuoRaw(200).ToDec(), shareInAmount.ToDec(), types.InitPoolSharesSupply.QuoRaw(10000).ToDec())
+	require.Equal(t, sdk.NewInt(9900), pool.PoolLiquidity.AmountOf("foo"))
+	require.Equal(t, sdk.NewInt(10000), pool.PoolLiquidity.AmountOf("bar"))
+	require.Equal(t, types.InitPoolSharesSupply.Sub(shareInAmount), pool.GetTotalShares())
+
+	// the exited shares are just enough for tokenOut
+	pool = shareAmountOutTestPool()
+	exitedCoins, err := pool.ExitPool(ctx, shareInAmount.SubRaw(1), pool.GetExitFee(ctx))
+	require.NoError(t, err)
+	swapOut, err := pool.calcOutAmtGivenIn(sdk.NewCoin("bar", exitedCoins.AmountOf("bar")), "foo", pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+	require.True(t, exitedCoins.AmountOf("foo").Add(swapOut.TruncateInt()).LT(tokenOut.Amount))
+
+	pool = shareAmountOutTestPool()
+	_, err = pool.ExitSwapExactAmountOut(ctx, tokenOut, shareInAmount.SubRaw(1))
+	require.ErrorIs(t, err, types.ErrLimitMaxAmount)
+	require.Equal(t, shareAmountOutTestPool(), pool)
+
+	_, err = pool.ExitSwapExactAmountOut(ctx, sdk.NewInt64Coin("foo", 20000), types.InitPoolSharesSupply)
+	require.ErrorIs(t, err, types.ErrTooManyTokensOut)
+}
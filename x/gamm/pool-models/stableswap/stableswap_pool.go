@@ -10,12 +10,18 @@ import (
 
 var _ types.PoolI = &Pool{}
 
-// NewStableswapPool returns a stableswap pool
+// NewStableswapPool returns a stableswap pool, with a scaling factor of 1 for
+// each asset
 // Invariants that are assumed to be satisfied and not checked:
-// * len(initialLiquidity) = 2
+// * 2 <= len(initialLiquidity) <= 8
 // * FutureGovernor is valid
 // * poolID doesn't already exist
 func NewStableswapPool(poolId uint64, stableswapPoolParams PoolParams, initialLiquidity sdk.Coins, futureGovernor string, blockTime time.Time) (Pool, error) {
+	scalingFactors := make([]uint64, len(initialLiquidity))
+	for i := range scalingFactors {
+		scalingFactors[i] = 1
+	}
+
 	pool := Pool{
 		Address:            types.NewPoolAddress(poolId).String(),
 		Id:                 poolId,
@@ -23,6 +29,7 @@ func NewStableswapPool(poolId uint64, stableswapPoolParams PoolParams, initialLi
 		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
 		PoolLiquidity:      initialLiquidity,
 		FuturePoolGovernor: futureGovernor,
+		ScalingFactor:      scalingFactors,
 	}
 
 	return pool, nil
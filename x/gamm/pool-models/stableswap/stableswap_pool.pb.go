@@ -7,20 +7,24 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// When set, swap_fee is the base swap fee of the pool, which is increased
+	// during volatile periods.
+	DynamicSwapFeeParams *types.DynamicSwapFeeParams `protobuf:"bytes,3,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -70,6 +77,88 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetDynamicSwapFeeParams() *types.DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
+// ScalingFactorRamp changes the scaling factors of a stableswap pool linearly
+// over time, so that the pool's prices don't move discontinuously.
+type ScalingFactorRamp struct {
+	// The time the scaling factors start changing at.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the scaling factors to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The scaling factors of the pool when the ramp was started.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// The scaling factors change linearly with respect to time between
+	// start_time and start_time + duration, ending at target_scaling_factors.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorRamp) Reset()         { *m = ScalingFactorRamp{} }
+func (m *ScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRamp) ProtoMessage()    {}
+func (*ScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *ScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRamp.Merge(m, src)
+}
+func (m *ScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRamp proto.InternalMessageInfo
+
+func (m *ScalingFactorRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRamp) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorRamp) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +174,25 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types2.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactor []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty" yaml:"stableswap_scaling_factor"`
 	// scaling_factor_governor is the address can adjust pool scaling factors
 	ScalingFactorGovernor string `protobuf:"bytes,8,opt,name=scaling_factor_governor,json=scalingFactorGovernor,proto3" json:"scaling_factor_governor,omitempty" yaml:"scaling_factor_governor"`
+	// volatility recorded by the pool's swaps, only set if the pool has
+	// dynamic swap fees.
+	VolatilityAccumulator *types.VolatilityAccumulator `protobuf:"bytes,9,opt,name=volatility_accumulator,json=volatilityAccumulator,proto3" json:"volatility_accumulator,omitempty" yaml:"volatility_accumulator"`
+	// scaling_factor_ramp is set while the scaling factors are being ramped
+	// towards new values by the scaling_factor_governor.
+	ScalingFactorRamp *ScalingFactorRamp `protobuf:"bytes,10,opt,name=scaling_factor_ramp,json=scalingFactorRamp,proto3" json:"scaling_factor_ramp,omitempty" yaml:"scaling_factor_ramp"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{2}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +223,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*ScalingFactorRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRamp")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,47 +232,65 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0xb4, 0x29, 0x5b, 0x1a, 0x84, 0x29, 0xc2, 0x6d, 0x85, 0x37, 0x5a, 0x09, 0x14,
-	0x21, 0x62, 0x53, 0x90, 0x40, 0xf4, 0x04, 0x01, 0x15, 0x21, 0x21, 0x51, 0xcc, 0x89, 0x0a, 0x29,
-	0x5a, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xde, 0x75, 0x69, 0x2f, 0x9c, 0x39, 0x72, 0xe4, 0xd8,
-	0x13, 0x07, 0xce, 0x7c, 0x44, 0xc5, 0xa9, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0x0f, 0xf2, 0x05, 0x68,
-	0xd7, 0xeb, 0x26, 0x29, 0x55, 0x55, 0x89, 0x53, 0x76, 0x66, 0xde, 0xbc, 0x99, 0x79, 0x33, 0x31,
-	0x78, 0xc8, 0x78, 0xc2, 0x38, 0xe5, 0x6e, 0x84, 0x93, 0xc4, 0x4d, 0x19, 0x8b, 0x3b, 0x09, 0x0b,
-	0x49, 0xcc, 0x5d, 0x2e, 0xb0, 0x1f, 0x13, 0xfe, 0x01, 0xa7, 0x63, 0xcf, 0x9e, 0x44, 0x38, 0x69,
-	0xc6, 0x04, 0x33, 0x6f, 0xe9, 0x54, 0x47, 0xa6, 0x3a, 0x32, 0x50, 0x66, 0x3a, 0x23, 0xb8, 0xb3,
-	0xbd, 0xea, 0x13, 0x81, 0x57, 0x97, 0x97, 0x02, 0x05, 0xee, 0xa9, 0x4c, 0xb7, 0x34, 0x4a, 0x9a,
-	0xe5, 0xc5, 0x88, 0x45, 0xac, 0xf4, 0xcb, 0x97, 0xf6, 0xda, 0x11, 0x63, 0x51, 0x4c, 0x5c, 0x65,
-	0xf9, 0x79, 0xdf, 0x0d, 0xf3, 0x0c, 0x0b, 0xca, 0x06, 0x3a, 0x0e, 0x4f, 0xc6, 0x05, 0x4d, 0x08,
-	0x17, 0x38, 0x49, 0x2b, 0x82, 0xb2, 0x88, 0x8b, 0x73, 0xb1, 0xe5, 0xea, 0x36, 0x94, 0x71, 0x22,
-	0xee, 0x63, 0x4e, 0x8e, 0xe3, 0x01, 0xa3, 0xba, 0x00, 0xda, 0x37, 0x00, 0xd8, 0x60, 0x2c, 0xde,
-	0xc0, 0x19, 0x4e, 0xb8, 0xf9, 0x16, 0xcc, 0xa9, 0xf9, 0xfb, 0x84, 0x58, 0x46, 0xcb, 0x68, 0x5f,
-	0xe8, 0x3e, 0xde, 0x2f, 0x60, 0xed, 0x57, 0x01, 0x6f, 0x46, 0x54, 0x6c, 0xe5, 0xbe, 0x13, 0xb0,
-	0x44, 0x0f, 0xa6, 0x7f, 0x3a, 0x3c, 0x7c, 0xe7, 0x8a, 0xdd, 0x94, 0x70, 0xe7, 0x29, 0x09, 0x86,
-	0x05, 0xbc, 0xb4, 0x8b, 0x93, 0x78, 0x0d, 0x55, 0x3c, 0xc8, 0x6b, 0xc8, 0xe7, 0x3a, 0x21, 0x92,
-	0x9d, 0xec, 0x50, 0xa1, 0xd8, 0xa7, 0xfe, 0x8f, 0xbd, 0xe2, 0x41, 0x5e, 0x43, 0x3e, 0xd7, 0x09,
-	0x41, 0x5f, 0x67, 0x40, 0x5d, 0x8e, 0x62, 0xde, 0x06, 0x0d, 0x1c, 0x86, 0x19, 0xe1, 0x5c, 0xcf,
-	0x60, 0x0e, 0x0b, 0xd8, 0x2c, 0xf3, 0x74, 0x00, 0x79, 0x15, 0xc4, 0x6c, 0x82, 0x29, 0x1a, 0xaa,
-	0x76, 0xea, 0xde, 0x14, 0x0d, 0xcd, 0x8f, 0x60, 0x5e, 0x2e, 0xb9, 0x97, 0x2a, 0x45, 0xac, 0xe9,
-	0x96, 0xd1, 0x9e, 0xbf, 0x7b, 0xdf, 0x39, 0xff, 0x15, 0x38, 0x23, 0x3d, 0xbb, 0x37, 0xe4, 0x7c,
-	0xc3, 0x02, 0x5e, 0xd7, 0x9a, 0x4c, 0x5e, 0x98, 0xae, 0x81, 0x3c, 0x90, 0x8e, 0x56, 0xf0, 0x0a,
-	0x2c, 0xf6, 0x73, 0x91, 0x67, 0xa4, 0x84, 0x44, 0x6c, 0x9b, 0x64, 0x03, 0x96, 0x59, 0x75, 0x35,
-	0x0a, 0x1c, 0x16, 0x70, 0xa5, 0x24, 0x3b, 0x0d, 0x85, 0x3c, 0xb3, 0x74, 0xcb, 0x1e, 0x9e, 0x69,
-	0xa7, 0xf9, 0x06, 0x5c, 0x14, 0x4c, 0xe0, 0xb8, 0xc7, 0xb7, 0x70, 0x46, 0xb8, 0x35, 0xa3, 0x66,
-	0x5a, 0x72, 0xf4, 0x81, 0xca, 0xdb, 0x38, 0x6e, 0xfe, 0x09, 0xa3, 0x83, 0xee, 0x8a, 0x6e, 0xfb,
-	0x4a, 0x59, 0x69, 0x3c, 0x19, 0x79, 0xf3, 0xca, 0x7c, 0xad, 0x2c, 0x33, 0x03, 0x4d, 0xd5, 0x40,
-	0x4c, 0xdf, 0xe7, 0x34, 0xa4, 0x62, 0xd7, 0x9a, 0x6d, 0x4d, 0x9f, 0x4d, 0x7e, 0x47, 0x92, 0x7f,
-	0xfb, 0x0d, 0xdb, 0xe7, 0xd8, 0xb9, 0x4c, 0xe0, 0xde, 0x82, 0x2c, 0xf1, 0xa2, 0xaa, 0x60, 0xbe,
-	0x04, 0x4d, 0x1e, 0xe0, 0x98, 0x0e, 0xa2, 0x5e, 0x1f, 0x07, 0x82, 0x65, 0x56, 0xa3, 0x35, 0xdd,
-	0xae, 0x77, 0xdb, 0xba, 0xeb, 0xd6, 0x3f, 0x62, 0x4f, 0xc2, 0x91, 0xb7, 0xa0, 0x1d, 0xeb, 0xca,
-	0x36, 0x37, 0xc1, 0xb5, 0x49, 0xc4, 0x48, 0xf5, 0x39, 0xa5, 0x3a, 0x1a, 0x16, 0xd0, 0xd6, 0xac,
-	0xa7, 0x03, 0x91, 0x77, 0x75, 0x82, 0xb3, 0xd2, 0x7e, 0xed, 0xf2, 0xa7, 0x3d, 0x58, 0xfb, 0xb2,
-	0x07, 0x6b, 0x3f, 0xbe, 0x77, 0x66, 0xe4, 0x56, 0x9e, 0x77, 0x37, 0xf7, 0x0f, 0x6d, 0xe3, 0xe0,
-	0xd0, 0x36, 0xfe, 0x1c, 0xda, 0xc6, 0xe7, 0x23, 0xbb, 0x76, 0x70, 0x64, 0xd7, 0x7e, 0x1e, 0xd9,
-	0xb5, 0xcd, 0x47, 0x63, 0x92, 0xe8, 0x83, 0xeb, 0xc4, 0xd8, 0xe7, 0x95, 0xe1, 0x6e, 0x3f, 0x70,
-	0x77, 0xce, 0xfa, 0x86, 0xf9, 0xb3, 0xea, 0x6f, 0x7d, 0xef, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0x51, 0xea, 0xde, 0xf1, 0x04, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x6e, 0x9d, 0x8c, 0x69, 0x50, 0xb6, 0x49, 0xeb, 0xa6, 0x8a, 0x37, 0x8c, 0xd4,
+	0xc8, 0x2a, 0xcd, 0x2e, 0x09, 0x12, 0x88, 0x4a, 0x48, 0x74, 0x89, 0x8a, 0x90, 0x90, 0x28, 0x13,
+	0xc4, 0x8f, 0x08, 0xc9, 0x1a, 0xef, 0x8e, 0x37, 0x23, 0x76, 0x3d, 0xcb, 0xce, 0xac, 0xa9, 0x2f,
+	0x9c, 0xa9, 0xc4, 0xa1, 0xdc, 0x7a, 0xec, 0x99, 0x33, 0x7f, 0x03, 0x8a, 0x38, 0xf5, 0xc0, 0x01,
+	0x71, 0xd8, 0xa2, 0xe4, 0xc6, 0xd1, 0x7f, 0x01, 0x9a, 0x1f, 0x6b, 0x7b, 0x13, 0xa7, 0x0a, 0xe2,
+	0xe4, 0x99, 0x79, 0xdf, 0xfb, 0xde, 0x9b, 0x6f, 0xbe, 0x7d, 0x32, 0x78, 0x8f, 0xf1, 0x84, 0x71,
+	0xca, 0xbd, 0x08, 0x27, 0x89, 0x97, 0x32, 0x16, 0xef, 0x24, 0x2c, 0x24, 0x31, 0xf7, 0xb8, 0xc0,
+	0xbd, 0x98, 0xf0, 0xef, 0x71, 0x3a, 0xb3, 0xec, 0x4a, 0x84, 0x9b, 0x66, 0x4c, 0x30, 0xfb, 0xae,
+	0x49, 0x75, 0x65, 0xaa, 0x2b, 0x03, 0x3a, 0xd3, 0x9d, 0xc2, 0xdd, 0xe1, 0x6e, 0x8f, 0x08, 0xbc,
+	0xbb, 0x71, 0x2b, 0x50, 0xe0, 0xae, 0xca, 0xf4, 0xf4, 0x46, 0xd3, 0x6c, 0xac, 0x45, 0x2c, 0x62,
+	0xfa, 0x5c, 0xae, 0xcc, 0x69, 0x3b, 0x62, 0x2c, 0x8a, 0x89, 0xa7, 0x76, 0xbd, 0xbc, 0xef, 0x85,
+	0x79, 0x86, 0x05, 0x65, 0x03, 0x13, 0x77, 0xce, 0xc6, 0x05, 0x4d, 0x08, 0x17, 0x38, 0x49, 0x4b,
+	0x02, 0x5d, 0xc4, 0xc3, 0xb9, 0x38, 0xf2, 0x4c, 0x1b, 0x6a, 0x73, 0x26, 0xde, 0xc3, 0x9c, 0x4c,
+	0xe2, 0x01, 0xa3, 0x65, 0x81, 0xed, 0x8a, 0x30, 0x25, 0x20, 0x1c, 0x0d, 0x70, 0x42, 0x83, 0x6e,
+	0x9f, 0x10, 0x8d, 0x83, 0x7f, 0x2c, 0x00, 0xf0, 0x88, 0xb1, 0xf8, 0x11, 0xce, 0x70, 0xc2, 0xed,
+	0x6f, 0xc0, 0x92, 0xd2, 0xa9, 0x4f, 0x48, 0xcb, 0xda, 0xb2, 0x3a, 0xcb, 0xfe, 0x83, 0xe3, 0xc2,
+	0xa9, 0xfd, 0x55, 0x38, 0xdb, 0x11, 0x15, 0x47, 0x79, 0xcf, 0x0d, 0x58, 0x62, 0x04, 0x30, 0x3f,
+	0x3b, 0x3c, 0xfc, 0xd6, 0x13, 0xa3, 0x94, 0x70, 0x77, 0x9f, 0x04, 0xe3, 0xc2, 0x79, 0x7d, 0x84,
+	0x93, 0xf8, 0x3e, 0x2c, 0x79, 0x20, 0x6a, 0xc8, 0xe5, 0x43, 0x42, 0x24, 0x3b, 0x79, 0x4c, 0x85,
+	0x62, 0x5f, 0xf8, 0x7f, 0xec, 0x25, 0x0f, 0x44, 0x0d, 0xb9, 0x94, 0xec, 0x4f, 0x2c, 0x70, 0xb3,
+	0xbc, 0x60, 0x59, 0xbc, 0x9b, 0xaa, 0x7b, 0xb5, 0x16, 0xb7, 0xac, 0x4e, 0x73, 0xef, 0xae, 0x5b,
+	0x79, 0x73, 0xa3, 0x8a, 0xbb, 0xaf, 0x93, 0x0e, 0x74, 0x97, 0x5a, 0x09, 0x7f, 0xfb, 0xb8, 0x70,
+	0xac, 0x71, 0xe1, 0xb4, 0x75, 0xbd, 0x0b, 0x88, 0x21, 0x5a, 0x0b, 0xe7, 0x64, 0xc3, 0x9f, 0x16,
+	0xc1, 0xea, 0x41, 0x80, 0x63, 0x3a, 0x88, 0x1e, 0xe2, 0x40, 0xb0, 0x0c, 0xe1, 0x24, 0xb5, 0xbf,
+	0x02, 0x80, 0x0b, 0x9c, 0x89, 0xae, 0x7c, 0x6d, 0xa5, 0x6f, 0x73, 0x6f, 0xc3, 0xd5, 0x56, 0x70,
+	0x4b, 0x2b, 0xb8, 0x9f, 0x97, 0x56, 0xf0, 0x37, 0xa5, 0x3a, 0xe3, 0xc2, 0x59, 0x35, 0x8a, 0x4e,
+	0x72, 0xe1, 0xd3, 0x97, 0x8e, 0x85, 0x96, 0xd5, 0x81, 0x84, 0xdb, 0x47, 0x60, 0xa9, 0x74, 0x98,
+	0x52, 0xb6, 0xb9, 0x77, 0xeb, 0x1c, 0xef, 0xbe, 0x01, 0xf8, 0xbb, 0x92, 0xf6, 0x9f, 0xc2, 0xb1,
+	0xcb, 0x94, 0x7b, 0x2c, 0xa1, 0x82, 0x24, 0xa9, 0x18, 0x4d, 0x05, 0x2e, 0x63, 0xf0, 0x99, 0x2c,
+	0x35, 0x61, 0xb7, 0x0f, 0xc1, 0x4d, 0x3a, 0xa0, 0x82, 0xe2, 0xb8, 0xcb, 0xf5, 0x05, 0xbb, 0x7d,
+	0x75, 0x43, 0x29, 0xf2, 0x62, 0xa7, 0xee, 0xc3, 0xa9, 0x68, 0x17, 0x00, 0x21, 0x5a, 0x37, 0x91,
+	0x8a, 0x44, 0xdc, 0xfe, 0x12, 0xdc, 0x10, 0x38, 0x8b, 0x88, 0x38, 0x47, 0x5d, 0x57, 0xd4, 0x6f,
+	0x8c, 0x0b, 0x67, 0x53, 0x53, 0xcf, 0xc7, 0x41, 0xb4, 0xa6, 0x03, 0x55, 0x62, 0xf8, 0x5b, 0x03,
+	0xd4, 0xa5, 0xcb, 0xed, 0x7b, 0xa0, 0x81, 0xc3, 0x30, 0x23, 0x9c, 0x1b, 0x7b, 0xdb, 0xe3, 0xc2,
+	0x59, 0xd1, 0x94, 0x26, 0x00, 0x51, 0x09, 0xb1, 0x57, 0xc0, 0x02, 0x0d, 0x95, 0x9e, 0x75, 0xb4,
+	0x40, 0x43, 0xfb, 0x07, 0xd0, 0x94, 0x73, 0xa2, 0x6a, 0xaa, 0x77, 0xdc, 0xcb, 0x0f, 0x12, 0x77,
+	0xfa, 0xa9, 0xf9, 0x77, 0xcc, 0xe3, 0x6e, 0x4e, 0x1e, 0x77, 0x76, 0x48, 0x4d, 0xfc, 0x05, 0xd2,
+	0xe9, 0xd7, 0xf9, 0x19, 0x58, 0xeb, 0xe7, 0x22, 0xcf, 0x88, 0x86, 0x44, 0x6c, 0x48, 0xb2, 0x01,
+	0xcb, 0x5a, 0x75, 0x75, 0x15, 0x67, 0x5c, 0x38, 0xb7, 0x35, 0xd9, 0x3c, 0x14, 0x44, 0xb6, 0x3e,
+	0x96, 0x3d, 0x7c, 0x64, 0x0e, 0xed, 0xaf, 0xc1, 0x6b, 0x82, 0x09, 0xf9, 0x46, 0x47, 0x38, 0x23,
+	0xbc, 0x75, 0xc5, 0x98, 0xc7, 0xcc, 0x38, 0x39, 0x5e, 0x26, 0xcd, 0x7f, 0xc8, 0xe8, 0xc0, 0xbf,
+	0x6d, 0xda, 0xbe, 0x6e, 0xde, 0x61, 0x26, 0x19, 0xa2, 0xa6, 0xda, 0x1e, 0xa8, 0x9d, 0x9d, 0x81,
+	0x15, 0xd5, 0x40, 0x4c, 0xbf, 0xcb, 0x69, 0x48, 0xc5, 0xa8, 0x75, 0x75, 0x6b, 0xf1, 0xd5, 0xe4,
+	0x6f, 0x49, 0xf2, 0x5f, 0x5e, 0x3a, 0x9d, 0x4b, 0x8c, 0x03, 0x99, 0xc0, 0xd1, 0x35, 0x59, 0xe2,
+	0x93, 0xb2, 0x82, 0xfd, 0x29, 0x58, 0xa9, 0x5a, 0xa2, 0xd5, 0x50, 0xce, 0xe9, 0x98, 0xae, 0xb7,
+	0xce, 0x89, 0x5d, 0x85, 0x43, 0x74, 0x8d, 0xcf, 0x5a, 0x47, 0xda, 0xbd, 0x8a, 0x98, 0xaa, 0xbe,
+	0xa4, 0x54, 0x9f, 0xb1, 0xfb, 0x05, 0x40, 0x88, 0xd6, 0x2b, 0x9c, 0x13, 0xed, 0x9f, 0x58, 0xe0,
+	0xc6, 0x90, 0xc5, 0x58, 0xd0, 0x98, 0x8a, 0x51, 0x17, 0x07, 0x41, 0x9e, 0xe4, 0x31, 0x96, 0x5d,
+	0x2f, 0xab, 0x67, 0x78, 0x73, 0xfe, 0xbc, 0xfa, 0x62, 0x92, 0xf3, 0x60, 0x9a, 0xe2, 0xdf, 0x31,
+	0x03, 0xcb, 0xf8, 0x69, 0x3e, 0x31, 0x44, 0xeb, 0xc3, 0x79, 0xd9, 0xf6, 0xcf, 0x16, 0xb8, 0x7e,
+	0xa6, 0xff, 0x0c, 0x27, 0x69, 0x0b, 0xa8, 0x46, 0xde, 0xff, 0x2f, 0x1e, 0x3f, 0x37, 0xf7, 0x7c,
+	0x68, 0x5a, 0xdb, 0x98, 0xab, 0x93, 0xac, 0x03, 0xd1, 0x2a, 0x3f, 0x9b, 0x76, 0x7f, 0xf5, 0xc7,
+	0xe7, 0x4e, 0xed, 0xd9, 0x73, 0xa7, 0xf6, 0xfb, 0xaf, 0x3b, 0x57, 0xa4, 0x6b, 0x3f, 0xf6, 0x0f,
+	0x8f, 0x4f, 0xda, 0xd6, 0x8b, 0x93, 0xb6, 0xf5, 0xf7, 0x49, 0xdb, 0x7a, 0x7a, 0xda, 0xae, 0xbd,
+	0x38, 0x6d, 0xd7, 0xfe, 0x3c, 0x6d, 0xd7, 0x0e, 0x3f, 0x98, 0xb1, 0x8c, 0x69, 0x76, 0x27, 0xc6,
+	0x3d, 0x5e, 0x6e, 0xbc, 0xe1, 0xbb, 0xde, 0xe3, 0x57, 0xfd, 0x4d, 0xe8, 0x5d, 0x55, 0x93, 0xf2,
+	0xed, 0x7f, 0x07, 0x00, 0xe2, 0x28, 0x0d, 0xa2, 0x54, 0x08, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -199,6 +313,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -222,6 +348,81 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA3 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j2 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA5 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j4 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -242,6 +443,30 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorRamp != nil {
+		{
+			size, err := m.ScalingFactorRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VolatilityAccumulator != nil {
+		{
+			size, err := m.VolatilityAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorGovernor) > 0 {
 		i -= len(m.ScalingFactorGovernor)
 		copy(dAtA[i:], m.ScalingFactorGovernor)
@@ -250,20 +475,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactor) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactor)*10)
-		var j1 int
+		dAtA11 := make([]byte, len(m.ScalingFactor)*10)
+		var j10 int
 		for _, num := range m.ScalingFactor {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j10++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -344,6 +569,37 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *ScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
 	return n
 }
 
@@ -385,6 +641,14 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.VolatilityAccumulator != nil {
+		l = m.VolatilityAccumulator.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.ScalingFactorRamp != nil {
+		l = m.ScalingFactorRamp.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -491,6 +755,310 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &types.DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -719,7 +1287,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types2.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -832,6 +1400,78 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolatilityAccumulator == nil {
+				m.VolatilityAccumulator = &types.VolatilityAccumulator{}
+			}
+			if err := m.VolatilityAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRamp == nil {
+				m.ScalingFactorRamp = &ScalingFactorRamp{}
+			}
+			if err := m.ScalingFactorRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
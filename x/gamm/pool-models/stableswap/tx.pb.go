@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,9 @@ type MsgCreateStableswapPool struct {
 	PoolParams           *PoolParams                              `protobuf:"bytes,2,opt,name=pool_params,json=poolParams,proto3" json:"pool_params,omitempty" yaml:"pool_params"`
 	InitialPoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=initial_pool_liquidity,json=initialPoolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_pool_liquidity"`
 	FuturePoolGovernor   string                                   `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// scaling_factor_governor is the address that can adjust and ramp the
+	// scaling factors of the pool. If left blank, they can't be changed.
+	ScalingFactorGovernor string `protobuf:"bytes,5,opt,name=scaling_factor_governor,json=scalingFactorGovernor,proto3" json:"scaling_factor_governor,omitempty" yaml:"scaling_factor_governor"`
 }
 
 func (m *MsgCreateStableswapPool) Reset()         { *m = MsgCreateStableswapPool{} }
@@ -98,6 +105,13 @@ func (m *MsgCreateStableswapPool) GetFuturePoolGovernor() string {
 	return ""
 }
 
+func (m *MsgCreateStableswapPool) GetScalingFactorGovernor() string {
+	if m != nil {
+		return m.ScalingFactorGovernor
+	}
+	return ""
+}
+
 type MsgCreateStableswapPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
@@ -244,11 +258,232 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// MsgStableSwapStartScalingFactorRamp starts changing the scaling factors of a
+// pool linearly towards target_scaling_factors, over duration from start_time.
+type MsgStableSwapStartScalingFactorRamp struct {
+	// Sender must be the pool's scaling_factor_governor in order for the tx to
+	// succeed
+	Sender               string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID               uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TargetScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	// If left blank, the ramp starts at the current block time.
+	StartTime time.Time     `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) Reset()         { *m = MsgStableSwapStartScalingFactorRamp{} }
+func (m *MsgStableSwapStartScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapStartScalingFactorRamp) ProtoMessage()    {}
+func (*MsgStableSwapStartScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapStartScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapStartScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapStartScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapStartScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapStartScalingFactorRamp.Merge(m, src)
+}
+func (m *MsgStableSwapStartScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapStartScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapStartScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapStartScalingFactorRamp proto.InternalMessageInfo
+
+func (m *MsgStableSwapStartScalingFactorRamp) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapStartScalingFactorRampResponse struct {
+}
+
+func (m *MsgStableSwapStartScalingFactorRampResponse) Reset() {
+	*m = MsgStableSwapStartScalingFactorRampResponse{}
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapStartScalingFactorRampResponse) ProtoMessage() {}
+func (*MsgStableSwapStartScalingFactorRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapStartScalingFactorRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapStartScalingFactorRampResponse.Merge(m, src)
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapStartScalingFactorRampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapStartScalingFactorRampResponse proto.InternalMessageInfo
+
+// MsgStableSwapCancelScalingFactorRamp stops the scaling factor ramp of a
+// pool, keeping the scaling factors it has reached.
+type MsgStableSwapCancelScalingFactorRamp struct {
+	// Sender must be the pool's scaling_factor_governor in order for the tx to
+	// succeed
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) Reset()         { *m = MsgStableSwapCancelScalingFactorRamp{} }
+func (m *MsgStableSwapCancelScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapCancelScalingFactorRamp) ProtoMessage()    {}
+func (*MsgStableSwapCancelScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{6}
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapCancelScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapCancelScalingFactorRamp.Merge(m, src)
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapCancelScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapCancelScalingFactorRamp proto.InternalMessageInfo
+
+func (m *MsgStableSwapCancelScalingFactorRamp) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+type MsgStableSwapCancelScalingFactorRampResponse struct {
+}
+
+func (m *MsgStableSwapCancelScalingFactorRampResponse) Reset() {
+	*m = MsgStableSwapCancelScalingFactorRampResponse{}
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapCancelScalingFactorRampResponse) ProtoMessage() {}
+func (*MsgStableSwapCancelScalingFactorRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{7}
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapCancelScalingFactorRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapCancelScalingFactorRampResponse.Merge(m, src)
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapCancelScalingFactorRampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapCancelScalingFactorRampResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapStartScalingFactorRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapStartScalingFactorRamp")
+	proto.RegisterType((*MsgStableSwapStartScalingFactorRampResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapStartScalingFactorRampResponse")
+	proto.RegisterType((*MsgStableSwapCancelScalingFactorRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapCancelScalingFactorRamp")
+	proto.RegisterType((*MsgStableSwapCancelScalingFactorRampResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapCancelScalingFactorRampResponse")
 }
 
 func init() {
@@ -256,44 +491,60 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6f, 0xd3, 0x3e,
-	0x1c, 0xc7, 0x9b, 0xb6, 0xea, 0x5f, 0x7f, 0x57, 0x80, 0x88, 0xaa, 0x51, 0x8a, 0x94, 0x94, 0x70,
-	0xe9, 0x80, 0xc6, 0xac, 0x48, 0x20, 0x38, 0x41, 0x8a, 0x86, 0x26, 0xa8, 0xb4, 0xa5, 0xe2, 0xb2,
-	0x4b, 0xe5, 0x34, 0x5e, 0x30, 0x24, 0x71, 0x88, 0xdd, 0x6e, 0x3d, 0x72, 0xe7, 0xc0, 0xcb, 0x40,
-	0xbc, 0x90, 0x69, 0xc7, 0x1d, 0x39, 0x05, 0xd4, 0xbe, 0x83, 0xde, 0xb8, 0x21, 0xc7, 0xe9, 0xc3,
-	0xa4, 0x75, 0x5b, 0xa5, 0x9d, 0xe2, 0xfc, 0xf2, 0xf1, 0xf7, 0xfb, 0x7b, 0x70, 0x0c, 0x1e, 0x53,
-	0x16, 0x50, 0x46, 0x18, 0xf4, 0x50, 0x10, 0xc0, 0x88, 0x52, 0xbf, 0x19, 0x50, 0x17, 0xfb, 0x0c,
-	0x32, 0x8e, 0x1c, 0x1f, 0xb3, 0x43, 0x14, 0x41, 0x7e, 0x64, 0x46, 0x31, 0xe5, 0x54, 0x7d, 0x98,
-	0xd1, 0xa6, 0xa0, 0x4d, 0x41, 0x4b, 0xd8, 0x5c, 0xc0, 0xe6, 0x70, 0xcb, 0xc1, 0x1c, 0x6d, 0xd5,
-	0xb4, 0x7e, 0x0a, 0x43, 0x07, 0x31, 0x0c, 0xb3, 0x20, 0xec, 0x53, 0x12, 0x4a, 0xad, 0x5a, 0xc5,
-	0xa3, 0x1e, 0x4d, 0x97, 0x50, 0xac, 0xb2, 0xe8, 0x8b, 0xab, 0xe4, 0xb3, 0x58, 0xf6, 0x04, 0x21,
-	0xb7, 0x1a, 0xdf, 0x0a, 0xe0, 0x4e, 0x87, 0x79, 0xed, 0x18, 0x23, 0x8e, 0xbb, 0x73, 0x64, 0x97,
-	0x52, 0x5f, 0xdd, 0x04, 0x25, 0x86, 0x43, 0x17, 0xc7, 0x55, 0xa5, 0xae, 0x34, 0xfe, 0xb7, 0x6e,
-	0x4f, 0x13, 0xfd, 0xc6, 0x08, 0x05, 0xfe, 0x4b, 0x43, 0xc6, 0x0d, 0x3b, 0x03, 0x54, 0x0a, 0xca,
-	0x42, 0xb4, 0x17, 0xa1, 0x18, 0x05, 0xac, 0x9a, 0xaf, 0x2b, 0x8d, 0x72, 0xeb, 0x99, 0x79, 0xf5,
-	0xca, 0x4d, 0xe1, 0xb8, 0x9b, 0xee, 0xb6, 0x36, 0xa6, 0x89, 0xae, 0x4a, 0x9f, 0x25, 0x51, 0xc3,
-	0x06, 0xd1, 0x9c, 0x51, 0xbf, 0x2a, 0x60, 0x83, 0x84, 0x84, 0x13, 0xe4, 0xa7, 0xe5, 0xf4, 0x7c,
-	0xf2, 0x65, 0x40, 0x5c, 0xc2, 0x47, 0xd5, 0x42, 0xbd, 0xd0, 0x28, 0xb7, 0xee, 0x9a, 0xb2, 0x95,
-	0xa6, 0x68, 0xe5, 0xdc, 0xa5, 0x4d, 0x49, 0x68, 0x3d, 0x39, 0x49, 0xf4, 0xdc, 0xcf, 0xdf, 0x7a,
-	0xc3, 0x23, 0xfc, 0xe3, 0xc0, 0x31, 0xfb, 0x34, 0x80, 0x59, 0xdf, 0xe5, 0xa3, 0xc9, 0xdc, 0xcf,
-	0x90, 0x8f, 0x22, 0xcc, 0xd2, 0x0d, 0xcc, 0xae, 0x64, 0x56, 0x22, 0xc9, 0xf7, 0x33, 0x23, 0x75,
-	0x0f, 0x54, 0x0e, 0x06, 0x7c, 0x10, 0x63, 0x99, 0x81, 0x47, 0x87, 0x38, 0x0e, 0x69, 0x5c, 0x2d,
-	0xa6, 0xdd, 0xd2, 0xa7, 0x89, 0x7e, 0x4f, 0x56, 0x71, 0x1e, 0x65, 0xd8, 0xaa, 0x0c, 0x0b, 0xcd,
-	0xb7, 0xb3, 0xe0, 0x36, 0xd0, 0x57, 0x4c, 0xc3, 0xc6, 0x2c, 0xa2, 0x21, 0xc3, 0xea, 0x03, 0xf0,
-	0x5f, 0x2a, 0x44, 0xdc, 0x74, 0x2c, 0x45, 0x0b, 0x8c, 0x13, 0xbd, 0x24, 0x90, 0x9d, 0x37, 0x76,
-	0x49, 0x7c, 0xda, 0x71, 0x8d, 0x63, 0x05, 0xdc, 0xef, 0x30, 0x4f, 0x4a, 0x74, 0x0f, 0x51, 0xf4,
-	0xda, 0xfd, 0x34, 0x60, 0xbc, 0xdb, 0x47, 0x3e, 0x09, 0xbd, 0x6d, 0xd4, 0xe7, 0x34, 0x66, 0xeb,
-	0x0c, 0x78, 0xc9, 0x35, 0xbf, 0xca, 0x55, 0xdd, 0x03, 0xb7, 0x98, 0x74, 0xe8, 0x1d, 0x48, 0x8b,
-	0x74, 0x18, 0x45, 0xab, 0x21, 0x3a, 0x3e, 0x4d, 0xf4, 0x7a, 0x26, 0xbe, 0x38, 0x8a, 0x67, 0x79,
-	0xc3, 0xbe, 0xc9, 0xce, 0xa4, 0x68, 0x3c, 0x02, 0x9b, 0x97, 0xd6, 0x31, 0x6b, 0x4d, 0xeb, 0x6f,
-	0x1e, 0x14, 0x3a, 0xcc, 0x53, 0x7f, 0x28, 0xa0, 0x72, 0xee, 0x89, 0x6e, 0xaf, 0x73, 0x22, 0x57,
-	0x0c, 0xa2, 0xf6, 0xee, 0x1a, 0x44, 0xe6, 0xd3, 0x3c, 0x56, 0x80, 0x76, 0xc9, 0x94, 0x3a, 0x6b,
-	0xfa, 0x5d, 0x2c, 0x57, 0xfb, 0x70, 0xad, 0x72, 0xb3, 0x42, 0xac, 0xfd, 0x93, 0xb1, 0xa6, 0x9c,
-	0x8e, 0x35, 0xe5, 0xcf, 0x58, 0x53, 0xbe, 0x4f, 0xb4, 0xdc, 0xe9, 0x44, 0xcb, 0xfd, 0x9a, 0x68,
-	0xb9, 0xfd, 0x57, 0x4b, 0xbf, 0x59, 0x66, 0xdd, 0xf4, 0x91, 0xc3, 0x66, 0x2f, 0x70, 0xf8, 0x1c,
-	0x1e, 0x5d, 0x74, 0x75, 0x39, 0xa5, 0xf4, 0xae, 0x7a, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0x03, 0x33, 0x73, 0x78, 0x05, 0x00, 0x00,
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x37, 0x25, 0xb0, 0x13, 0xc1, 0x6a, 0xad, 0xd0, 0x0d, 0x41, 0x6b, 0xa7, 0xb3, 0x1c,
+	0xb2, 0x6c, 0x63, 0xd3, 0x20, 0x81, 0xe0, 0x04, 0xce, 0x6a, 0xd1, 0x0a, 0x22, 0xba, 0x0e, 0xa8,
+	0x55, 0x2f, 0xd1, 0x24, 0x9e, 0xba, 0x06, 0xdb, 0x63, 0x3c, 0x93, 0xb4, 0x39, 0xf2, 0x0d, 0x2a,
+	0x4e, 0x88, 0x4f, 0x80, 0xf8, 0x20, 0x55, 0x0f, 0x48, 0xf4, 0xc8, 0xc9, 0x45, 0xe9, 0x05, 0x71,
+	0xcc, 0x27, 0x40, 0x33, 0xfe, 0x93, 0xa4, 0x4a, 0x9a, 0xb4, 0xca, 0x9e, 0x6a, 0xbf, 0xf7, 0x7b,
+	0xbf, 0xdf, 0xbc, 0xf7, 0x1b, 0xbf, 0x06, 0x6c, 0x13, 0xea, 0x11, 0xea, 0x50, 0xdd, 0x46, 0x9e,
+	0xa7, 0x07, 0x84, 0xb8, 0x75, 0x8f, 0x58, 0xd8, 0xa5, 0x3a, 0x65, 0xa8, 0xeb, 0x62, 0x7a, 0x8c,
+	0x02, 0x9d, 0x9d, 0x68, 0x41, 0x48, 0x18, 0x91, 0x3f, 0x4c, 0xd0, 0x1a, 0x47, 0x6b, 0x1c, 0x1d,
+	0x83, 0xb5, 0x09, 0x58, 0x1b, 0xec, 0x74, 0x31, 0x43, 0x3b, 0x15, 0xa5, 0x27, 0xc0, 0x7a, 0x17,
+	0x51, 0xac, 0x27, 0x41, 0xbd, 0x47, 0x1c, 0x3f, 0xe6, 0xaa, 0x94, 0x6c, 0x62, 0x13, 0xf1, 0xa8,
+	0xf3, 0xa7, 0x24, 0xaa, 0xd8, 0x84, 0xd8, 0x2e, 0xd6, 0xc5, 0x5b, 0xb7, 0x7f, 0xa8, 0x5b, 0xfd,
+	0x10, 0x31, 0x87, 0xa4, 0x55, 0xea, 0xf5, 0x3c, 0x73, 0x3c, 0x4c, 0x19, 0xf2, 0x82, 0x04, 0xf0,
+	0xd9, 0x2a, 0x0d, 0x4d, 0x1e, 0x3b, 0x1c, 0x11, 0x97, 0xc2, 0x7f, 0xf3, 0xe0, 0x51, 0x8b, 0xda,
+	0xcd, 0x10, 0x23, 0x86, 0xdb, 0x19, 0x64, 0x97, 0x10, 0x57, 0x7e, 0x0a, 0x0a, 0x14, 0xfb, 0x16,
+	0x0e, 0xcb, 0x52, 0x55, 0xaa, 0xdd, 0x37, 0x1e, 0x8e, 0x23, 0xf5, 0xed, 0x21, 0xf2, 0xdc, 0xcf,
+	0x61, 0x1c, 0x87, 0x66, 0x02, 0x90, 0x09, 0x28, 0x72, 0xd2, 0x4e, 0x80, 0x42, 0xe4, 0xd1, 0xf2,
+	0xbd, 0xaa, 0x54, 0x2b, 0x36, 0x3e, 0xd1, 0x56, 0x1f, 0x9d, 0xc6, 0x15, 0x77, 0x45, 0xb5, 0xb1,
+	0x39, 0x8e, 0x54, 0x39, 0xd6, 0x99, 0x22, 0x85, 0x26, 0x08, 0x32, 0x8c, 0xfc, 0xb3, 0x04, 0x36,
+	0x1d, 0xdf, 0x61, 0x0e, 0x72, 0x45, 0x3b, 0x1d, 0xd7, 0xf9, 0xa9, 0xef, 0x58, 0x0e, 0x1b, 0x96,
+	0xf3, 0xd5, 0x7c, 0xad, 0xd8, 0x78, 0x4f, 0x8b, 0xbd, 0xd0, 0xb8, 0x17, 0x99, 0x4a, 0x93, 0x38,
+	0xbe, 0xf1, 0xd1, 0x79, 0xa4, 0xe6, 0xfe, 0xb8, 0x54, 0x6b, 0xb6, 0xc3, 0x8e, 0xfa, 0x5d, 0xad,
+	0x47, 0x3c, 0x3d, 0x31, 0x2e, 0xfe, 0x53, 0xa7, 0xd6, 0x8f, 0x3a, 0x1b, 0x06, 0x98, 0x8a, 0x02,
+	0x6a, 0x96, 0x12, 0x29, 0x7e, 0xc8, 0x6f, 0x52, 0x21, 0xf9, 0x15, 0x28, 0x1d, 0xf6, 0x59, 0x3f,
+	0xc4, 0xf1, 0x09, 0x6c, 0x32, 0xc0, 0xa1, 0x4f, 0xc2, 0xf2, 0x86, 0x98, 0x96, 0x3a, 0x8e, 0xd4,
+	0xf7, 0xe3, 0x2e, 0xe6, 0xa1, 0xa0, 0x29, 0xc7, 0x61, 0xce, 0xf9, 0x55, 0x12, 0x94, 0x0f, 0xc0,
+	0x23, 0xda, 0x43, 0xae, 0xe3, 0xdb, 0x9d, 0x43, 0xd4, 0x63, 0x24, 0x9c, 0xb0, 0xbe, 0x21, 0x58,
+	0xe1, 0x38, 0x52, 0x95, 0xc4, 0x83, 0xf9, 0x40, 0x68, 0xbe, 0x9b, 0x64, 0x5e, 0x88, 0x44, 0xca,
+	0x0d, 0x5f, 0x00, 0x75, 0x81, 0xd3, 0x26, 0xa6, 0x01, 0xf1, 0x29, 0x96, 0x9f, 0x80, 0x37, 0xc5,
+	0x21, 0x1d, 0x4b, 0x58, 0xbe, 0x61, 0x80, 0x51, 0xa4, 0x16, 0x38, 0xe4, 0xe5, 0x73, 0xb3, 0xc0,
+	0x53, 0x2f, 0x2d, 0x78, 0x26, 0x81, 0xad, 0x16, 0xb5, 0x63, 0x8a, 0xf6, 0x31, 0x0a, 0xbe, 0xb4,
+	0x7e, 0xe8, 0x53, 0xd6, 0x9e, 0x16, 0xa5, 0xb7, 0xb9, 0x3c, 0x53, 0xaa, 0xf7, 0x16, 0xa9, 0xca,
+	0xaf, 0xc0, 0x83, 0xd9, 0x86, 0xa9, 0x30, 0x7a, 0xc3, 0xa8, 0x71, 0x37, 0xc7, 0x91, 0x5a, 0x4d,
+	0xc8, 0x27, 0xd7, 0x7c, 0x16, 0x0f, 0xcd, 0x77, 0x66, 0xe6, 0x42, 0xe1, 0x33, 0xf0, 0x74, 0x69,
+	0x1f, 0xe9, 0x68, 0xe0, 0x2f, 0x79, 0xf0, 0x64, 0x06, 0xdd, 0x66, 0x28, 0x9c, 0x05, 0x9b, 0xc8,
+	0x0b, 0xd6, 0xde, 0xf7, 0x1e, 0xd8, 0x64, 0x28, 0xb4, 0x31, 0xeb, 0xcc, 0x6f, 0x7f, 0x6b, 0x1c,
+	0xa9, 0x8f, 0x63, 0xfe, 0xf9, 0x38, 0x68, 0x96, 0xe2, 0xc4, 0x35, 0x83, 0xf6, 0x01, 0xa0, 0xbc,
+	0x85, 0x0e, 0xdf, 0x26, 0xe2, 0xce, 0x16, 0x1b, 0x15, 0x2d, 0x5e, 0x35, 0x5a, 0xba, 0x6a, 0xb4,
+	0xef, 0xd2, 0x55, 0x63, 0x3c, 0x4e, 0xe6, 0xfc, 0x30, 0x9b, 0x73, 0x52, 0x0b, 0x4f, 0x2f, 0x55,
+	0xc9, 0xbc, 0x2f, 0x02, 0x1c, 0x2e, 0x1f, 0x81, 0xb7, 0xd2, 0x0d, 0x26, 0x6e, 0x2d, 0xff, 0x18,
+	0xaf, 0xf3, 0x3e, 0x4f, 0x00, 0xc6, 0x0e, 0xa7, 0xfd, 0x2f, 0x52, 0xe5, 0xb4, 0x64, 0x9b, 0x78,
+	0x0e, 0xc3, 0x5e, 0xc0, 0x86, 0xe3, 0x48, 0x7d, 0x10, 0x8b, 0xa5, 0x39, 0xf8, 0x2b, 0x97, 0xca,
+	0xd8, 0x61, 0x1d, 0x3c, 0x5b, 0xc1, 0x93, 0xcc, 0xc3, 0x01, 0xf8, 0x60, 0x06, 0xde, 0x44, 0x7e,
+	0x0f, 0xbb, 0xaf, 0xdd, 0x43, 0xa8, 0x81, 0xed, 0x55, 0x74, 0xd3, 0x73, 0x36, 0x7e, 0x2b, 0x80,
+	0x7c, 0x8b, 0xda, 0xf2, 0xef, 0x12, 0x28, 0xcd, 0xdd, 0xcc, 0xcd, 0xdb, 0x6c, 0xd6, 0x05, 0x1f,
+	0x7d, 0xe5, 0xeb, 0x35, 0x90, 0x64, 0x9b, 0xe3, 0x4c, 0x02, 0xca, 0x92, 0x8d, 0xd0, 0xba, 0xa5,
+	0xde, 0xcd, 0x74, 0x95, 0xef, 0xd7, 0x4a, 0x97, 0x35, 0xf2, 0xa7, 0x04, 0xaa, 0x4b, 0x3f, 0xf2,
+	0x6f, 0xef, 0xac, 0x3d, 0x9f, 0xb0, 0xb2, 0xb7, 0x66, 0xc2, 0xac, 0x9d, 0xbf, 0x24, 0xb0, 0xb5,
+	0xfc, 0xc2, 0xef, 0xde, 0x59, 0x7e, 0x01, 0x63, 0x65, 0x7f, 0xdd, 0x8c, 0x69, 0x47, 0xc6, 0xc1,
+	0xf9, 0x48, 0x91, 0x2e, 0x46, 0x8a, 0xf4, 0xcf, 0x48, 0x91, 0x4e, 0xaf, 0x94, 0xdc, 0xc5, 0x95,
+	0x92, 0xfb, 0xfb, 0x4a, 0xc9, 0x1d, 0x7c, 0x31, 0xf5, 0xff, 0x3c, 0x51, 0xaf, 0xbb, 0xa8, 0x4b,
+	0xd3, 0x17, 0x7d, 0xf0, 0xa9, 0x7e, 0x72, 0xd3, 0x6f, 0xa4, 0x6e, 0x41, 0xec, 0xa7, 0x8f, 0xff,
+	0x1f, 0x00, 0xb2, 0xee, 0xc9, 0xad, 0x22, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -310,6 +561,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapStartScalingFactorRamp(ctx context.Context, in *MsgStableSwapStartScalingFactorRamp, opts ...grpc.CallOption) (*MsgStableSwapStartScalingFactorRampResponse, error)
+	StableSwapCancelScalingFactorRamp(ctx context.Context, in *MsgStableSwapCancelScalingFactorRamp, opts ...grpc.CallOption) (*MsgStableSwapCancelScalingFactorRampResponse, error)
 }
 
 type msgClient struct {
@@ -338,10 +591,30 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapStartScalingFactorRamp(ctx context.Context, in *MsgStableSwapStartScalingFactorRamp, opts ...grpc.CallOption) (*MsgStableSwapStartScalingFactorRampResponse, error) {
+	out := new(MsgStableSwapStartScalingFactorRampResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapStartScalingFactorRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapCancelScalingFactorRamp(ctx context.Context, in *MsgStableSwapCancelScalingFactorRamp, opts ...grpc.CallOption) (*MsgStableSwapCancelScalingFactorRampResponse, error) {
+	out := new(MsgStableSwapCancelScalingFactorRampResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapCancelScalingFactorRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapStartScalingFactorRamp(context.Context, *MsgStableSwapStartScalingFactorRamp) (*MsgStableSwapStartScalingFactorRampResponse, error)
+	StableSwapCancelScalingFactorRamp(context.Context, *MsgStableSwapCancelScalingFactorRamp) (*MsgStableSwapCancelScalingFactorRampResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -354,6 +627,12 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapStartScalingFactorRamp(ctx context.Context, req *MsgStableSwapStartScalingFactorRamp) (*MsgStableSwapStartScalingFactorRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapStartScalingFactorRamp not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapCancelScalingFactorRamp(ctx context.Context, req *MsgStableSwapCancelScalingFactorRamp) (*MsgStableSwapCancelScalingFactorRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapCancelScalingFactorRamp not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -395,6 +674,42 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapStartScalingFactorRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapStartScalingFactorRamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapStartScalingFactorRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapStartScalingFactorRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapStartScalingFactorRamp(ctx, req.(*MsgStableSwapStartScalingFactorRamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapCancelScalingFactorRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapCancelScalingFactorRamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapCancelScalingFactorRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapCancelScalingFactorRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapCancelScalingFactorRamp(ctx, req.(*MsgStableSwapCancelScalingFactorRamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -407,6 +722,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapStartScalingFactorRamp",
+			Handler:    _Msg_StableSwapStartScalingFactorRamp_Handler,
+		},
+		{
+			MethodName: "StableSwapCancelScalingFactorRamp",
+			Handler:    _Msg_StableSwapCancelScalingFactorRamp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -432,6 +755,13 @@ func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactorGovernor) > 0 {
+		i -= len(m.ScalingFactorGovernor)
+		copy(dAtA[i:], m.ScalingFactorGovernor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScalingFactorGovernor)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FuturePoolGovernor) > 0 {
 		i -= len(m.FuturePoolGovernor)
 		copy(dAtA[i:], m.FuturePoolGovernor)
@@ -579,57 +909,211 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStableSwapStartScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateStableswapPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStableSwapStartScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapStartScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if len(m.InitialPoolLiquidity) > 0 {
-		for _, e := range m.InitialPoolLiquidity {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA7 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j6 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+func (m *MsgStableSwapStartScalingFactorRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
+func (m *MsgStableSwapStartScalingFactorRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapStartScalingFactorRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapCancelScalingFactorRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapCancelScalingFactorRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapCancelScalingFactorRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStableswapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialPoolLiquidity) > 0 {
+		for _, e := range m.InitialPoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScalingFactorGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -661,6 +1145,67 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapStartScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapStartScalingFactorRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapCancelScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgStableSwapCancelScalingFactorRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -830,6 +1375,38 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			}
 			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1147,6 +1724,450 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapStartScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapStartScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapStartScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapStartScalingFactorRampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapStartScalingFactorRampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapStartScalingFactorRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapCancelScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapCancelScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapCancelScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapCancelScalingFactorRampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapCancelScalingFactorRampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapCancelScalingFactorRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
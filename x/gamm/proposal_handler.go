@@ -0,0 +1,26 @@
+package gamm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func NewGammProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPoolPauseStatusProposal:
+			return handleSetPoolPauseStatusProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetPoolPauseStatusProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetPoolPauseStatusProposal) error {
+	return k.HandleSetPoolPauseStatusProposal(ctx, p)
+}
@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gamm type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyNextGlobalPoolNumber):
+			var poolNumberA, poolNumberB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &poolNumberA)
+			cdc.MustUnmarshal(kvB.Value, &poolNumberB)
+			return fmt.Sprintf("%v\n%v", poolNumberA.Value, poolNumberB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPools):
+			var poolA, poolB types.PoolI
+			if err := cdc.UnmarshalInterface(kvA.Value, &poolA); err != nil {
+				panic(err)
+			}
+			if err := cdc.UnmarshalInterface(kvB.Value, &poolB); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyTotalLiquidity),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixPoolVolume),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixPoolFeesCollected),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTakerFeesCollected):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPositions):
+			var positionA, positionB types.Position
+			cdc.MustUnmarshal(kvA.Value, &positionA)
+			cdc.MustUnmarshal(kvB.Value, &positionB)
+			return fmt.Sprintf("%v\n%v", positionA, positionB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTicks):
+			var tickA, tickB types.Tick
+			cdc.MustUnmarshal(kvA.Value, &tickA)
+			cdc.MustUnmarshal(kvB.Value, &tickB)
+			return fmt.Sprintf("%v\n%v", tickA, tickB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPoolPauseStatus):
+			var statusA, statusB types.PoolPauseStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", statusA, statusB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCircuitBreakerReferences):
+			var referenceA, referenceB types.CircuitBreakerReference
+			cdc.MustUnmarshal(kvA.Value, &referenceA)
+			cdc.MustUnmarshal(kvB.Value, &referenceB)
+			return fmt.Sprintf("%v\n%v", referenceA, referenceB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBatchSwapOrders):
+			var orderA, orderB types.BatchSwapOrder
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyNextBatchSwapOrderId):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDenomPools),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixFlashSwapLocks):
+			// the keys of the indexes hold all their data
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid gamm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// Simulation parameter constants.
+const (
+	ParamsPoolCreationFee = "pool_creation_fee"
+	ParamsTakerFee        = "taker_fee"
+)
+
+// SimDenoms are the denoms, besides the bond denom, that the simulation
+// accounts are funded with at genesis, so that they have assets to create
+// pools with.
+var SimDenoms = []string{"uatom", "uion", "uusdc", "uweth"}
+
+// RandomizedGenState generates a random GenesisState for gamm, with no pools.
+// The bank genesis state is updated to fund the simulation accounts with
+// SimDenoms, so it must be generated before.
+func RandomizedGenState(simState *module.SimulationState) {
+	var poolCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ParamsPoolCreationFee, &poolCreationFee, simState.Rand,
+		func(r *rand.Rand) { poolCreationFee = GenParamsPoolCreationFee(r) },
+	)
+
+	var takerFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ParamsTakerFee, &takerFee, simState.Rand,
+		func(r *rand.Rand) { takerFee = GenParamsTakerFee(r) },
+	)
+
+	gammGenesis := types.DefaultGenesis()
+	gammGenesis.Params.PoolCreationFee = poolCreationFee
+	gammGenesis.Params.TakerFee = takerFee
+
+	bz, err := json.MarshalIndent(&gammGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gamm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gammGenesis)
+
+	fundSimAccounts(simState)
+}
+
+// GenParamsPoolCreationFee returns a random pool creation fee of at most 10
+// OSMO, so that the simulation accounts can afford creating pools.
+func GenParamsPoolCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, int64(simtypes.RandIntBetween(r, 1, 10_000_000))))
+}
+
+// GenParamsTakerFee returns a random taker fee of at most 1%.
+func GenParamsTakerFee(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2))
+}
+
+// fundSimAccounts adds random balances of SimDenoms to the simulation accounts
+// in the bank genesis state, which only funds them with the bond denom.
+func fundSimAccounts(simState *module.SimulationState) {
+	bankGenesisBz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok {
+		panic("bank genesis state is missing")
+	}
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankGenesisBz, &bankGenesis)
+
+	simAccounts := map[string]bool{}
+	for _, acc := range simState.Accounts {
+		simAccounts[acc.Address.String()] = true
+	}
+
+	for i, balance := range bankGenesis.Balances {
+		if !simAccounts[balance.Address] {
+			continue
+		}
+
+		funds := sdk.Coins{}
+		for _, denom := range SimDenoms {
+			amount := simtypes.RandIntBetween(simState.Rand, 1_000_000, 1_000_000_000_000)
+			funds = funds.Add(sdk.NewInt64Coin(denom, int64(amount)))
+		}
+		bankGenesis.Balances[i].Coins = balance.Coins.Add(funds...)
+		bankGenesis.Supply = bankGenesis.Supply.Add(funds...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
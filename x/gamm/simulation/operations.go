@@ -0,0 +1,584 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	osmo_simulation "github.com/osmosis-labs/osmosis/v7/x/simulation"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Simulation operation weights constants.
+const (
+	DefaultWeightMsgCreateBalancerPool     int = 10
+	DefaultWeightMsgCreateStableswapPool   int = 10
+	DefaultWeightMsgJoinPool               int = 50
+	DefaultWeightMsgExitPool               int = 30
+	DefaultWeightMsgJoinSwapExternAmountIn int = 30
+	DefaultWeightMsgExitSwapShareAmountIn  int = 20
+	DefaultWeightMsgSwapExactAmountIn      int = 100
+	DefaultWeightMsgSwapExactAmountOut     int = 100
+
+	OpWeightMsgCreateBalancerPool     = "op_weight_msg_create_balancer_pool"
+	OpWeightMsgCreateStableswapPool   = "op_weight_msg_create_stableswap_pool"
+	OpWeightMsgJoinPool               = "op_weight_msg_join_pool"
+	OpWeightMsgExitPool               = "op_weight_msg_exit_pool"
+	OpWeightMsgJoinSwapExternAmountIn = "op_weight_msg_join_swap_extern_amount_in"
+	OpWeightMsgExitSwapShareAmountIn  = "op_weight_msg_exit_swap_share_amount_in"
+	OpWeightMsgSwapExactAmountIn      = "op_weight_msg_swap_exact_amount_in"
+	OpWeightMsgSwapExactAmountOut     = "op_weight_msg_swap_exact_amount_out"
+)
+
+// maxSwapHops is the largest number of pools a simulated swap is routed through.
+const maxSwapHops = 3
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak stakingtypes.AccountKeeper,
+	bk stakingtypes.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateBalancerPool     int
+		weightMsgCreateStableswapPool   int
+		weightMsgJoinPool               int
+		weightMsgExitPool               int
+		weightMsgJoinSwapExternAmountIn int
+		weightMsgExitSwapShareAmountIn  int
+		weightMsgSwapExactAmountIn      int
+		weightMsgSwapExactAmountOut     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateBalancerPool, &weightMsgCreateBalancerPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateBalancerPool = DefaultWeightMsgCreateBalancerPool
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateStableswapPool, &weightMsgCreateStableswapPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateStableswapPool = DefaultWeightMsgCreateStableswapPool
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgJoinPool, &weightMsgJoinPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgJoinPool = DefaultWeightMsgJoinPool
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExitPool, &weightMsgExitPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgExitPool = DefaultWeightMsgExitPool
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgJoinSwapExternAmountIn, &weightMsgJoinSwapExternAmountIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgJoinSwapExternAmountIn = DefaultWeightMsgJoinSwapExternAmountIn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExitSwapShareAmountIn, &weightMsgExitSwapShareAmountIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgExitSwapShareAmountIn = DefaultWeightMsgExitSwapShareAmountIn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwapExactAmountIn, &weightMsgSwapExactAmountIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwapExactAmountIn = DefaultWeightMsgSwapExactAmountIn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwapExactAmountOut, &weightMsgSwapExactAmountOut, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwapExactAmountOut = DefaultWeightMsgSwapExactAmountOut
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateBalancerPool,
+			SimulateMsgCreateBalancerPool(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateStableswapPool,
+			SimulateMsgCreateStableswapPool(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgJoinPool,
+			SimulateMsgJoinPool(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExitPool,
+			SimulateMsgExitPool(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgJoinSwapExternAmountIn,
+			SimulateMsgJoinSwapExternAmountIn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExitSwapShareAmountIn,
+			SimulateMsgExitSwapShareAmountIn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapExactAmountIn,
+			SimulateMsgSwapExactAmountIn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapExactAmountOut,
+			SimulateMsgSwapExactAmountOut(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateBalancerPool generates a MsgCreateBalancerPool with random values.
+func SimulateMsgCreateBalancerPool(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		poolCreationFee := k.GetParams(ctx).PoolCreationFee
+		initialLiquidity, ok := genInitialPoolLiquidity(r, bk.SpendableCoins(ctx, simAccount.Address), poolCreationFee)
+		if !ok {
+			return simtypes.NoOpMsg(
+				types.ModuleName, balancer.TypeMsgCreateBalancerPool, "Account can't create a pool"), nil, nil
+		}
+
+		poolAssets := []balancer.PoolAsset{}
+		for _, coin := range initialLiquidity {
+			poolAssets = append(poolAssets, balancer.PoolAsset{
+				Token:  coin,
+				Weight: sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 100))),
+			})
+		}
+		poolParams := balancer.PoolParams{
+			SwapFee: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+			ExitFee: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+		}
+		msg := balancer.NewMsgCreateBalancerPool(simAccount.Address, poolParams, poolAssets, "")
+
+		if _, err := k.CreatePool(dryRunContext(ctx), msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, initialLiquidity.Add(poolCreationFee...), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgCreateStableswapPool generates a MsgCreateStableswapPool with random values.
+func SimulateMsgCreateStableswapPool(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		poolCreationFee := k.GetParams(ctx).PoolCreationFee
+		initialLiquidity, ok := genInitialPoolLiquidity(r, bk.SpendableCoins(ctx, simAccount.Address), poolCreationFee)
+		if !ok {
+			return simtypes.NoOpMsg(
+				types.ModuleName, stableswap.TypeMsgCreateStableswapPool, "Account can't create a pool"), nil, nil
+		}
+
+		poolParams := stableswap.PoolParams{
+			SwapFee: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+			ExitFee: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+		}
+		msg := stableswap.NewMsgCreateStableswapPool(simAccount.Address, poolParams, initialLiquidity, "", "")
+
+		if _, err := k.CreatePool(dryRunContext(ctx), msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, initialLiquidity.Add(poolCreationFee...), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgJoinPool generates a MsgJoinPool with random values.
+func SimulateMsgJoinPool(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		pool := randomPool(ctx, r, k)
+		if pool == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgJoinPool, "No pool to join"), nil, nil
+		}
+
+		// the account joins with at most half of its balance of each pool asset
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		tokenInMaxs := sdk.Coins{}
+		maxShareRatio := sdk.OneDec()
+		for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+			balance := spendable.AmountOf(coin.Denom)
+			tokenInMaxs = append(tokenInMaxs, sdk.NewCoin(coin.Denom, balance))
+			maxShareRatio = sdk.MinDec(maxShareRatio, balance.ToDec().QuoInt(coin.Amount).QuoInt64(2))
+		}
+		if !tokenInMaxs.IsAllPositive() {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgJoinPool, "Account doesn't have the pool assets"), nil, nil
+		}
+		shareOutAmount := simtypes.RandomDecAmount(r, maxShareRatio).MulInt(pool.GetTotalShares()).TruncateInt()
+		if !shareOutAmount.IsPositive() {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgJoinPool, "Account has too little of the pool assets"), nil, nil
+		}
+
+		msg := types.MsgJoinPool{
+			Sender:         simAccount.Address.String(),
+			PoolId:         pool.GetId(),
+			ShareOutAmount: shareOutAmount,
+			TokenInMaxs:    tokenInMaxs,
+		}
+
+		if _, err := k.JoinPoolNoSwap(dryRunContext(ctx), simAccount.Address, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, tokenInMaxs, ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgExitPool generates a MsgExitPool with random values.
+func SimulateMsgExitPool(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		pool, shares := randomPoolShares(ctx, r, k, bk, simAccount.Address)
+		if pool == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExitPool, "Account has no pool shares"), nil, nil
+		}
+
+		shareInAmount, err := simtypes.RandPositiveInt(r, sdk.MinInt(shares.Amount, pool.GetTotalShares().QuoRaw(2)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExitPool, "Pool has too few shares"), nil, nil
+		}
+
+		msg := types.MsgExitPool{
+			Sender:        simAccount.Address.String(),
+			PoolId:        pool.GetId(),
+			ShareInAmount: shareInAmount,
+			TokenOutMins:  sdk.Coins{},
+		}
+
+		if _, err := k.ExitPool(dryRunContext(ctx), simAccount.Address, msg.PoolId, msg.ShareInAmount, msg.TokenOutMins); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.NewCoins(sdk.NewCoin(shares.Denom, shareInAmount)), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgJoinSwapExternAmountIn generates a MsgJoinSwapExternAmountIn with random values.
+func SimulateMsgJoinSwapExternAmountIn(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		pool := randomPool(ctx, r, k)
+		if pool == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgJoinSwapExternAmountIn, "No pool to join"), nil, nil
+		}
+
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		reserve := liquidity[r.Intn(len(liquidity))]
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(reserve.Denom)
+		amountIn, err := simtypes.RandPositiveInt(r, sdk.MinInt(balance, reserve.Amount.QuoRaw(4)))
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgJoinSwapExternAmountIn, "Account doesn't have the pool asset"), nil, nil
+		}
+
+		msg := types.MsgJoinSwapExternAmountIn{
+			Sender:            simAccount.Address.String(),
+			PoolId:            pool.GetId(),
+			TokenIn:           sdk.NewCoin(reserve.Denom, amountIn),
+			ShareOutMinAmount: sdk.OneInt(),
+		}
+
+		if _, err := k.JoinSwapExactAmountIn(dryRunContext(ctx), simAccount.Address, msg.PoolId, sdk.NewCoins(msg.TokenIn), msg.ShareOutMinAmount); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.NewCoins(msg.TokenIn), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgExitSwapShareAmountIn generates a MsgExitSwapShareAmountIn with random values.
+func SimulateMsgExitSwapShareAmountIn(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		pool, shares := randomPoolShares(ctx, r, k, bk, simAccount.Address)
+		if pool == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgExitSwapShareAmountIn, "Account has no pool shares"), nil, nil
+		}
+
+		shareInAmount, err := simtypes.RandPositiveInt(r, sdk.MinInt(shares.Amount, pool.GetTotalShares().QuoRaw(10)))
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgExitSwapShareAmountIn, "Pool has too few shares"), nil, nil
+		}
+
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		msg := types.MsgExitSwapShareAmountIn{
+			Sender:            simAccount.Address.String(),
+			PoolId:            pool.GetId(),
+			TokenOutDenom:     liquidity[r.Intn(len(liquidity))].Denom,
+			ShareInAmount:     shareInAmount,
+			TokenOutMinAmount: sdk.OneInt(),
+		}
+
+		if _, err := k.ExitSwapShareAmountIn(dryRunContext(ctx), simAccount.Address, msg.PoolId, msg.TokenOutDenom, msg.ShareInAmount, msg.TokenOutMinAmount); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.NewCoins(sdk.NewCoin(shares.Denom, shareInAmount)), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgSwapExactAmountIn generates a MsgSwapExactAmountIn with random
+// values, routed through up to maxSwapHops pools.
+func SimulateMsgSwapExactAmountIn(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		hops := randomSwapHops(ctx, r, k, bk.SpendableCoins(ctx, simAccount.Address))
+		if len(hops) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountIn, "Account has no asset to swap"), nil, nil
+		}
+
+		denomIn := hops[0].denomIn
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denomIn)
+		amountIn, err := simtypes.RandPositiveInt(r, sdk.MinInt(balance, hops[0].pool.GetTotalPoolLiquidity(ctx).AmountOf(denomIn).QuoRaw(10)))
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountIn, "Pool has too little liquidity"), nil, nil
+		}
+
+		routes := []types.SwapAmountInRoute{}
+		for _, hop := range hops {
+			routes = append(routes, types.SwapAmountInRoute{PoolId: hop.pool.GetId(), TokenOutDenom: hop.denomOut})
+		}
+		msg := types.MsgSwapExactAmountIn{
+			Sender:            simAccount.Address.String(),
+			Routes:            routes,
+			TokenIn:           sdk.NewCoin(denomIn, amountIn),
+			TokenOutMinAmount: sdk.OneInt(),
+		}
+
+		if _, err := k.MultihopSwapExactAmountIn(dryRunContext(ctx), simAccount.Address, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.SwapGuards()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.NewCoins(msg.TokenIn), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgSwapExactAmountOut generates a MsgSwapExactAmountOut with random
+// values, routed through up to maxSwapHops pools.
+func SimulateMsgSwapExactAmountOut(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		hops := randomSwapHops(ctx, r, k, bk.SpendableCoins(ctx, simAccount.Address))
+		if len(hops) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountOut, "Account has no asset to swap"), nil, nil
+		}
+
+		lastHop := hops[len(hops)-1]
+		amountOut, err := simtypes.RandPositiveInt(r, lastHop.pool.GetTotalPoolLiquidity(ctx).AmountOf(lastHop.denomOut).QuoRaw(10))
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountOut, "Pool has too little liquidity"), nil, nil
+		}
+
+		routes := []types.SwapAmountOutRoute{}
+		for _, hop := range hops {
+			routes = append(routes, types.SwapAmountOutRoute{PoolId: hop.pool.GetId(), TokenInDenom: hop.denomIn})
+		}
+		denomIn := hops[0].denomIn
+		msg := types.MsgSwapExactAmountOut{
+			Sender:           simAccount.Address.String(),
+			Routes:           routes,
+			TokenInMaxAmount: bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denomIn),
+			TokenOut:         sdk.NewCoin(lastHop.denomOut, amountOut),
+		}
+
+		// the dry run finds the amount in, so that no more than it is spent
+		amountIn, err := k.MultihopSwapExactAmountOut(dryRunContext(ctx), simAccount.Address, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.SwapGuards())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+		msg.TokenInMaxAmount = amountIn
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.NewCoins(sdk.NewCoin(denomIn, amountIn)), ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// genInitialPoolLiquidity returns the random initial liquidity of a pool
+// created by an account with spendable coins, which also pays the pool
+// creation fee. It returns false if the account can't create a pool.
+func genInitialPoolLiquidity(r *rand.Rand, spendable, poolCreationFee sdk.Coins) (sdk.Coins, bool) {
+	available, hasNeg := spendable.SafeSub(poolCreationFee)
+	if hasNeg {
+		return nil, false
+	}
+
+	assets := sdk.Coins{}
+	for _, coin := range available {
+		// shares of other pools and dust aren't used as pool assets
+		if strings.HasPrefix(coin.Denom, "gamm/pool/") || coin.Amount.LT(sdk.NewInt(100)) {
+			continue
+		}
+		assets = append(assets, coin)
+	}
+	if len(assets) < types.MinPoolAssets {
+		return nil, false
+	}
+
+	maxAssets := len(assets)
+	if maxAssets > types.MaxPoolAssets {
+		maxAssets = types.MaxPoolAssets
+	}
+	numAssets := simtypes.RandIntBetween(r, types.MinPoolAssets, maxAssets+1)
+
+	// the pool is created with 10% to 20% of the balance of each asset
+	initialLiquidity := sdk.Coins{}
+	for _, i := range r.Perm(len(assets))[:numAssets] {
+		amount := assets[i].Amount.QuoRaw(10)
+		amount = amount.Add(simtypes.RandomAmount(r, amount))
+		initialLiquidity = initialLiquidity.Add(sdk.NewCoin(assets[i].Denom, amount))
+	}
+	return initialLiquidity, true
+}
+
+// randomPool returns a random pool with shares, or nil if there is none.
+func randomPool(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) types.PoolI {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil
+	}
+
+	poolsWithShares := []types.PoolI{}
+	for _, pool := range pools {
+		// concentrated liquidity pools have no shares
+		if _, ok := pool.(*concentrated.Pool); ok {
+			continue
+		}
+		poolsWithShares = append(poolsWithShares, pool)
+	}
+	if len(poolsWithShares) == 0 {
+		return nil
+	}
+	return poolsWithShares[r.Intn(len(poolsWithShares))]
+}
+
+// randomPoolShares returns a random pool addr has shares of, together with
+// the shares, or a nil pool if addr has no pool shares.
+func randomPoolShares(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, bk stakingtypes.BankKeeper, addr sdk.AccAddress) (types.PoolI, sdk.Coin) {
+	shares := []sdk.Coin{}
+	for _, coin := range bk.SpendableCoins(ctx, addr) {
+		if strings.HasPrefix(coin.Denom, "gamm/pool/") {
+			shares = append(shares, coin)
+		}
+	}
+	if len(shares) == 0 {
+		return nil, sdk.Coin{}
+	}
+
+	share := shares[r.Intn(len(shares))]
+	pool, err := k.GetPoolAndPoke(ctx, types.MustGetPoolIdFromShareDenom(share.Denom))
+	if err != nil {
+		return nil, sdk.Coin{}
+	}
+	return pool, share
+}
+
+// swapHop is a pool a swap is routed through, with the denoms swapped in and
+// out of it.
+type swapHop struct {
+	pool     types.PoolI
+	denomIn  string
+	denomOut string
+}
+
+// randomSwapHops returns a random route of up to maxSwapHops pools, starting
+// from one of the spendable coins, or nil if none of them is in a pool.
+func randomSwapHops(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, spendable sdk.Coins) []swapHop {
+	if spendable.Empty() {
+		return nil
+	}
+
+	hops := []swapHop{}
+	denomIn := spendable[r.Intn(len(spendable))].Denom
+	numHops := simtypes.RandIntBetween(r, 1, maxSwapHops+1)
+	for len(hops) < numHops {
+		poolIds := k.GetPoolIdsWithDenom(ctx, denomIn)
+		if len(poolIds) == 0 {
+			break
+		}
+		pool, err := k.GetPoolAndPoke(ctx, poolIds[r.Intn(len(poolIds))])
+		if err != nil {
+			break
+		}
+
+		denomsOut := []string{}
+		for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+			if coin.Denom != denomIn {
+				denomsOut = append(denomsOut, coin.Denom)
+			}
+		}
+		if len(denomsOut) == 0 {
+			break
+		}
+
+		denomOut := denomsOut[r.Intn(len(denomsOut))]
+		hops = append(hops, swapHop{pool: pool, denomIn: denomIn, denomOut: denomOut})
+		denomIn = denomOut
+	}
+	return hops
+}
+
+// dryRunContext returns a context whose state changes are discarded, used to
+// check that a message succeeds before delivering it.
+func dryRunContext(ctx sdk.Context) sdk.Context {
+	cacheCtx, _ := ctx.CacheContext()
+	return cacheCtx.WithEventManager(sdk.NewEventManager())
+}
@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/batch.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BatchSwapOrder is a swap queued by MsgBatchSwap, which is cleared with the
+// other orders of its pool at the end of the block.
+type BatchSwapOrder struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is held in escrow by the gamm module account until the order is
+	// cleared. The taker fee is taken out of it when it is.
+	TokenIn           types.Coin                             `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                                 `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *BatchSwapOrder) Reset()         { *m = BatchSwapOrder{} }
+func (m *BatchSwapOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapOrder) ProtoMessage()    {}
+func (*BatchSwapOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2991629c5a26281d, []int{0}
+}
+func (m *BatchSwapOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapOrder.Merge(m, src)
+}
+func (m *BatchSwapOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapOrder proto.InternalMessageInfo
+
+func (m *BatchSwapOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BatchSwapOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *BatchSwapOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *BatchSwapOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *BatchSwapOrder) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BatchSwapOrder)(nil), "osmosis.gamm.v1beta1.BatchSwapOrder")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/batch.proto", fileDescriptor_2991629c5a26281d) }
+
+var fileDescriptor_2991629c5a26281d = []byte{
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x6e, 0x74, 0xcc, 0xa8, 0x9b, 0x66, 0x55, 0x10, 0x8a, 0x88, 0x2b, 0x1f, 0x50,
+	0x11, 0x9a, 0xad, 0xc1, 0x01, 0x89, 0x1b, 0x81, 0x4b, 0x0f, 0xd5, 0xa4, 0x70, 0xe3, 0x52, 0x39,
+	0xb5, 0xd5, 0x59, 0xab, 0xed, 0xaa, 0x76, 0x07, 0xbb, 0xf0, 0x0c, 0x9c, 0x79, 0xa2, 0x1d, 0x77,
+	0x44, 0x1c, 0x2c, 0xd4, 0xbe, 0x41, 0x9e, 0x00, 0xc5, 0x4e, 0xb6, 0x89, 0x53, 0x3e, 0xff, 0xfd,
+	0xfb, 0xfe, 0xdf, 0x3f, 0xc9, 0x07, 0x46, 0xc6, 0x2a, 0x63, 0xa5, 0xa5, 0x0b, 0xa6, 0x14, 0xbd,
+	0x3a, 0x2b, 0x85, 0x63, 0x67, 0xb4, 0x64, 0x6e, 0x7e, 0x41, 0x56, 0x6b, 0xe3, 0x0c, 0x1c, 0x34,
+	0x04, 0xa9, 0x09, 0xd2, 0x10, 0xc3, 0xc1, 0xc2, 0x2c, 0x4c, 0x00, 0x68, 0x5d, 0x45, 0x76, 0x98,
+	0xcd, 0x03, 0x4c, 0x4b, 0x66, 0xc5, 0x9d, 0xd9, 0xdc, 0x48, 0x1d, 0xef, 0xf1, 0xaf, 0x3d, 0x70,
+	0x94, 0xd7, 0xde, 0x5f, 0xbe, 0xb1, 0xd5, 0xf9, 0x9a, 0x8b, 0x35, 0x7c, 0x09, 0xba, 0x92, 0xa7,
+	0xc9, 0x28, 0x19, 0xef, 0xe7, 0xfd, 0xca, 0xa3, 0xc3, 0x6b, 0xa6, 0x96, 0x1f, 0xb0, 0xe4, 0xb8,
+	0xe8, 0x4a, 0x0e, 0x5f, 0x83, 0x9e, 0x15, 0x9a, 0x8b, 0x75, 0xda, 0x1d, 0x25, 0xe3, 0xc3, 0xfc,
+	0xa4, 0xf2, 0xa8, 0x1f, 0x91, 0xa8, 0xe3, 0xa2, 0x01, 0xe0, 0x1b, 0x70, 0xb0, 0x32, 0x66, 0x39,
+	0x93, 0x3c, 0xdd, 0x0b, 0x76, 0xb0, 0xf2, 0xe8, 0x28, 0xb2, 0xcd, 0x05, 0x2e, 0x7a, 0x75, 0x35,
+	0xe1, 0x70, 0x0a, 0x1e, 0x3b, 0x73, 0x29, 0xf4, 0x4c, 0xea, 0x74, 0x7f, 0x94, 0x8c, 0x9f, 0xbc,
+	0x7d, 0x4e, 0x62, 0x78, 0x52, 0x87, 0x6f, 0xdf, 0x93, 0x7c, 0x32, 0x52, 0xe7, 0xcf, 0x6e, 0x3c,
+	0xea, 0x54, 0x1e, 0x1d, 0x47, 0xb3, 0xb6, 0x11, 0x17, 0x07, 0xa1, 0x9c, 0x68, 0x98, 0x83, 0xe3,
+	0xa8, 0x9a, 0x8d, 0x9b, 0x71, 0xa1, 0x8d, 0x4a, 0x1f, 0x85, 0xbc, 0xc3, 0xca, 0xa3, 0xa7, 0x0f,
+	0xdb, 0xee, 0x00, 0x5c, 0xf4, 0x83, 0x72, 0xbe, 0x71, 0x9f, 0xeb, 0x33, 0xfc, 0x01, 0x06, 0xf7,
+	0x88, 0x92, 0x7a, 0xc6, 0x94, 0xd9, 0x68, 0x97, 0xf6, 0x82, 0xd1, 0xb4, 0xce, 0xf0, 0xc7, 0xa3,
+	0x57, 0x0b, 0xe9, 0x2e, 0x36, 0x25, 0x99, 0x1b, 0x45, 0x9b, 0xaf, 0x1d, 0x1f, 0xa7, 0x96, 0x5f,
+	0x52, 0x77, 0xbd, 0x12, 0x96, 0x4c, 0xb4, 0xab, 0x3c, 0x7a, 0xf1, 0xff, 0xd8, 0x7b, 0x4f, 0x5c,
+	0x9c, 0xb4, 0xb3, 0xa7, 0x52, 0x7f, 0x0c, 0x5a, 0x3e, 0xb9, 0xd9, 0x66, 0xc9, 0xed, 0x36, 0x4b,
+	0xfe, 0x6e, 0xb3, 0xe4, 0xe7, 0x2e, 0xeb, 0xdc, 0xee, 0xb2, 0xce, 0xef, 0x5d, 0xd6, 0xf9, 0x4a,
+	0x1f, 0xcc, 0x6c, 0xb6, 0xe1, 0x74, 0xc9, 0x4a, 0xdb, 0x1e, 0xe8, 0xd5, 0x7b, 0xfa, 0x3d, 0x6e,
+	0x50, 0x08, 0x50, 0xf6, 0xc2, 0xef, 0x7e, 0xf7, 0x6f, 0x00, 0x5c, 0x8f, 0x31, 0xb3, 0x5e, 0x02,
+	0x00, 0x00,
+}
+
+func (m *BatchSwapOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwapOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwapOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchSwapOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBatch(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovBatch(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchSwapOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwapOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwapOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
@@ -19,6 +20,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/gamm/split-route-swap-exact-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/gamm/split-route-swap-exact-amount-out", nil)
+	cdc.RegisterConcrete(&MsgMigrateShares{}, "osmosis/gamm/migrate-shares", nil)
+	cdc.RegisterConcrete(&MsgPausePool{}, "osmosis/gamm/pause-pool", nil)
+	cdc.RegisterConcrete(&MsgJoinPoolAndLock{}, "osmosis/gamm/join-pool-and-lock", nil)
+	cdc.RegisterConcrete(&MsgExitLockedPool{}, "osmosis/gamm/exit-locked-pool", nil)
+	cdc.RegisterConcrete(&MsgBatchSwap{}, "osmosis/gamm/batch-swap", nil)
+	cdc.RegisterConcrete(&SetPoolPauseStatusProposal{}, "osmosis/gamm/set-pool-pause-status-proposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -37,7 +46,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgSplitRouteSwapExactAmountIn{},
+		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgMigrateShares{},
+		&MsgPausePool{},
+		&MsgJoinPoolAndLock{},
+		&MsgExitLockedPool{},
+		&MsgBatchSwap{},
 	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetPoolPauseStatusProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -12,6 +12,11 @@ const (
 	// Raise 10 to the power of SigFigsExponent to determine number of significant figures.
 	// i.e. SigFigExponent = 8 is 10^8 which is 100000000. This gives 8 significant figures.
 	SigFigsExponent = 8
+
+	// Pool types, as queried by PoolsByType.
+	PoolTypeBalancer     = "balancer"
+	PoolTypeStableswap   = "stableswap"
+	PoolTypeConcentrated = "concentrated"
 )
 
 var (
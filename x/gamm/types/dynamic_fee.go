@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewDynamicSwapFeeParams(volatilityMultiplier, maxSwapFee sdk.Dec, decayPeriod time.Duration) *DynamicSwapFeeParams {
+	return &DynamicSwapFeeParams{
+		VolatilityMultiplier: volatilityMultiplier,
+		MaxSwapFee:           maxSwapFee,
+		DecayPeriod:          decayPeriod,
+	}
+}
+
+// Validate checks the dynamic swap fee params of a pool with the given base swap fee.
+func (params DynamicSwapFeeParams) Validate(baseSwapFee sdk.Dec) error {
+	if params.VolatilityMultiplier.IsNil() || params.VolatilityMultiplier.IsNegative() {
+		return errors.New("dynamic swap fee volatility multiplier must not be negative")
+	}
+
+	if params.MaxSwapFee.IsNil() || params.MaxSwapFee.LT(baseSwapFee) {
+		return errors.New("dynamic swap fee max swap fee must not be lesser than the base swap fee")
+	}
+
+	if params.MaxSwapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+
+	if params.DecayPeriod <= 0 {
+		return errors.New("dynamic swap fee decay period must be positive")
+	}
+
+	return nil
+}
+
+// SwapFee returns the swap fee of a pool with the given base swap fee and
+// volatility accumulator at blockTime.
+// A nil accumulator means the pool hasn't recorded any volatility yet.
+func (params DynamicSwapFeeParams) SwapFee(baseSwapFee sdk.Dec, acc *VolatilityAccumulator, blockTime time.Time) sdk.Dec {
+	if acc == nil {
+		return baseSwapFee
+	}
+
+	volatility := acc.DecayedVolatility(blockTime, params.DecayPeriod)
+	swapFee := baseSwapFee.Add(params.VolatilityMultiplier.Mul(volatility))
+	if swapFee.GT(params.MaxSwapFee) {
+		return params.MaxSwapFee
+	}
+	return swapFee
+}
+
+// DecayedVolatility returns the volatility of the accumulator at blockTime,
+// decayed linearly to zero over decayPeriod since its last update.
+func (acc VolatilityAccumulator) DecayedVolatility(blockTime time.Time, decayPeriod time.Duration) sdk.Dec {
+	elapsed := blockTime.Sub(acc.LastUpdateTime)
+	if elapsed <= 0 {
+		return acc.Volatility
+	}
+	if elapsed >= decayPeriod {
+		return sdk.ZeroDec()
+	}
+
+	remaining := sdk.NewDec((decayPeriod - elapsed).Milliseconds()).QuoInt64(decayPeriod.Milliseconds())
+	return acc.Volatility.Mul(remaining)
+}
+
+// UpdateVolatilityAccumulator returns the accumulator after a swap moving the
+// spot price from spotPriceBefore to spotPriceAfter at blockTime.
+// The relative move of the spot price is added to the decayed volatility.
+func UpdateVolatilityAccumulator(
+	acc *VolatilityAccumulator,
+	decayPeriod time.Duration,
+	spotPriceBefore, spotPriceAfter sdk.Dec,
+	blockTime time.Time,
+) *VolatilityAccumulator {
+	volatility := sdk.ZeroDec()
+	if acc != nil {
+		volatility = acc.DecayedVolatility(blockTime, decayPeriod)
+	}
+
+	if spotPriceBefore.IsPositive() {
+		priceMove := spotPriceAfter.Sub(spotPriceBefore).Abs().Quo(spotPriceBefore)
+		volatility = volatility.Add(priceMove)
+	}
+
+	return &VolatilityAccumulator{
+		Volatility:     volatility,
+		LastUpdateTime: blockTime,
+	}
+}
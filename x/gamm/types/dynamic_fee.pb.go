@@ -0,0 +1,662 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/dynamic_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSwapFeeParams opts a pool into volatility dependent swap fees.
+// The pool's swap fee is then
+//
+//	min(max_swap_fee, swap_fee + volatility_multiplier * volatility)
+//
+// where swap_fee is the pool's base swap fee, and volatility is the sum of the
+// relative spot price moves of the pool's recent swaps, decayed linearly to
+// zero over decay_period.
+type DynamicSwapFeeParams struct {
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	MaxSwapFee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	DecayPeriod          time.Duration                          `protobuf:"bytes,3,opt,name=decay_period,json=decayPeriod,proto3,stdduration" json:"decay_period" yaml:"decay_period"`
+}
+
+func (m *DynamicSwapFeeParams) Reset()         { *m = DynamicSwapFeeParams{} }
+func (m *DynamicSwapFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFeeParams) ProtoMessage()    {}
+func (*DynamicSwapFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_559d9bbe8deb1a0e, []int{0}
+}
+func (m *DynamicSwapFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFeeParams.Merge(m, src)
+}
+func (m *DynamicSwapFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFeeParams proto.InternalMessageInfo
+
+func (m *DynamicSwapFeeParams) GetDecayPeriod() time.Duration {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return 0
+}
+
+// VolatilityAccumulator is the volatility recorded by a pool with dynamic
+// swap fees, as of its last swap.
+type VolatilityAccumulator struct {
+	Volatility     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	LastUpdateTime time.Time                              `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+}
+
+func (m *VolatilityAccumulator) Reset()         { *m = VolatilityAccumulator{} }
+func (m *VolatilityAccumulator) String() string { return proto.CompactTextString(m) }
+func (*VolatilityAccumulator) ProtoMessage()    {}
+func (*VolatilityAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_559d9bbe8deb1a0e, []int{1}
+}
+func (m *VolatilityAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolatilityAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolatilityAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolatilityAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolatilityAccumulator.Merge(m, src)
+}
+func (m *VolatilityAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolatilityAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolatilityAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolatilityAccumulator proto.InternalMessageInfo
+
+func (m *VolatilityAccumulator) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DynamicSwapFeeParams)(nil), "osmosis.gamm.v1beta1.DynamicSwapFeeParams")
+	proto.RegisterType((*VolatilityAccumulator)(nil), "osmosis.gamm.v1beta1.VolatilityAccumulator")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/dynamic_fee.proto", fileDescriptor_559d9bbe8deb1a0e)
+}
+
+var fileDescriptor_559d9bbe8deb1a0e = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0xa9, 0x20, 0x38, 0x5b, 0x44, 0xe3, 0x16, 0xd7, 0x45, 0x92, 0x12, 0xa1, 0xf4, 0xd2,
+	0x0c, 0xad, 0x07, 0xc1, 0x9b, 0xeb, 0x2a, 0x78, 0x50, 0xca, 0xfa, 0xe3, 0x20, 0x48, 0x78, 0x49,
+	0xa6, 0x71, 0x70, 0xa6, 0x13, 0x32, 0x93, 0xed, 0xe6, 0xec, 0x3f, 0xd0, 0xa3, 0x7f, 0x52, 0x8f,
+	0x3d, 0x8a, 0x87, 0x55, 0x76, 0x05, 0xef, 0xfe, 0x05, 0x92, 0x99, 0xd9, 0x6e, 0x58, 0x7b, 0xd9,
+	0x53, 0x32, 0xdf, 0x7c, 0xef, 0x7b, 0xef, 0xfb, 0x78, 0x83, 0xf7, 0xa4, 0x12, 0x52, 0x31, 0x45,
+	0x72, 0x10, 0x82, 0x4c, 0x0e, 0x13, 0xaa, 0xe1, 0x90, 0x64, 0xf5, 0x29, 0x08, 0x96, 0xc6, 0x27,
+	0x94, 0x46, 0x45, 0x29, 0xb5, 0xf4, 0x7a, 0x8e, 0x17, 0x35, 0xbc, 0xc8, 0xf1, 0x06, 0xbd, 0x5c,
+	0xe6, 0xd2, 0x10, 0x48, 0xf3, 0x67, 0xb9, 0x03, 0x3f, 0x97, 0x32, 0xe7, 0x94, 0x98, 0x53, 0x52,
+	0x9d, 0x90, 0xac, 0x2a, 0x41, 0x33, 0x79, 0xea, 0xee, 0x83, 0xf5, 0x7b, 0xcd, 0x04, 0x55, 0x1a,
+	0x44, 0x61, 0x09, 0xe1, 0xef, 0x2d, 0xdc, 0x1b, 0xd9, 0x11, 0xde, 0x9e, 0x41, 0xf1, 0x92, 0xd2,
+	0x63, 0x28, 0x41, 0x28, 0xef, 0x2b, 0xc2, 0x3b, 0x13, 0xc9, 0x41, 0x33, 0xce, 0x74, 0x1d, 0x8b,
+	0x8a, 0x6b, 0x56, 0x70, 0x46, 0xcb, 0x3e, 0xda, 0x45, 0xfb, 0xb7, 0x86, 0x6f, 0x2e, 0x66, 0x41,
+	0xe7, 0xc7, 0x2c, 0xd8, 0xcb, 0x99, 0xfe, 0x5c, 0x25, 0x51, 0x2a, 0x05, 0x49, 0xcd, 0xe4, 0xee,
+	0x73, 0xa0, 0xb2, 0x2f, 0x44, 0xd7, 0x05, 0x55, 0xd1, 0x88, 0xa6, 0x7f, 0x67, 0xc1, 0xc3, 0x1a,
+	0x04, 0x7f, 0x1a, 0x5e, 0x2b, 0x1a, 0x8e, 0x7b, 0x2b, 0xfc, 0xf5, 0x15, 0xec, 0xe5, 0x78, 0x5b,
+	0xc0, 0x34, 0x56, 0x67, 0x50, 0x34, 0x09, 0xf5, 0xb7, 0x4c, 0xef, 0x17, 0x1b, 0xf7, 0xbe, 0x67,
+	0x7b, 0xb7, 0xb5, 0xc2, 0x31, 0x16, 0x30, 0x75, 0xa6, 0xbd, 0x4f, 0x78, 0x3b, 0xa3, 0x29, 0xd4,
+	0x71, 0x41, 0x4b, 0x26, 0xb3, 0xfe, 0x8d, 0x5d, 0xb4, 0xdf, 0x3d, 0x7a, 0x10, 0xd9, 0xfc, 0xa2,
+	0x65, 0x7e, 0xd1, 0xc8, 0xe5, 0x3b, 0x0c, 0x9a, 0x19, 0x56, 0xca, 0xed, 0xe2, 0xf0, 0xdb, 0xcf,
+	0x00, 0x8d, 0xbb, 0x06, 0x3a, 0xb6, 0xc8, 0x1f, 0x84, 0x77, 0x3e, 0x5c, 0x19, 0x7c, 0x96, 0xa6,
+	0x95, 0xa8, 0x38, 0x68, 0x59, 0x7a, 0x29, 0xc6, 0x2b, 0xe7, 0x2e, 0xdb, 0xe7, 0x1b, 0xfb, 0xbb,
+	0xbb, 0x9e, 0x6d, 0x38, 0x6e, 0xc9, 0x7a, 0x0c, 0xdf, 0xe1, 0xa0, 0x74, 0x5c, 0x15, 0x19, 0x68,
+	0x1a, 0x37, 0x4b, 0x60, 0xa2, 0xec, 0x1e, 0x0d, 0xfe, 0x73, 0xf8, 0x6e, 0xb9, 0x21, 0xc3, 0x47,
+	0xce, 0xe2, 0x7d, 0x2b, 0xbe, 0xae, 0x10, 0x9e, 0x37, 0x36, 0x6f, 0x37, 0xf0, 0x7b, 0x83, 0x36,
+	0x95, 0xc3, 0x57, 0x17, 0x73, 0x1f, 0x5d, 0xce, 0x7d, 0xf4, 0x6b, 0xee, 0xa3, 0xf3, 0x85, 0xdf,
+	0xb9, 0x5c, 0xf8, 0x9d, 0xef, 0x0b, 0xbf, 0xf3, 0x91, 0xb4, 0xdc, 0xb8, 0x15, 0x3f, 0xe0, 0x90,
+	0xa8, 0xe5, 0x81, 0x4c, 0x9e, 0x90, 0xa9, 0x7d, 0x1c, 0xc6, 0x5a, 0x72, 0xd3, 0xcc, 0xf4, 0xf8,
+	0xdf, 0x00, 0x2f, 0xc6, 0x73, 0xa6, 0x39, 0x03, 0x00, 0x00,
+}
+
+func (m *DynamicSwapFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDynamicFee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolatilityAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolatilityAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolatilityAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDynamicFee(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSwapFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovDynamicFee(uint64(l))
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovDynamicFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayPeriod)
+	n += 1 + l + sovDynamicFee(uint64(l))
+	return n
+}
+
+func (m *VolatilityAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volatility.Size()
+	n += 1 + l + sovDynamicFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovDynamicFee(uint64(l))
+	return n
+}
+
+func sovDynamicFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicFee(x uint64) (n int) {
+	return sovDynamicFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSwapFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolatilityAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolatilityAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolatilityAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDynamicSwapFeeParamsValidate(t *testing.T) {
+	baseSwapFee := sdk.NewDecWithPrec(3, 3)
+
+	tests := []struct {
+		name       string
+		params     *DynamicSwapFeeParams
+		expectPass bool
+	}{
+		{
+			name:       "proper params",
+			params:     NewDynamicSwapFeeParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 2), time.Hour),
+			expectPass: true,
+		},
+		{
+			name:       "max swap fee equal to the base swap fee",
+			params:     NewDynamicSwapFeeParams(sdk.ZeroDec(), baseSwapFee, time.Hour),
+			expectPass: true,
+		},
+		{
+			name:   "negative multiplier",
+			params: NewDynamicSwapFeeParams(sdk.NewDecWithPrec(-5, 1), sdk.NewDecWithPrec(5, 2), time.Hour),
+		},
+		{
+			name:   "max swap fee below the base swap fee",
+			params: NewDynamicSwapFeeParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 3), time.Hour),
+		},
+		{
+			name:   "max swap fee of 1",
+			params: NewDynamicSwapFeeParams(sdk.NewDecWithPrec(5, 1), sdk.OneDec(), time.Hour),
+		},
+		{
+			name:   "zero decay period",
+			params: NewDynamicSwapFeeParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 2), 0),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.params.Validate(baseSwapFee)
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestDynamicSwapFee(t *testing.T) {
+	baseSwapFee := sdk.NewDecWithPrec(3, 3)
+	params := NewDynamicSwapFeeParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 2), time.Hour)
+	startTime := time.Unix(1618700000, 0)
+
+	// no volatility recorded yet
+	require.True(t, baseSwapFee.Equal(params.SwapFee(baseSwapFee, nil, startTime)))
+
+	// a 2% price move
+	acc := UpdateVolatilityAccumulator(nil, params.DecayPeriod, sdk.NewDec(100), sdk.NewDec(98), startTime)
+	require.True(t, sdk.NewDecWithPrec(2, 2).Equal(acc.Volatility))
+	require.True(t, sdk.NewDecWithPrec(13, 3).Equal(params.SwapFee(baseSwapFee, acc, startTime)))
+
+	// half of the volatility has decayed after half the decay period
+	require.True(t, sdk.NewDecWithPrec(8, 3).Equal(params.SwapFee(baseSwapFee, acc, startTime.Add(30*time.Minute))))
+	// and all of it after the decay period
+	require.True(t, baseSwapFee.Equal(params.SwapFee(baseSwapFee, acc, startTime.Add(time.Hour))))
+
+	// price moves add up, on top of the decayed volatility
+	acc = UpdateVolatilityAccumulator(acc, params.DecayPeriod, sdk.NewDec(98), sdk.NewDec(147), startTime.Add(30*time.Minute))
+	require.True(t, sdk.NewDecWithPrec(51, 2).Equal(acc.Volatility))
+	require.Equal(t, startTime.Add(30*time.Minute), acc.LastUpdateTime)
+	// the swap fee is capped
+	require.True(t, params.MaxSwapFee.Equal(params.SwapFee(baseSwapFee, acc, startTime.Add(30*time.Minute))))
+}
@@ -8,7 +8,7 @@ var (
 	ErrPoolAlreadyExist   = sdkerrors.Register(ModuleName, 2, "pool already exist")
 	ErrPoolLocked         = sdkerrors.Register(ModuleName, 3, "pool is locked")
 	ErrTooFewPoolAssets   = sdkerrors.Register(ModuleName, 4, "pool should have at least 2 assets, as they must be swapping between at least two assets")
-	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets (currently capped at 8 assets per balancer pool and 2 per stableswap)")
+	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets (currently capped at 8 assets per pool)")
 	ErrLimitMaxAmount     = sdkerrors.Register(ModuleName, 6, "calculated amount is larger than max amount")
 	ErrLimitMinAmount     = sdkerrors.Register(ModuleName, 7, "calculated amount is lesser than min amount")
 	ErrInvalidMathApprox  = sdkerrors.Register(ModuleName, 8, "invalid calculated result")
@@ -26,6 +26,20 @@ var (
 	ErrNotPositiveCriteria      = sdkerrors.Register(ModuleName, 29, "min out amount or max in amount should be positive")
 	ErrNotPositiveRequireAmount = sdkerrors.Register(ModuleName, 30, "required amount should be positive")
 	ErrTooManyTokensOut         = sdkerrors.Register(ModuleName, 31, "tx is trying to get more tokens out of the pool than exist")
+	ErrInvalidSplitRoutes       = sdkerrors.Register(ModuleName, 32, "split routes must all swap between the same denoms")
+	ErrNoRouteFound             = sdkerrors.Register(ModuleName, 33, "no swap route found")
+	ErrMigrateToSamePool        = sdkerrors.Register(ModuleName, 34, "cannot migrate shares to the pool they are from")
+	ErrMigrateDenomMismatch     = sdkerrors.Register(ModuleName, 35, "migrated tokens must all be assets of the pool being joined")
+	ErrNotPoolGovernor          = sdkerrors.Register(ModuleName, 36, "sender is not the pool's governor")
+	ErrNotBalancerPool          = sdkerrors.Register(ModuleName, 37, "not balancer pool")
+	ErrPoolPaused               = sdkerrors.Register(ModuleName, 38, "pool is paused")
+	ErrNotPauseGuardian         = sdkerrors.Register(ModuleName, 39, "sender is not the pause guardian")
+	ErrNotLockOwner             = sdkerrors.Register(ModuleName, 40, "sender is not the lock owner")
+	ErrLockNotOfPoolShares      = sdkerrors.Register(ModuleName, 41, "lock must hold only shares of the pool")
+	ErrSwapDeadlineExceeded     = sdkerrors.Register(ModuleName, 42, "swap deadline exceeded")
+	ErrInvalidMaxPriceImpact    = sdkerrors.Register(ModuleName, 43, "invalid max price impact")
+	ErrPriceImpactTooLarge      = sdkerrors.Register(ModuleName, 44, "swap price impact is larger than the max price impact")
+	ErrDenomNotInPool           = sdkerrors.Register(ModuleName, 45, "denom is not an asset of the pool")
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
@@ -35,4 +49,12 @@ var (
 	ErrNotStableSwapPool               = sdkerrors.Register(ModuleName, 61, "not stableswap pool")
 	ErrInvalidStableswapScalingFactors = sdkerrors.Register(ModuleName, 62, "length between liquidity and scaling factors mismatch")
 	ErrNotScalingFactorGovernor        = sdkerrors.Register(ModuleName, 63, "not scaling factor governor")
+	ErrInvalidScalingFactorRamp        = sdkerrors.Register(ModuleName, 64, "invalid scaling factor ramp")
+	ErrNoScalingFactorRamp             = sdkerrors.Register(ModuleName, 65, "stableswap pool has no scaling factor ramp")
+
+	ErrNotConcentratedPool   = sdkerrors.Register(ModuleName, 70, "not concentrated liquidity pool")
+	ErrInvalidTick           = sdkerrors.Register(ModuleName, 71, "invalid tick")
+	ErrPositionNotFound      = sdkerrors.Register(ModuleName, 72, "position not found")
+	ErrInsufficientLiquidity = sdkerrors.Register(ModuleName, 73, "insufficient liquidity in pool")
+	ErrNotPositiveLiquidity  = sdkerrors.Register(ModuleName, 74, "liquidity should be positive")
 )
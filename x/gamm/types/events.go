@@ -7,16 +7,32 @@ import (
 )
 
 const (
-	TypeEvtPoolJoined   = "pool_joined"
-	TypeEvtPoolExited   = "pool_exited"
-	TypeEvtPoolCreated  = "pool_created"
-	TypeEvtTokenSwapped = "token_swapped"
+	TypeEvtPoolJoined        = "pool_joined"
+	TypeEvtPoolExited        = "pool_exited"
+	TypeEvtPoolCreated       = "pool_created"
+	TypeEvtTokenSwapped      = "token_swapped"
+	TypeEvtPoolParamsUpdated = "pool_params_updated"
+
+	TypeEvtPoolPauseStatusChanged  = "pool_pause_status_changed"
+	TypeEvtCircuitBreakerTriggered = "circuit_breaker_triggered"
+
+	TypeEvtBatchSwapQueued   = "batch_swap_queued"
+	TypeEvtBatchSwapCleared  = "batch_swap_cleared"
+	TypeEvtBatchSwapRefunded = "batch_swap_refunded"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
+	AttributeKeyExitFee    = "exit_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+
+	AttributeKeySwapsPaused = "swaps_paused"
+	AttributeKeyJoinsPaused = "joins_paused"
+	AttributeKeyExitsPaused = "exits_paused"
+	AttributeKeyPriceChange = "price_change"
+
+	AttributeKeyOrderId = "order_id"
 )
 
 func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
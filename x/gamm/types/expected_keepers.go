@@ -1,9 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -41,6 +46,7 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 
 	// Only needed for simulation interface matching
@@ -61,3 +67,19 @@ type BankKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// TxFeesKeeper defines the contract needed to be fulfilled for the txfees keeper,
+// to route taker fees to stakers.
+type TxFeesKeeper interface {
+	GetBaseDenom(ctx sdk.Context) (denom string, err error)
+	GetFeeToken(ctx sdk.Context, denom string) (txfeestypes.FeeToken, error)
+}
+
+// LockupKeeper defines the contract needed to be fulfilled for the lockup keeper,
+// to lock the shares of joined pools and exit pools with locked shares.
+type LockupKeeper interface {
+	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
+	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	HasAnySyntheticLockups(ctx sdk.Context, lockID uint64) bool
+	ForceUnlock(ctx sdk.Context, lock lockuptypes.PeriodLock) error
+}
@@ -27,9 +27,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TakerFeeRecipient int32
+
+const (
+	// Taker fees are sent to the community pool
+	CommunityPool TakerFeeRecipient = 0
+	// Taker fees are sent to the txfees module, which swaps them to the base
+	// denom through the fee token pools and pays them to stakers. Taker fees in
+	// denoms that aren't fee tokens are sent to the community pool.
+	Stakers TakerFeeRecipient = 1
+)
+
+var TakerFeeRecipient_name = map[int32]string{
+	0: "CommunityPool",
+	1: "Stakers",
+}
+
+var TakerFeeRecipient_value = map[string]int32{
+	"CommunityPool": 0,
+	"Stakers":       1,
+}
+
+func (x TakerFeeRecipient) String() string {
+	return proto.EnumName(TakerFeeRecipient_name, int32(x))
+}
+
+func (TakerFeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{0}
+}
+
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// taker_fee is the fraction of the tokens swapped in that the protocol
+	// charges on every swap, on top of the pool's swap fee.
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+	// taker_fee_overrides replace taker_fee for swaps between specific denoms.
+	TakerFeeOverrides []TakerFeeOverride `protobuf:"bytes,3,rep,name=taker_fee_overrides,json=takerFeeOverrides,proto3" json:"taker_fee_overrides" yaml:"taker_fee_overrides"`
+	// taker_fee_recipient is where the collected taker fees are sent.
+	TakerFeeRecipient TakerFeeRecipient `protobuf:"varint,4,opt,name=taker_fee_recipient,json=takerFeeRecipient,proto3,enum=osmosis.gamm.v1beta1.TakerFeeRecipient" json:"taker_fee_recipient,omitempty" yaml:"taker_fee_recipient"`
+	// pause_guardian is the address allowed to pause the swaps, joins and exits
+	// of any pool with MsgPausePool. Only governance can unpause pools. Empty
+	// if there is no pause guardian.
+	PauseGuardian string `protobuf:"bytes,5,opt,name=pause_guardian,json=pauseGuardian,proto3" json:"pause_guardian,omitempty" yaml:"pause_guardian"`
+	// circuit_breaker_max_price_change is the relative spot price move of a
+	// pool's denom pair within circuit_breaker_window_blocks above which the
+	// pool is paused. Zero disables the circuit breaker.
+	CircuitBreakerMaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=circuit_breaker_max_price_change,json=circuitBreakerMaxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_max_price_change" yaml:"circuit_breaker_max_price_change"`
+	// circuit_breaker_window_blocks is the number of blocks spot price moves
+	// are measured over by the circuit breaker.
+	CircuitBreakerWindowBlocks uint64 `protobuf:"varint,7,opt,name=circuit_breaker_window_blocks,json=circuitBreakerWindowBlocks,proto3" json:"circuit_breaker_window_blocks,omitempty" yaml:"circuit_breaker_window_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,18 +119,112 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetTakerFeeOverrides() []TakerFeeOverride {
+	if m != nil {
+		return m.TakerFeeOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetTakerFeeRecipient() TakerFeeRecipient {
+	if m != nil {
+		return m.TakerFeeRecipient
+	}
+	return CommunityPool
+}
+
+func (m *Params) GetPauseGuardian() string {
+	if m != nil {
+		return m.PauseGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetCircuitBreakerWindowBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindowBlocks
+	}
+	return 0
+}
+
+// TakerFeeOverride is the taker fee of swaps between denom0 and denom1, in
+// either direction.
+type TakerFeeOverride struct {
+	Denom0   string                                 `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1   string                                 `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *TakerFeeOverride) Reset()         { *m = TakerFeeOverride{} }
+func (m *TakerFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeOverride) ProtoMessage()    {}
+func (*TakerFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *TakerFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeOverride.Merge(m, src)
+}
+func (m *TakerFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeOverride proto.InternalMessageInfo
+
+func (m *TakerFeeOverride) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *TakerFeeOverride) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	NextPoolNumber uint64        `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params         Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// positions are the positions of all concentrated liquidity pools
+	Positions []Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
+	// ticks are the initialized ticks of all concentrated liquidity pools
+	Ticks []Tick `protobuf:"bytes,5,rep,name=ticks,proto3" json:"ticks"`
+	// pool_volumes are the swap volumes and fees collected of all pools
+	PoolVolumes []PoolVolume `protobuf:"bytes,6,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes"`
+	// taker_fees_collected are the taker fees collected since genesis
+	TakerFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=taker_fees_collected,json=takerFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_collected"`
+	// pool_pause_statuses are the pause statuses of all pools with paused
+	// operations
+	PoolPauseStatuses []PoolPauseStatus `protobuf:"bytes,8,rep,name=pool_pause_statuses,json=poolPauseStatuses,proto3" json:"pool_pause_statuses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,8 +274,45 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTicks() []Tick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakerFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesCollected
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolPauseStatuses() []PoolPauseStatus {
+	if m != nil {
+		return m.PoolPauseStatuses
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.TakerFeeRecipient", TakerFeeRecipient_name, TakerFeeRecipient_value)
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*TakerFeeOverride)(nil), "osmosis.gamm.v1beta1.TakerFeeOverride")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
 }
 
@@ -143,32 +321,63 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0x86, 0x5b, 0x11, 0xa1, 0x3b, 0x53, 0x50, 0x0a, 0x22, 0x25, 0xee, 0xdc, 0x84, 0x49,
-	0x9c, 0xc6, 0x60, 0x32, 0x5f, 0xc8, 0x4c, 0x4b, 0xf3, 0x16, 0x82, 0x7b, 0x1f, 0x40, 0xb7, 0x3e,
-	0x44, 0x71, 0xd5, 0xa5, 0xab, 0x2a, 0xed, 0x1b, 0xf8, 0x04, 0x32, 0x7f, 0x22, 0x82, 0x77, 0x95,
-	0x7c, 0xf3, 0xfd, 0xce, 0xe1, 0xcc, 0x49, 0xdc, 0x10, 0x44, 0x0d, 0xa2, 0x14, 0xa4, 0xa0, 0x75,
-	0x4d, 0x36, 0x37, 0x19, 0x93, 0xf4, 0x86, 0x14, 0x8c, 0x33, 0x51, 0x0a, 0xdc, 0xb4, 0x20, 0xc1,
-	0x1b, 0x5a, 0x06, 0x2b, 0x06, 0x5b, 0xe6, 0x7a, 0x58, 0x40, 0x01, 0x1a, 0x20, 0xea, 0xcd, 0xb0,
-	0xd7, 0x57, 0x05, 0x40, 0x51, 0x31, 0xa2, 0xa7, 0x6c, 0xbd, 0x22, 0x94, 0x77, 0xfd, 0x2a, 0xd7,
-	0x3e, 0xa9, 0xd1, 0x98, 0xc1, 0xae, 0x02, 0x33, 0x91, 0x8c, 0x0a, 0xf6, 0x37, 0x44, 0x0e, 0x25,
-	0x37, 0xfb, 0xf0, 0x33, 0x72, 0x07, 0x4b, 0xda, 0xd2, 0x5a, 0x78, 0x9f, 0x90, 0xfb, 0xa8, 0x01,
-	0xa8, 0xd2, 0xbc, 0x65, 0x54, 0x96, 0xc0, 0xd3, 0x15, 0x63, 0x3e, 0x1a, 0x9f, 0x45, 0x97, 0xd3,
-	0x2b, 0x6c, 0x5d, 0x95, 0x4f, 0x1f, 0x14, 0xcf, 0xa1, 0xe4, 0xf1, 0xab, 0xdd, 0x61, 0xe4, 0xfc,
-	0x3e, 0x8c, 0xfc, 0x8e, 0xd6, 0xd5, 0x2c, 0xfc, 0xcf, 0x21, 0xfc, 0xf2, 0x73, 0x14, 0x15, 0xa5,
-	0x7c, 0xbf, 0xce, 0x70, 0x0e, 0xb5, 0x8d, 0x67, 0x1f, 0x13, 0xf1, 0xee, 0x03, 0x91, 0x5d, 0xc3,
-	0x84, 0x36, 0x13, 0xc9, 0x03, 0xa5, 0x9f, 0x5b, 0xf9, 0x0b, 0xc6, 0xc2, 0xaf, 0xc8, 0xbd, 0xf7,
-	0xd2, 0x94, 0xf6, 0x46, 0x52, 0xc9, 0xbc, 0xa7, 0xee, 0x85, 0x62, 0x84, 0x4d, 0x36, 0xc4, 0xa6,
-	0x17, 0xdc, 0xf7, 0x82, 0x9f, 0xf3, 0x2e, 0xbe, 0xfb, 0xfd, 0xdb, 0xe4, 0x62, 0x09, 0x50, 0x2d,
-	0x12, 0x43, 0x7b, 0x91, 0xfb, 0x90, 0xb3, 0xad, 0x4c, 0x75, 0x3e, 0xbe, 0xae, 0x33, 0xd6, 0xfa,
-	0x77, 0xc6, 0x28, 0x3a, 0x4f, 0xee, 0xab, 0x73, 0xc5, 0xbe, 0xd6, 0xa7, 0xde, 0xcc, 0x1d, 0x34,
-	0xba, 0x11, 0xff, 0x6c, 0x8c, 0xa2, 0xcb, 0xe9, 0x63, 0x7c, 0xdb, 0x57, 0xc2, 0xa6, 0xb5, 0xf8,
-	0x5c, 0x5d, 0x3f, 0xb1, 0x8a, 0x78, 0xb1, 0x3b, 0x06, 0x68, 0x7f, 0x0c, 0xd0, 0xaf, 0x63, 0x80,
-	0x3e, 0x9e, 0x02, 0x67, 0x7f, 0x0a, 0x9c, 0x1f, 0xa7, 0xc0, 0x79, 0x4b, 0xfe, 0xa9, 0xc0, 0xfa,
-	0x4d, 0x2a, 0x9a, 0x89, 0x7e, 0x20, 0x9b, 0x67, 0x64, 0x6b, 0xfe, 0x15, 0xdd, 0x47, 0x36, 0xd0,
-	0x17, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf2, 0x18, 0x37, 0x48, 0x02, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xb7, 0x49, 0xba, 0x9d, 0x6e, 0x4b, 0x32, 0x5b, 0x24, 0x37, 0x5a, 0x9c, 0x60, 0x60,
+	0x37, 0x20, 0xd5, 0xde, 0x14, 0x01, 0x62, 0x4f, 0xe0, 0x20, 0x56, 0x95, 0xf8, 0x53, 0xb9, 0x08,
+	0x04, 0x1c, 0xac, 0xb1, 0x33, 0xeb, 0x1d, 0xc5, 0xf6, 0x58, 0x9e, 0x71, 0xdb, 0x20, 0x71, 0xe7,
+	0x88, 0xc4, 0x27, 0x40, 0xdc, 0x38, 0xf3, 0x1d, 0x58, 0x38, 0xa0, 0x3d, 0x22, 0x0e, 0x01, 0xb5,
+	0xdf, 0x20, 0x9f, 0x00, 0xcd, 0x1f, 0x67, 0x9b, 0x6e, 0xc2, 0x52, 0x69, 0x4f, 0xf1, 0xbc, 0xf7,
+	0xfb, 0xf3, 0xde, 0xfc, 0x79, 0x01, 0x36, 0x65, 0x29, 0x65, 0x84, 0xb9, 0x31, 0x4a, 0x53, 0xf7,
+	0x78, 0x10, 0x62, 0x8e, 0x06, 0x6e, 0x8c, 0x33, 0xcc, 0x08, 0x73, 0xf2, 0x82, 0x72, 0x0a, 0x77,
+	0x34, 0xc6, 0x11, 0x18, 0x47, 0x63, 0x3a, 0x3b, 0x31, 0x8d, 0xa9, 0x04, 0xb8, 0xe2, 0x4b, 0x61,
+	0x3b, 0xbb, 0x31, 0xa5, 0x71, 0x82, 0x5d, 0xb9, 0x0a, 0xcb, 0x07, 0x2e, 0xca, 0x26, 0x55, 0x2a,
+	0x92, 0x3a, 0x81, 0xe2, 0xa8, 0x85, 0x4e, 0x59, 0x6a, 0xe5, 0x86, 0x88, 0xe1, 0x79, 0x11, 0x11,
+	0x25, 0x99, 0xce, 0xbf, 0xb2, 0xb4, 0xca, 0x9c, 0x32, 0xc2, 0x09, 0xad, 0x40, 0x2f, 0x2f, 0x05,
+	0x1d, 0xd3, 0xa4, 0x4c, 0xb1, 0x86, 0xf4, 0x96, 0xeb, 0xa0, 0x92, 0x69, 0x84, 0xfd, 0x6b, 0x13,
+	0x34, 0x0f, 0x51, 0x81, 0x52, 0x06, 0x7f, 0x30, 0x40, 0x3b, 0xa7, 0x34, 0x09, 0xa2, 0x02, 0x23,
+	0xe1, 0x13, 0x3c, 0xc0, 0xd8, 0x34, 0x7a, 0x6b, 0xfd, 0xcd, 0xfd, 0x5d, 0x47, 0xd7, 0x2f, 0x2a,
+	0xae, 0xb6, 0xc4, 0x19, 0x52, 0x92, 0x79, 0x1f, 0x3d, 0x9a, 0x76, 0x6b, 0xb3, 0x69, 0xd7, 0x9c,
+	0xa0, 0x34, 0xb9, 0x67, 0x3f, 0xa5, 0x60, 0xff, 0xfc, 0x77, 0xb7, 0x1f, 0x13, 0xfe, 0xb0, 0x0c,
+	0x9d, 0x88, 0xa6, 0x7a, 0x23, 0xf4, 0xcf, 0x1e, 0x1b, 0x8d, 0x5d, 0x3e, 0xc9, 0x31, 0x93, 0x62,
+	0xcc, 0x7f, 0x41, 0xf0, 0x87, 0x9a, 0xfe, 0x21, 0xc6, 0x30, 0x00, 0x1b, 0x1c, 0x8d, 0x71, 0x21,
+	0x8b, 0xb9, 0xd6, 0x33, 0xfa, 0x1b, 0x9e, 0x27, 0x1c, 0xff, 0x9a, 0x76, 0x6f, 0xff, 0x0f, 0xd5,
+	0x0f, 0x70, 0x34, 0x9b, 0x76, 0x5b, 0xaa, 0xb6, 0xb9, 0x90, 0xed, 0x5f, 0x97, 0xdf, 0xc2, 0xe0,
+	0x1b, 0x70, 0x73, 0x1e, 0x0f, 0xe8, 0x31, 0x2e, 0x0a, 0x32, 0xc2, 0xcc, 0x5c, 0x93, 0x7d, 0xdf,
+	0x76, 0x96, 0xdd, 0x05, 0xe7, 0x33, 0x4d, 0xfe, 0x54, 0xc3, 0x3d, 0x5b, 0x6f, 0x42, 0xe7, 0x92,
+	0xd1, 0x13, 0x41, 0xdb, 0x6f, 0xf3, 0x4b, 0x2c, 0x06, 0x4f, 0x2e, 0x7a, 0x17, 0x38, 0x22, 0x39,
+	0xc1, 0x19, 0x37, 0xeb, 0x3d, 0xa3, 0xbf, 0xbd, 0x7f, 0xe7, 0xbf, 0xbd, 0xfd, 0x0a, 0xee, 0x59,
+	0xcb, 0x8c, 0xe7, 0x6a, 0x17, 0x8c, 0xe7, 0x14, 0xf8, 0x1e, 0xd8, 0x96, 0xb7, 0x20, 0x88, 0x4b,
+	0x54, 0x8c, 0x08, 0xca, 0xcc, 0x86, 0xdc, 0xda, 0xdd, 0xd9, 0xb4, 0xfb, 0xa2, 0x3e, 0xc8, 0x85,
+	0xbc, 0xed, 0x6f, 0xc9, 0xc0, 0x7d, 0xbd, 0x86, 0x3f, 0x1a, 0xa0, 0x17, 0x91, 0x22, 0x2a, 0x09,
+	0x0f, 0xc2, 0x02, 0x4b, 0xdf, 0x14, 0x9d, 0x06, 0x79, 0x41, 0x22, 0x1c, 0x44, 0x0f, 0x51, 0x16,
+	0x63, 0xb3, 0x29, 0x45, 0xbf, 0xbc, 0xf2, 0x79, 0xdd, 0x51, 0x25, 0x3c, 0x4b, 0xdf, 0xf6, 0x6f,
+	0x69, 0x88, 0xa7, 0x10, 0x1f, 0xa3, 0xd3, 0x43, 0x91, 0x1f, 0xca, 0x34, 0x1c, 0x83, 0x97, 0x2e,
+	0x4b, 0x9c, 0x90, 0x6c, 0x44, 0x4f, 0x82, 0x30, 0xa1, 0xd1, 0x98, 0x99, 0xeb, 0x3d, 0xa3, 0x5f,
+	0xf7, 0xfa, 0xb3, 0x69, 0xf7, 0xd5, 0xe5, 0x8e, 0x0b, 0x70, 0xdb, 0xef, 0x2c, 0xda, 0x7d, 0x21,
+	0xb3, 0x9e, 0x4a, 0xfe, 0x66, 0x80, 0xd6, 0xe5, 0x7b, 0x01, 0x5f, 0x07, 0xcd, 0x11, 0xce, 0x68,
+	0x7a, 0xd7, 0x34, 0xe4, 0x56, 0xb4, 0x67, 0xd3, 0xee, 0x96, 0xb2, 0x52, 0x71, 0xdb, 0xd7, 0x80,
+	0x39, 0x74, 0x60, 0x5e, 0x5b, 0x0a, 0x1d, 0x54, 0xd0, 0xc1, 0xe2, 0x9b, 0x58, 0x7b, 0xfe, 0x6f,
+	0xc2, 0xfe, 0xa3, 0x0e, 0x6e, 0xdc, 0x57, 0x33, 0xf1, 0x88, 0x23, 0x8e, 0xe1, 0x5b, 0xa0, 0x21,
+	0x1e, 0x26, 0xd3, 0xe3, 0x60, 0xc7, 0x51, 0x63, 0xcf, 0xa9, 0xc6, 0x9e, 0xf3, 0x7e, 0x36, 0xf1,
+	0x36, 0x7e, 0xff, 0x65, 0xaf, 0x71, 0x48, 0x69, 0x72, 0xe0, 0x2b, 0x34, 0xec, 0x83, 0x56, 0x86,
+	0x4f, 0x79, 0x20, 0x56, 0x41, 0x56, 0xa6, 0x21, 0x2e, 0x64, 0x77, 0x75, 0x7f, 0x5b, 0xc4, 0x05,
+	0xf6, 0x13, 0x19, 0x85, 0xf7, 0x40, 0x33, 0x97, 0x63, 0x48, 0xf6, 0xb3, 0xb9, 0x7f, 0x6b, 0xf9,
+	0xe5, 0x57, 0xa3, 0xca, 0xab, 0x8b, 0x6e, 0x7d, 0xcd, 0x80, 0x1e, 0xd8, 0xa8, 0x46, 0x23, 0x33,
+	0xeb, 0xb2, 0x40, 0x6b, 0x05, 0x5d, 0xc3, 0xb4, 0xc0, 0x13, 0x1a, 0x7c, 0x1b, 0x34, 0x38, 0x11,
+	0x57, 0xa2, 0x21, 0xf9, 0x9d, 0x15, 0x6f, 0x8f, 0x44, 0x63, 0xcd, 0x55, 0x70, 0x78, 0x00, 0x6e,
+	0xc8, 0xe6, 0xd4, 0xd8, 0x65, 0x66, 0x53, 0xd2, 0x7b, 0xab, 0xec, 0x69, 0xf2, 0xb9, 0x04, 0x6a,
+	0x91, 0xcd, 0x7c, 0x1e, 0x61, 0xf0, 0x5b, 0xb0, 0x33, 0x3f, 0x0c, 0x16, 0x44, 0x34, 0x49, 0x70,
+	0xc4, 0xf1, 0xc8, 0x5c, 0x7f, 0xd6, 0x04, 0xbe, 0x2b, 0xb4, 0xae, 0x34, 0x65, 0x61, 0x75, 0xd2,
+	0x6c, 0x58, 0xd9, 0xc0, 0xaf, 0xc1, 0x4d, 0xd9, 0x89, 0x7a, 0xf7, 0x8c, 0x23, 0x5e, 0x32, 0xcc,
+	0xcc, 0xeb, 0xd2, 0xfd, 0xb5, 0xd5, 0x0d, 0x1d, 0x0a, 0xfc, 0x91, 0x84, 0xeb, 0xae, 0xda, 0xf9,
+	0x62, 0x18, 0xb3, 0x37, 0xde, 0x05, 0xed, 0xa7, 0xe6, 0x16, 0x6c, 0x83, 0xad, 0x21, 0x4d, 0xd3,
+	0x32, 0x23, 0x7c, 0x22, 0x94, 0x5a, 0x35, 0xb8, 0x09, 0xd6, 0x8f, 0x64, 0x6d, 0xac, 0x65, 0x74,
+	0xea, 0xdf, 0xfd, 0x64, 0xd5, 0xbc, 0x83, 0x47, 0x67, 0x96, 0xf1, 0xf8, 0xcc, 0x32, 0xfe, 0x39,
+	0xb3, 0x8c, 0xef, 0xcf, 0xad, 0xda, 0xe3, 0x73, 0xab, 0xf6, 0xe7, 0xb9, 0x55, 0xfb, 0xca, 0xbd,
+	0xd0, 0xaf, 0x2e, 0x6f, 0x2f, 0x41, 0x21, 0xab, 0x16, 0xee, 0xf1, 0x3b, 0xee, 0xa9, 0xfa, 0xeb,
+	0x93, 0xcd, 0x87, 0x4d, 0x79, 0x5d, 0xdf, 0xfc, 0x77, 0x00, 0xab, 0xc8, 0x63, 0xf3, 0x05, 0x08,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +400,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerWindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CircuitBreakerWindowBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CircuitBreakerMaxPriceChange.Size()
+		i -= size
+		if _, err := m.CircuitBreakerMaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PauseGuardian) > 0 {
+		i -= len(m.PauseGuardian)
+		copy(dAtA[i:], m.PauseGuardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PauseGuardian)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TakerFeeRecipient != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TakerFeeRecipient))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TakerFeeOverrides) > 0 {
+		for iNdEx := len(m.TakerFeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +468,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -228,6 +535,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolPauseStatuses) > 0 {
+		for iNdEx := len(m.PoolPauseStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolPauseStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TakerFeesCollected) > 0 {
+		for iNdEx := len(m.TakerFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +660,45 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TakerFeeOverrides) > 0 {
+		for _, e := range m.TakerFeeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TakerFeeRecipient != 0 {
+		n += 1 + sovGenesis(uint64(m.TakerFeeRecipient))
+	}
+	l = len(m.PauseGuardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CircuitBreakerMaxPriceChange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CircuitBreakerWindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.CircuitBreakerWindowBlocks))
+	}
+	return n
+}
+
+func (m *TakerFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -303,6 +719,36 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeesCollected) > 0 {
+		for _, e := range m.TakerFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolPauseStatuses) > 0 {
+		for _, e := range m.PoolPauseStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,23 +821,343 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeOverrides = append(m.TakerFeeOverrides, TakerFeeOverride{})
+			if err := m.TakerFeeOverrides[len(m.TakerFeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRecipient", wireType)
+			}
+			m.TakerFeeRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeRecipient |= TakerFeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerMaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindowBlocks", wireType)
+			}
+			m.CircuitBreakerWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -511,6 +1277,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, Tick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesCollected = append(m.TakerFeesCollected, types.Coin{})
+			if err := m.TakerFeesCollected[len(m.TakerFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPauseStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolPauseStatuses = append(m.PoolPauseStatuses, PoolPauseStatus{})
+			if err := m.PoolPauseStatuses[len(m.PoolPauseStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolPauseStatus = "SetPoolPauseStatus"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolPauseStatus)
+	govtypes.RegisterProposalTypeCodec(&SetPoolPauseStatusProposal{}, "osmosis/SetPoolPauseStatusProposal")
+}
+
+var _ govtypes.Content = &SetPoolPauseStatusProposal{}
+
+func NewSetPoolPauseStatusProposal(title, description string, pauseStatus PoolPauseStatus) govtypes.Content {
+	return &SetPoolPauseStatusProposal{
+		Title:       title,
+		Description: description,
+		PauseStatus: pauseStatus,
+	}
+}
+
+func (p *SetPoolPauseStatusProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolPauseStatusProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolPauseStatusProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolPauseStatusProposal) ProposalType() string {
+	return ProposalTypeSetPoolPauseStatus
+}
+
+func (p *SetPoolPauseStatusProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PauseStatus.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	return nil
+}
+
+func (p SetPoolPauseStatusProposal) String() string {
+	return fmt.Sprintf(`Set Pool Pause Status Proposal:
+  Title:        %s
+  Description:  %s
+  Pool Id:      %d
+  Swaps Paused: %t
+  Joins Paused: %t
+  Exits Paused: %t
+`, p.Title, p.Description, p.PauseStatus.PoolId, p.PauseStatus.SwapsPaused, p.PauseStatus.JoinsPaused, p.PauseStatus.ExitsPaused)
+}
@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetPoolPauseStatusProposal is a gov Content type for pausing or unpausing the
+// swaps, joins and exits of a pool. Unlike the pause guardian, governance can
+// also unpause a pool, including one paused by the circuit breaker.
+type SetPoolPauseStatusProposal struct {
+	Title       string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PauseStatus PoolPauseStatus `protobuf:"bytes,3,opt,name=pause_status,json=pauseStatus,proto3" json:"pause_status" yaml:"pause_status"`
+}
+
+func (m *SetPoolPauseStatusProposal) Reset()      { *m = SetPoolPauseStatusProposal{} }
+func (*SetPoolPauseStatusProposal) ProtoMessage() {}
+func (*SetPoolPauseStatusProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{0}
+}
+func (m *SetPoolPauseStatusProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolPauseStatusProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolPauseStatusProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolPauseStatusProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolPauseStatusProposal.Merge(m, src)
+}
+func (m *SetPoolPauseStatusProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolPauseStatusProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolPauseStatusProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolPauseStatusProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetPoolPauseStatusProposal)(nil), "osmosis.gamm.v1beta1.SetPoolPauseStatusProposal")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
+
+var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0x03, 0x31,
+	0x14, 0xc7, 0x13, 0x45, 0xc1, 0x6b, 0x07, 0x39, 0x8b, 0x94, 0x0a, 0x49, 0x39, 0x50, 0xba, 0x98,
+	0x50, 0x1d, 0x94, 0x8e, 0xdd, 0xdc, 0x4a, 0xbb, 0xb9, 0x48, 0xae, 0x86, 0xf3, 0xe0, 0xce, 0x17,
+	0x9a, 0xb4, 0xd8, 0x6f, 0xe0, 0xe8, 0xe8, 0xd8, 0x8f, 0xd3, 0xb1, 0xa3, 0xd3, 0x21, 0xbd, 0xc5,
+	0xf9, 0xfc, 0x02, 0x72, 0xc9, 0x89, 0x87, 0x74, 0x4b, 0xde, 0xef, 0xc7, 0x7b, 0xff, 0xf7, 0x3c,
+	0x02, 0x3a, 0x05, 0x1d, 0x6b, 0x1e, 0x89, 0x34, 0xe5, 0x8b, 0x7e, 0x28, 0x8d, 0xe8, 0xf3, 0x08,
+	0x16, 0x4c, 0xcd, 0xc0, 0x80, 0xdf, 0xaa, 0x38, 0x2b, 0x39, 0xab, 0x78, 0xa7, 0x15, 0x41, 0x04,
+	0x56, 0xe0, 0xe5, 0xcb, 0xb9, 0x9d, 0xee, 0xce, 0x5e, 0x4a, 0xcc, 0xb5, 0x74, 0x46, 0xf0, 0x8d,
+	0xbd, 0xce, 0x44, 0x9a, 0x11, 0x40, 0x32, 0x2a, 0xcb, 0x13, 0x23, 0xcc, 0x5c, 0x8f, 0x66, 0xa0,
+	0x40, 0x8b, 0xc4, 0xbf, 0xf0, 0x0e, 0x4c, 0x6c, 0x12, 0xd9, 0xc6, 0x5d, 0xdc, 0x3b, 0x1a, 0x1e,
+	0x17, 0x19, 0x6d, 0x2e, 0x45, 0x9a, 0x0c, 0x02, 0x5b, 0x0e, 0xc6, 0x0e, 0xfb, 0xb7, 0x5e, 0xe3,
+	0x51, 0xea, 0xe9, 0x2c, 0x56, 0x26, 0x86, 0xe7, 0xf6, 0x9e, 0xb5, 0x4f, 0x8b, 0x8c, 0xfa, 0xce,
+	0xae, 0xc1, 0x60, 0x5c, 0x57, 0x7d, 0xe9, 0x35, 0x6d, 0x9e, 0x07, 0x6d, 0x27, 0xb7, 0xf7, 0xbb,
+	0xb8, 0xd7, 0xb8, 0x3a, 0x67, 0xbb, 0xb6, 0x64, 0xff, 0x62, 0x0e, 0xcf, 0xd6, 0x19, 0x45, 0x45,
+	0x46, 0x4f, 0xdc, 0x94, 0x7a, 0xa3, 0x60, 0xdc, 0x50, 0x7f, 0xe6, 0xa0, 0xf9, 0xba, 0xa2, 0xe8,
+	0x7d, 0x45, 0xd1, 0xd7, 0x8a, 0xe2, 0xe1, 0xdd, 0x7a, 0x4b, 0xf0, 0x66, 0x4b, 0xf0, 0xe7, 0x96,
+	0xe0, 0xb7, 0x9c, 0xa0, 0x4d, 0x4e, 0xd0, 0x47, 0x4e, 0xd0, 0x3d, 0x8f, 0x62, 0xf3, 0x34, 0x0f,
+	0xd9, 0x14, 0x52, 0x5e, 0x45, 0xb8, 0x4c, 0x44, 0xa8, 0x7f, 0x3f, 0x7c, 0x71, 0xc3, 0x5f, 0xdc,
+	0x39, 0xcd, 0x52, 0x49, 0x1d, 0x1e, 0xda, 0x3b, 0x5e, 0xff, 0x0c, 0x00, 0xc6, 0x27, 0xde, 0x34,
+	0xb7, 0x01, 0x00, 0x00,
+}
+
+func (this *SetPoolPauseStatusProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolPauseStatusProposal)
+	if !ok {
+		that2, ok := that.(SetPoolPauseStatusProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.PauseStatus.Equal(&that1.PauseStatus) {
+		return false
+	}
+	return true
+}
+func (m *SetPoolPauseStatusProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolPauseStatusProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolPauseStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetPoolPauseStatusProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.PauseStatus.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetPoolPauseStatusProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolPauseStatusProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolPauseStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,11 +11,22 @@ type GammHooks interface {
 	AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins)
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut
 	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// BeforeJoinPool is called before the tokens of JoinPool, JoinSwapExternAmountIn, and JoinSwapShareAmountOut
+	// are sent to the pool. Returning an error aborts the join.
+	BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error
+	// BeforeExitPool is called before the tokens of ExitPool, ExitSwapShareAmountIn, and ExitSwapExternAmountOut
+	// are sent from the pool. Returning an error aborts the exit.
+	BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error
+	// BeforeSwap is called before the tokens of SwapExactAmountIn and SwapExactAmountOut are exchanged with the pool,
+	// and when a batch swap is queued, with its min amount out as the output. Returning an error aborts the swap.
+	BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error
 }
 
 var _ GammHooks = MultiGammHooks{}
 
 // combine multiple gamm hooks, all hook functions are run in array sequence.
+// The Before hooks stop at the first error, which is returned.
 type MultiGammHooks []GammHooks
 
 // Creates hooks for the Gamm Module.
@@ -46,3 +57,30 @@ func (h MultiGammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId
 		h[i].AfterSwap(ctx, sender, poolId, input, output)
 	}
 }
+
+func (h MultiGammHooks) BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error {
+	for i := range h {
+		if err := h[i].BeforeJoinPool(ctx, sender, poolId, enterCoins, shareOutAmount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiGammHooks) BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeExitPool(ctx, sender, poolId, shareInAmount, exitCoins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiGammHooks) BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSwap(ctx, sender, poolId, input, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
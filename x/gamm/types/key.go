@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,6 +26,36 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixPositions defines prefix to store concentrated liquidity positions.
+	KeyPrefixPositions = []byte{0x04}
+	// KeyPrefixTicks defines prefix to store the initialized ticks of
+	// concentrated liquidity pools.
+	KeyPrefixTicks = []byte{0x05}
+	// KeyPrefixPoolVolume defines prefix to store the swap volume of pools.
+	KeyPrefixPoolVolume = []byte{0x06}
+	// KeyPrefixPoolFeesCollected defines prefix to store the swap fees collected by pools.
+	KeyPrefixPoolFeesCollected = []byte{0x07}
+	// KeyPrefixTakerFeesCollected defines prefix to store the taker fees collected, by denom.
+	KeyPrefixTakerFeesCollected = []byte{0x08}
+	// KeyPrefixPoolPauseStatus defines prefix to store the pause status of pools.
+	KeyPrefixPoolPauseStatus = []byte{0x09}
+	// KeyPrefixCircuitBreakerReferences defines prefix to store the circuit breaker
+	// reference spot prices of pools.
+	KeyPrefixCircuitBreakerReferences = []byte{0x0A}
+	// KeyPrefixDenomPools defines prefix to store the ids of the pools
+	// containing each denom.
+	KeyPrefixDenomPools = []byte{0x0B}
+	// KeyPrefixFlashSwapLocks defines prefix to store the ids of the pools
+	// locked by a flash swap in progress.
+	KeyPrefixFlashSwapLocks = []byte{0x0C}
+	// KeyPrefixBatchSwapOrders defines prefix to store the batch swap orders
+	// queued during the block.
+	KeyPrefixBatchSwapOrders = []byte{0x0D}
+	// KeyNextBatchSwapOrderId defines key to store the next batch swap order ID to be used.
+	KeyNextBatchSwapOrderId = []byte{0x0E}
+
+	// KeySeparator separates the denoms of keys, as it can't be part of a denom.
+	KeySeparator = []byte("|")
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +87,84 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+// GetKeyPrefixPoolPositions returns the prefix of all the positions of a
+// concentrated liquidity pool.
+func GetKeyPrefixPoolPositions(poolId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPosition returns the key of the position of owner over
+// [lowerTick, upperTick) in the given pool.
+func GetKeyPosition(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) []byte {
+	key := GetKeyPrefixPoolPositions(poolId)
+	key = append(key, address.MustLengthPrefix(owner)...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(lowerTick))...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(upperTick))...)
+}
+
+// GetKeyPrefixPoolTicks returns the prefix of all the initialized ticks of a
+// concentrated liquidity pool.
+func GetKeyPrefixPoolTicks(poolId uint64) []byte {
+	return append(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// TickIndexToBytes encodes a tick index so that the byte order of encoded
+// ticks is their numerical order, negative ticks included.
+func TickIndexToBytes(index int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(index) ^ (1 << 63))
+}
+
+// GetKeyPrefixPoolVolume returns the prefix of the swap volume of a pool, by denom.
+func GetKeyPrefixPoolVolume(poolId uint64) []byte {
+	return append(KeyPrefixPoolVolume, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPrefixPoolFeesCollected returns the prefix of the swap fees collected
+// by a pool, by denom.
+func GetKeyPrefixPoolFeesCollected(poolId uint64) []byte {
+	return append(KeyPrefixPoolFeesCollected, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPoolPauseStatus returns the key of the pause status of a pool.
+func GetKeyPoolPauseStatus(poolId uint64) []byte {
+	return append(KeyPrefixPoolPauseStatus, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPrefixCircuitBreakerReferences returns the prefix of the circuit
+// breaker reference spot prices of a pool.
+func GetKeyPrefixCircuitBreakerReferences(poolId uint64) []byte {
+	return append(KeyPrefixCircuitBreakerReferences, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyCircuitBreakerReference returns the key of the circuit breaker
+// reference spot price of denom0 in terms of denom1 in a pool.
+func GetKeyCircuitBreakerReference(poolId uint64, denom0, denom1 string) []byte {
+	key := GetKeyPrefixCircuitBreakerReferences(poolId)
+	key = append(key, []byte(denom0)...)
+	key = append(key, KeySeparator...)
+	return append(key, []byte(denom1)...)
+}
+
+// GetKeyPrefixDenomPools returns the prefix of the ids of the pools containing
+// denom.
+func GetKeyPrefixDenomPools(denom string) []byte {
+	key := append(KeyPrefixDenomPools, []byte(denom)...)
+	return append(key, KeySeparator...)
+}
+
+// GetKeyDenomPool returns the key indexing a pool under a denom it contains.
+func GetKeyDenomPool(denom string, poolId uint64) []byte {
+	return append(GetKeyPrefixDenomPools(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyFlashSwapLock returns the key locking a pool during a flash swap.
+func GetKeyFlashSwapLock(poolId uint64) []byte {
+	return append(KeyPrefixFlashSwapLocks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyBatchSwapOrder returns the key of a batch swap order queued in a pool.
+func GetKeyBatchSwapOrder(poolId, orderId uint64) []byte {
+	key := append(KeyPrefixBatchSwapOrders, sdk.Uint64ToBigEndian(poolId)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -22,3 +23,10 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestTickIndexToBytes(t *testing.T) {
+	ticks := []int64{math.MinInt64, -200000, -1, 0, 1, 200000, math.MaxInt64}
+	for i := 1; i < len(ticks); i++ {
+		require.Equal(t, -1, bytes.Compare(TickIndexToBytes(ticks[i-1]), TickIndexToBytes(ticks[i])), "ticks %d and %d", ticks[i-1], ticks[i])
+	}
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // SwapMsg defines a simple interface for getting the token denoms on a swap message route.
 type SwapMsgRoute interface {
 	TokenInDenom() string
@@ -46,3 +53,28 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+// SwapGuards are the optional conditions a swap is rejected under, besides
+// its slippage bound. The zero value rejects nothing.
+type SwapGuards struct {
+	// Deadline is the latest block time the swap can happen at.
+	Deadline *time.Time
+	// MaxPriceImpact is the largest fraction the swap may move the spot price
+	// of each pool it swaps through by.
+	MaxPriceImpact *sdk.Dec
+}
+
+func (guards SwapGuards) Validate() error {
+	if guards.MaxPriceImpact != nil && (guards.MaxPriceImpact.IsNil() || !guards.MaxPriceImpact.IsPositive()) {
+		return sdkerrors.Wrapf(ErrInvalidMaxPriceImpact, "max price impact must be positive: %s", guards.MaxPriceImpact)
+	}
+	return nil
+}
+
+func (msg MsgSwapExactAmountIn) SwapGuards() SwapGuards {
+	return SwapGuards{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact}
+}
+
+func (msg MsgSwapExactAmountOut) SwapGuards() SwapGuards {
+	return SwapGuards{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact}
+}
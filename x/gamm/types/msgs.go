@@ -11,14 +11,21 @@ import (
 
 // constants.
 const (
-	TypeMsgSwapExactAmountIn       = "swap_exact_amount_in"
-	TypeMsgSwapExactAmountOut      = "swap_exact_amount_out"
-	TypeMsgJoinPool                = "join_pool"
-	TypeMsgExitPool                = "exit_pool"
-	TypeMsgJoinSwapExternAmountIn  = "join_swap_extern_amount_in"
-	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
-	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
-	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgSwapExactAmountIn            = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut           = "swap_exact_amount_out"
+	TypeMsgJoinPool                     = "join_pool"
+	TypeMsgExitPool                     = "exit_pool"
+	TypeMsgJoinSwapExternAmountIn       = "join_swap_extern_amount_in"
+	TypeMsgJoinSwapShareAmountOut       = "join_swap_share_amount_out"
+	TypeMsgExitSwapExternAmountOut      = "exit_swap_extern_amount_out"
+	TypeMsgExitSwapShareAmountIn        = "exit_swap_share_amount_in"
+	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
+	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
+	TypeMsgMigrateShares                = "migrate_shares"
+	TypeMsgPausePool                    = "pause_pool"
+	TypeMsgJoinPoolAndLock              = "join_pool_and_lock"
+	TypeMsgExitLockedPool               = "exit_locked_pool"
+	TypeMsgBatchSwap                    = "batch_swap"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -85,7 +92,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return ErrNotPositiveCriteria
 	}
 
-	return nil
+	return msg.SwapGuards().Validate()
 }
 
 func (msg MsgSwapExactAmountIn) GetSignBytes() []byte {
@@ -123,7 +130,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return ErrNotPositiveCriteria
 	}
 
-	return nil
+	return msg.SwapGuards().Validate()
 }
 
 func (msg MsgSwapExactAmountOut) GetSignBytes() []byte {
@@ -347,3 +354,269 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountInSplitRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountOut{}
+
+func (msg MsgSplitRouteSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountOut) Type() string  { return TypeMsgSplitRouteSwapExactAmountOut }
+func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountOutSplitRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigrateShares{}
+
+func (msg MsgMigrateShares) Route() string { return RouterKey }
+func (msg MsgMigrateShares) Type() string  { return TypeMsgMigrateShares }
+func (msg MsgMigrateShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.FromPoolId == msg.ToPoolId {
+		return sdkerrors.Wrapf(ErrMigrateToSamePool, "pool id %d", msg.FromPoolId)
+	}
+
+	if !msg.ShareInAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPausePool{}
+
+func (msg MsgPausePool) Route() string { return RouterKey }
+func (msg MsgPausePool) Type() string  { return TypeMsgPausePool }
+func (msg MsgPausePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.PauseSwaps && !msg.PauseJoins && !msg.PauseExits {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one of swaps, joins or exits must be paused")
+	}
+
+	return nil
+}
+
+func (msg MsgPausePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPausePool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgJoinPoolAndLock{}
+
+func (msg MsgJoinPoolAndLock) Route() string { return RouterKey }
+func (msg MsgJoinPoolAndLock) Type() string  { return TypeMsgJoinPoolAndLock }
+func (msg MsgJoinPoolAndLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.ShareOutAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveRequireAmount, msg.ShareOutAmount.String())
+	}
+
+	tokenInMaxs := sdk.Coins(msg.TokenInMaxs)
+	if !tokenInMaxs.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenInMaxs.String())
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration must be positive: %s", msg.Duration)
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPoolAndLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPoolAndLock) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitLockedPool{}
+
+func (msg MsgExitLockedPool) Route() string { return RouterKey }
+func (msg MsgExitLockedPool) Type() string  { return TypeMsgExitLockedPool }
+func (msg MsgExitLockedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.LockIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no lock ids given")
+	}
+
+	lockIds := make(map[uint64]bool, len(msg.LockIds))
+	for _, lockId := range msg.LockIds {
+		if lockIds[lockId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock id %d", lockId)
+		}
+		lockIds[lockId] = true
+	}
+
+	tokenOutMins := sdk.Coins(msg.TokenOutMins)
+	if !tokenOutMins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenOutMins.String())
+	}
+
+	return nil
+}
+
+func (msg MsgExitLockedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitLockedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBatchSwap{}
+
+func (msg MsgBatchSwap) Route() string { return RouterKey }
+func (msg MsgBatchSwap) Type() string  { return TypeMsgBatchSwap }
+func (msg MsgBatchSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot swap %s for itself", msg.TokenOutDenom)
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+
+func (msg MsgBatchSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBatchSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -130,6 +131,26 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "deadline and max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountIn) MsgSwapExactAmountIn {
+				deadline := time.Unix(1700000000, 0)
+				maxPriceImpact := sdk.NewDecWithPrec(5, 2)
+				msg.Deadline = &deadline
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountIn) MsgSwapExactAmountIn {
+				maxPriceImpact := sdk.ZeroDec()
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -260,6 +281,26 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "deadline and max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountOut) MsgSwapExactAmountOut {
+				deadline := time.Unix(1700000000, 0)
+				maxPriceImpact := sdk.NewDecWithPrec(5, 2)
+				msg.Deadline = &deadline
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountOut) MsgSwapExactAmountOut {
+				maxPriceImpact := sdk.ZeroDec()
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -867,3 +908,668 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		properMsg := MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []SwapAmountInSplitRoute{{
+				Pools: []SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(100),
+			}, {
+				Pools: []SwapAmountInRoute{{
+					PoolId:        1,
+					TokenOutDenom: "test3",
+				}, {
+					PoolId:        2,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(50),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty route pools",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes ending in different denoms",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[1].TokenOutDenom = "test4"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+		properMsg := MsgSplitRouteSwapExactAmountOut{
+			Sender: addr1,
+			Routes: []SwapAmountOutSplitRoute{{
+				Pools: []SwapAmountOutRoute{{
+					PoolId:       0,
+					TokenInDenom: "test",
+				}},
+				TokenOutAmount: sdk.NewInt(100),
+			}, {
+				Pools: []SwapAmountOutRoute{{
+					PoolId:       1,
+					TokenInDenom: "test",
+				}, {
+					PoolId:       2,
+					TokenInDenom: "test3",
+				}},
+				TokenOutAmount: sdk.NewInt(50),
+			}},
+			TokenOutDenom:    "test2",
+			TokenInMaxAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_out")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSplitRouteSwapExactAmountOut
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes starting from different denoms",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools[0].TokenInDenom = "test4"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative route amount",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].TokenOutAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountOut) MsgSplitRouteSwapExactAmountOut {
+				msg.TokenInMaxAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgMigrateShares(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgMigrateShares) MsgMigrateShares) MsgMigrateShares {
+		properMsg := MsgMigrateShares{
+			Sender:            addr1,
+			FromPoolId:        1,
+			ToPoolId:          2,
+			ShareInAmount:     sdk.NewInt(10),
+			ShareOutMinAmount: sdk.NewInt(1),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "migrate_shares")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgMigrateShares
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same pool",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				msg.ToPoolId = msg.FromPoolId
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero share in amount",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				msg.ShareInAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative share in amount",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				msg.ShareInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg MsgMigrateShares) MsgMigrateShares {
+				msg.ShareOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgPausePool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgPausePool) MsgPausePool) MsgPausePool {
+		properMsg := MsgPausePool{
+			Sender:     addr1,
+			PoolId:     1,
+			PauseSwaps: true,
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgPausePool) MsgPausePool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "pause_pool")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgPausePool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgPausePool) MsgPausePool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "pause joins and exits",
+			msg: createMsg(func(msg MsgPausePool) MsgPausePool {
+				msg.PauseSwaps = false
+				msg.PauseJoins = true
+				msg.PauseExits = true
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgPausePool) MsgPausePool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nothing paused",
+			msg: createMsg(func(msg MsgPausePool) MsgPausePool {
+				msg.PauseSwaps = false
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgJoinPoolAndLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+		properMsg := MsgJoinPoolAndLock{
+			Sender:         addr1,
+			PoolId:         1,
+			ShareOutAmount: sdk.NewInt(10),
+			TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin("test", 10), sdk.NewInt64Coin("test2", 20)),
+			Duration:       time.Hour,
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "join_pool_and_lock")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgJoinPoolAndLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero share out amount",
+			msg: createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+				msg.ShareOutAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in maxs",
+			msg: createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+				msg.TokenInMaxs = []sdk.Coin{{Denom: "test", Amount: sdk.NewInt(-10)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg MsgJoinPoolAndLock) MsgJoinPoolAndLock {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgExitLockedPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgExitLockedPool) MsgExitLockedPool) MsgExitLockedPool {
+		properMsg := MsgExitLockedPool{
+			Sender:       addr1,
+			PoolId:       1,
+			LockIds:      []uint64{1, 2},
+			TokenOutMins: sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "exit_locked_pool")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgExitLockedPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no lock ids",
+			msg: createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+				msg.LockIds = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock id",
+			msg: createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+				msg.LockIds = []uint64{1, 2, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out mins",
+			msg: createMsg(func(msg MsgExitLockedPool) MsgExitLockedPool {
+				msg.TokenOutMins = []sdk.Coin{{Denom: "test", Amount: sdk.NewInt(-10)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgBatchSwap(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgBatchSwap) MsgBatchSwap) MsgBatchSwap {
+		properMsg := MsgBatchSwap{
+			Sender:            addr1,
+			PoolId:            1,
+			TokenIn:           sdk.NewInt64Coin("test", 10),
+			TokenOutDenom:     "test2",
+			TokenOutMinAmount: sdk.NewInt(1),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "batch_swap")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgBatchSwap
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				msg.TokenIn.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same denom in and out",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				msg.TokenOutDenom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token out min amount",
+			msg: createMsg(func(msg MsgBatchSwap) MsgBatchSwap {
+				msg.TokenOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
@@ -11,7 +12,14 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee   = []byte("PoolCreationFee")
+	KeyTakerFee          = []byte("TakerFee")
+	KeyTakerFeeOverrides = []byte("TakerFeeOverrides")
+	KeyTakerFeeRecipient = []byte("TakerFeeRecipient")
+	KeyPauseGuardian     = []byte("PauseGuardian")
+
+	KeyCircuitBreakerMaxPriceChange = []byte("CircuitBreakerMaxPriceChange")
+	KeyCircuitBreakerWindowBlocks   = []byte("CircuitBreakerWindowBlocks")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +27,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns gamm module parameters with the given pool creation fee,
+// no taker fee, no pause guardian and the circuit breaker disabled.
 func NewParams(poolCreationFee sdk.Coins) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:              poolCreationFee,
+		TakerFee:                     sdk.ZeroDec(),
+		TakerFeeOverrides:            []TakerFeeOverride{},
+		TakerFeeRecipient:            CommunityPool,
+		CircuitBreakerMaxPriceChange: sdk.ZeroDec(),
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:              sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakerFee:                     sdk.ZeroDec(),
+		TakerFeeOverrides:            []TakerFeeOverride{},
+		TakerFeeRecipient:            CommunityPool,
+		CircuitBreakerMaxPriceChange: sdk.ZeroDec(),
 	}
 }
 
@@ -38,6 +56,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTakerFee(p.TakerFee); err != nil {
+		return err
+	}
+
+	if err := validateTakerFeeOverrides(p.TakerFeeOverrides); err != nil {
+		return err
+	}
+
+	if err := validateTakerFeeRecipient(p.TakerFeeRecipient); err != nil {
+		return err
+	}
+
+	if err := validatePauseGuardian(p.PauseGuardian); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerMaxPriceChange(p.CircuitBreakerMaxPriceChange); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerWindowBlocks(p.CircuitBreakerWindowBlocks); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,7 +87,30 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakerFee, &p.TakerFee, validateTakerFee),
+		paramtypes.NewParamSetPair(KeyTakerFeeOverrides, &p.TakerFeeOverrides, validateTakerFeeOverrides),
+		paramtypes.NewParamSetPair(KeyTakerFeeRecipient, &p.TakerFeeRecipient, validateTakerFeeRecipient),
+		paramtypes.NewParamSetPair(KeyPauseGuardian, &p.PauseGuardian, validatePauseGuardian),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerMaxPriceChange, &p.CircuitBreakerMaxPriceChange, validateCircuitBreakerMaxPriceChange),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerWindowBlocks, &p.CircuitBreakerWindowBlocks, validateCircuitBreakerWindowBlocks),
+	}
+}
+
+// IsCircuitBreakerEnabled returns whether pools are paused when their spot
+// prices move too much.
+func (p Params) IsCircuitBreakerEnabled() bool {
+	return p.CircuitBreakerMaxPriceChange.IsPositive() && p.CircuitBreakerWindowBlocks > 0
+}
+
+// GetTakerFee returns the taker fee of swaps between denomIn and denomOut.
+func (p Params) GetTakerFee(denomIn, denomOut string) sdk.Dec {
+	for _, override := range p.TakerFeeOverrides {
+		if (override.Denom0 == denomIn && override.Denom1 == denomOut) ||
+			(override.Denom0 == denomOut && override.Denom1 == denomIn) {
+			return override.TakerFee
+		}
 	}
+	return p.TakerFee
 }
 
 func validatePoolCreationFee(i interface{}) error {
@@ -60,3 +125,103 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateTakerFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("taker fee must be in [0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateTakerFeeOverrides(i interface{}) error {
+	v, ok := i.([]TakerFeeOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	pairs := map[[2]string]bool{}
+	for _, override := range v {
+		if err := sdk.ValidateDenom(override.Denom0); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(override.Denom1); err != nil {
+			return err
+		}
+		if override.Denom0 == override.Denom1 {
+			return fmt.Errorf("taker fee override denoms must differ: %s", override.Denom0)
+		}
+
+		pair := [2]string{override.Denom0, override.Denom1}
+		if pair[0] > pair[1] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		if pairs[pair] {
+			return fmt.Errorf("duplicate taker fee override for %s and %s", pair[0], pair[1])
+		}
+		pairs[pair] = true
+
+		if err := validateTakerFee(override.TakerFee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTakerFeeRecipient(i interface{}) error {
+	v, ok := i.(TakerFeeRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := TakerFeeRecipient_name[int32(v)]; !ok {
+		return errors.New("invalid taker fee recipient")
+	}
+
+	return nil
+}
+
+func validatePauseGuardian(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// allow no pause guardian
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid pause guardian: %w", err)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerMaxPriceChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("circuit breaker max price change must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerWindowBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	withTakerFee := func(after func(params *Params)) Params {
+		params := DefaultParams()
+		params.TakerFee = sdk.NewDecWithPrec(1, 3)
+		params.TakerFeeOverrides = []TakerFeeOverride{
+			{Denom0: "uatom", Denom1: "uosmo", TakerFee: sdk.NewDecWithPrec(5, 4)},
+		}
+		after(&params)
+		return params
+	}
+
+	tests := []struct {
+		name       string
+		params     Params
+		expectPass bool
+	}{
+		{
+			name:       "default params",
+			params:     DefaultParams(),
+			expectPass: true,
+		},
+		{
+			name:       "taker fee with an override",
+			params:     withTakerFee(func(params *Params) {}),
+			expectPass: true,
+		},
+		{
+			name:       "taker fee to stakers",
+			params:     withTakerFee(func(params *Params) { params.TakerFeeRecipient = Stakers }),
+			expectPass: true,
+		},
+		{
+			name:       "negative taker fee",
+			params:     withTakerFee(func(params *Params) { params.TakerFee = sdk.NewDecWithPrec(-1, 3) }),
+			expectPass: false,
+		},
+		{
+			name:       "taker fee of 1",
+			params:     withTakerFee(func(params *Params) { params.TakerFee = sdk.OneDec() }),
+			expectPass: false,
+		},
+		{
+			name:       "nil taker fee",
+			params:     withTakerFee(func(params *Params) { params.TakerFee = sdk.Dec{} }),
+			expectPass: false,
+		},
+		{
+			name: "override of a denom with itself",
+			params: withTakerFee(func(params *Params) {
+				params.TakerFeeOverrides[0].Denom1 = params.TakerFeeOverrides[0].Denom0
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate override in reverse order",
+			params: withTakerFee(func(params *Params) {
+				params.TakerFeeOverrides = append(params.TakerFeeOverrides,
+					TakerFeeOverride{Denom0: "uosmo", Denom1: "uatom", TakerFee: sdk.NewDecWithPrec(1, 4)})
+			}),
+			expectPass: false,
+		},
+		{
+			name: "override taker fee of 1",
+			params: withTakerFee(func(params *Params) {
+				params.TakerFeeOverrides[0].TakerFee = sdk.OneDec()
+			}),
+			expectPass: false,
+		},
+		{
+			name:       "invalid taker fee recipient",
+			params:     withTakerFee(func(params *Params) { params.TakerFeeRecipient = 2 }),
+			expectPass: false,
+		},
+		{
+			name: "pause guardian and circuit breaker",
+			params: withTakerFee(func(params *Params) {
+				params.PauseGuardian = sdk.AccAddress([]byte("guardian____________")).String()
+				params.CircuitBreakerMaxPriceChange = sdk.NewDecWithPrec(2, 1)
+				params.CircuitBreakerWindowBlocks = 100
+			}),
+			expectPass: true,
+		},
+		{
+			name:       "invalid pause guardian",
+			params:     withTakerFee(func(params *Params) { params.PauseGuardian = "guardian" }),
+			expectPass: false,
+		},
+		{
+			name: "negative circuit breaker max price change",
+			params: withTakerFee(func(params *Params) {
+				params.CircuitBreakerMaxPriceChange = sdk.NewDecWithPrec(-2, 1)
+			}),
+			expectPass: false,
+		},
+		{
+			name:       "nil circuit breaker max price change",
+			params:     withTakerFee(func(params *Params) { params.CircuitBreakerMaxPriceChange = sdk.Dec{} }),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.params.Validate()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestGetTakerFee(t *testing.T) {
+	params := DefaultParams()
+	params.TakerFee = sdk.NewDecWithPrec(1, 3)
+	params.TakerFeeOverrides = []TakerFeeOverride{
+		{Denom0: "uatom", Denom1: "uosmo", TakerFee: sdk.NewDecWithPrec(5, 4)},
+	}
+
+	require.Equal(t, sdk.NewDecWithPrec(5, 4), params.GetTakerFee("uatom", "uosmo"))
+	require.Equal(t, sdk.NewDecWithPrec(5, 4), params.GetTakerFee("uosmo", "uatom"))
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), params.GetTakerFee("uatom", "uion"))
+}
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/pause.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolPauseStatus is which operations of a pool are paused.
+type PoolPauseStatus struct {
+	PoolId      uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapsPaused bool   `protobuf:"varint,2,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+	JoinsPaused bool   `protobuf:"varint,3,opt,name=joins_paused,json=joinsPaused,proto3" json:"joins_paused,omitempty" yaml:"joins_paused"`
+	ExitsPaused bool   `protobuf:"varint,4,opt,name=exits_paused,json=exitsPaused,proto3" json:"exits_paused,omitempty" yaml:"exits_paused"`
+}
+
+func (m *PoolPauseStatus) Reset()         { *m = PoolPauseStatus{} }
+func (m *PoolPauseStatus) String() string { return proto.CompactTextString(m) }
+func (*PoolPauseStatus) ProtoMessage()    {}
+func (*PoolPauseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc9b3a267f59d662, []int{0}
+}
+func (m *PoolPauseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPauseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPauseStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPauseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPauseStatus.Merge(m, src)
+}
+func (m *PoolPauseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPauseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPauseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPauseStatus proto.InternalMessageInfo
+
+func (m *PoolPauseStatus) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolPauseStatus) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
+	}
+	return false
+}
+
+func (m *PoolPauseStatus) GetJoinsPaused() bool {
+	if m != nil {
+		return m.JoinsPaused
+	}
+	return false
+}
+
+func (m *PoolPauseStatus) GetExitsPaused() bool {
+	if m != nil {
+		return m.ExitsPaused
+	}
+	return false
+}
+
+// CircuitBreakerReference is the spot price of a pool's denom pair at the start
+// of the current circuit breaker window, which the spot price after each swap
+// is compared against.
+type CircuitBreakerReference struct {
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+	Height    int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *CircuitBreakerReference) Reset()         { *m = CircuitBreakerReference{} }
+func (m *CircuitBreakerReference) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerReference) ProtoMessage()    {}
+func (*CircuitBreakerReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc9b3a267f59d662, []int{1}
+}
+func (m *CircuitBreakerReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerReference.Merge(m, src)
+}
+func (m *CircuitBreakerReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerReference proto.InternalMessageInfo
+
+func (m *CircuitBreakerReference) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PoolPauseStatus)(nil), "osmosis.gamm.v1beta1.PoolPauseStatus")
+	proto.RegisterType((*CircuitBreakerReference)(nil), "osmosis.gamm.v1beta1.CircuitBreakerReference")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/pause.proto", fileDescriptor_dc9b3a267f59d662) }
+
+var fileDescriptor_dc9b3a267f59d662 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x3f, 0x8f, 0xda, 0x30,
+	0x18, 0xc6, 0xe3, 0x82, 0x68, 0x31, 0xfd, 0x23, 0x52, 0x24, 0x50, 0x87, 0x04, 0x79, 0xa8, 0xa8,
+	0x2a, 0x62, 0xa1, 0x0e, 0x95, 0x18, 0x43, 0x17, 0x36, 0x94, 0x6e, 0x5d, 0x90, 0x93, 0xb8, 0xc1,
+	0x25, 0xc1, 0x51, 0xec, 0x50, 0xf8, 0x16, 0xfd, 0x08, 0x1d, 0xef, 0xa3, 0x30, 0x32, 0x9e, 0x6e,
+	0x88, 0x4e, 0xb0, 0xdc, 0x9c, 0xe9, 0xc6, 0x53, 0x9c, 0x1c, 0x17, 0x31, 0xe5, 0x7d, 0xf2, 0x3c,
+	0xbf, 0xe4, 0xd5, 0x63, 0xc3, 0x21, 0x17, 0x11, 0x17, 0x4c, 0xe0, 0x80, 0x44, 0x11, 0xde, 0x4e,
+	0x5c, 0x2a, 0xc9, 0x04, 0xc7, 0x24, 0x15, 0xd4, 0x8a, 0x13, 0x2e, 0xb9, 0xde, 0xab, 0x12, 0x56,
+	0x91, 0xb0, 0xaa, 0xc4, 0xa7, 0x5e, 0xc0, 0x03, 0xae, 0x02, 0xb8, 0x98, 0xca, 0x2c, 0x7a, 0x04,
+	0xf0, 0xc3, 0x82, 0xf3, 0x70, 0x51, 0xf0, 0x3f, 0x25, 0x91, 0xa9, 0xd0, 0xbf, 0xc2, 0xd7, 0x31,
+	0xe7, 0xe1, 0x92, 0xf9, 0x03, 0x30, 0x04, 0xa3, 0xa6, 0xad, 0xe7, 0x99, 0xf9, 0x7e, 0x4f, 0xa2,
+	0x70, 0x8a, 0x2a, 0x03, 0x39, 0xad, 0x62, 0x9a, 0xfb, 0xfa, 0x14, 0xbe, 0x15, 0x7f, 0x49, 0x2c,
+	0x96, 0x6a, 0x03, 0x7f, 0xf0, 0x6a, 0x08, 0x46, 0x6f, 0xec, 0x7e, 0x9e, 0x99, 0x1f, 0x4b, 0xa2,
+	0xee, 0x22, 0xa7, 0xa3, 0xa4, 0xfa, 0x9b, 0x62, 0xff, 0x70, 0xb6, 0xb9, 0xb0, 0x8d, 0x6b, 0xb6,
+	0xee, 0x22, 0xa7, 0xa3, 0xe4, 0x0b, 0x4b, 0x77, 0x4c, 0x5e, 0xd8, 0xe6, 0x35, 0x5b, 0x77, 0x91,
+	0xd3, 0x51, 0xb2, 0x64, 0xa7, 0xcd, 0x87, 0xff, 0x26, 0x40, 0x37, 0x00, 0xf6, 0x67, 0x2c, 0xf1,
+	0x52, 0x26, 0xed, 0x84, 0x92, 0x35, 0x4d, 0x1c, 0xfa, 0x9b, 0x26, 0x74, 0xe3, 0x51, 0xdd, 0x85,
+	0x50, 0xc4, 0x5c, 0x2e, 0xe3, 0x84, 0x79, 0x54, 0xb5, 0xd0, 0xb6, 0x67, 0x87, 0xcc, 0xd4, 0xee,
+	0x32, 0xf3, 0x73, 0xc0, 0xe4, 0x2a, 0x75, 0x2d, 0x8f, 0x47, 0xd8, 0x53, 0x55, 0x57, 0x8f, 0xb1,
+	0xf0, 0xd7, 0x58, 0xee, 0x63, 0x2a, 0xac, 0x1f, 0xd4, 0xcb, 0x33, 0xb3, 0x5b, 0x35, 0x70, 0xf9,
+	0x12, 0x72, 0xda, 0x85, 0x58, 0x14, 0xb3, 0xfe, 0x05, 0xb6, 0x56, 0x94, 0x05, 0x2b, 0xa9, 0x3a,
+	0x6b, 0xd8, 0xdd, 0x3c, 0x33, 0xdf, 0x95, 0x44, 0xf9, 0x1e, 0x39, 0x55, 0xc0, 0x9e, 0x1f, 0x4e,
+	0x06, 0x38, 0x9e, 0x0c, 0x70, 0x7f, 0x32, 0xc0, 0xbf, 0xb3, 0xa1, 0x1d, 0xcf, 0x86, 0x76, 0x7b,
+	0x36, 0xb4, 0x5f, 0xb8, 0xb6, 0x4c, 0x75, 0xec, 0xe3, 0x90, 0xb8, 0xe2, 0x59, 0xe0, 0xed, 0x77,
+	0xbc, 0x2b, 0xaf, 0x8a, 0xda, 0xcc, 0x6d, 0xa9, 0x73, 0xff, 0xf6, 0x34, 0x00, 0x33, 0xd4, 0xf6,
+	0x48, 0x47, 0x02, 0x00, 0x00,
+}
+
+func (this *PoolPauseStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolPauseStatus)
+	if !ok {
+		that2, ok := that.(PoolPauseStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.SwapsPaused != that1.SwapsPaused {
+		return false
+	}
+	if this.JoinsPaused != that1.JoinsPaused {
+		return false
+	}
+	if this.ExitsPaused != that1.ExitsPaused {
+		return false
+	}
+	return true
+}
+func (m *PoolPauseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPauseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPauseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitsPaused {
+		i--
+		if m.ExitsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JoinsPaused {
+		i--
+		if m.JoinsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPause(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPause(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPause(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolPauseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPause(uint64(m.PoolId))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.JoinsPaused {
+		n += 2
+	}
+	if m.ExitsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *CircuitBreakerReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovPause(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovPause(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPause(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPause(x uint64) (n int) {
+	return sovPause(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolPauseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPauseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinsPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPause(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPause
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPause
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPause
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPause(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPause
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPause(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPause
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPause
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPause
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPause        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPause          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPause = fmt.Errorf("proto: unexpected end of group")
+)
@@ -117,6 +117,19 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
+// CFMMPoolI is an extension of the PoolI interface for pools whose value is
+// given by a constant function market maker (CFMM). It is implemented by
+// balancer and stableswap pools.
+type CFMMPoolI interface {
+	PoolI
+
+	// CFMMValueRatio returns the ratio of the pool's CFMM value to its value
+	// with reservesBefore. As swaps don't change the pool's total shares, a
+	// ratio lesser than one after a swap means the swap decreased the value
+	// per share.
+	CFMMValueRatio(reservesBefore sdk.Coins) (sdk.Dec, error)
+}
+
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
 	return address.Module(ModuleName, key)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPosition returns an empty position of owner over [lowerTick, upperTick)
+// in the given concentrated liquidity pool.
+func NewPosition(poolId uint64, owner string, lowerTick, upperTick int64) Position {
+	return Position{
+		PoolId:               poolId,
+		Owner:                owner,
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		Liquidity:            sdk.ZeroDec(),
+		FeeGrowthInside0Last: sdk.ZeroDec(),
+		FeeGrowthInside1Last: sdk.ZeroDec(),
+	}
+}
+
+// GetOwnerAddress returns the account that owns the position.
+func (p Position) GetOwnerAddress() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(p.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return owner
+}
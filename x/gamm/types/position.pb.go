@@ -0,0 +1,978 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/position.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Position is a concentrated liquidity position owned by an account, over a
+// range of ticks of the pool.
+type Position struct {
+	PoolId    uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner     string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64                                  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee_growth_inside0_last and fee_growth_inside1_last are the fee growth
+	// inside the position's range as of its last update.
+	FeeGrowthInside0Last github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_growth_inside0_last,json=feeGrowthInside0Last,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside0_last" yaml:"fee_growth_inside0_last"`
+	FeeGrowthInside1Last github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fee_growth_inside1_last,json=feeGrowthInside1Last,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside1_last" yaml:"fee_growth_inside1_last"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_049c9d11d6fe46a1, []int{0}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// Tick is an initialized tick of a concentrated liquidity pool, i.e. a price
+// boundary that at least one position starts or ends at.
+type Tick struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Index  int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	// liquidity_gross is the total position liquidity referencing this tick.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity_net is the amount of liquidity added to the active liquidity
+	// when the price crosses this tick moving upwards (and subtracted when
+	// crossing it downwards).
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee_growth_outside0 and fee_growth_outside1 are the fees collected per
+	// unit of liquidity on the other side of this tick, relative to the current
+	// tick.
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *Tick) Reset()         { *m = Tick{} }
+func (m *Tick) String() string { return proto.CompactTextString(m) }
+func (*Tick) ProtoMessage()    {}
+func (*Tick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_049c9d11d6fe46a1, []int{1}
+}
+func (m *Tick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tick.Merge(m, src)
+}
+func (m *Tick) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tick) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tick proto.InternalMessageInfo
+
+func (m *Tick) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Tick) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Position)(nil), "osmosis.gamm.v1beta1.Position")
+	proto.RegisterType((*Tick)(nil), "osmosis.gamm.v1beta1.Tick")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/position.proto", fileDescriptor_049c9d11d6fe46a1)
+}
+
+var fileDescriptor_049c9d11d6fe46a1 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x89, 0x9b, 0xe2, 0x55, 0x29, 0xad, 0x09, 0x60, 0xf5, 0x60, 0x47, 0x8b, 0x54, 0x45,
+	0x42, 0xb5, 0xb1, 0x40, 0x42, 0xe2, 0x68, 0x21, 0x4a, 0xa4, 0x0a, 0x2a, 0x8b, 0x13, 0x17, 0xe3,
+	0xd8, 0x5b, 0x77, 0x15, 0xdb, 0xeb, 0x66, 0x37, 0x4d, 0x23, 0x71, 0xe7, 0xca, 0xdf, 0x70, 0xe6,
+	0xd6, 0x63, 0x8f, 0x88, 0x83, 0x85, 0x92, 0x3f, 0xc8, 0x17, 0x20, 0xef, 0xda, 0x49, 0x94, 0xa6,
+	0x07, 0x4b, 0x3d, 0x79, 0x67, 0xe6, 0xcd, 0xbc, 0xe7, 0x99, 0xd1, 0x80, 0x17, 0x84, 0x26, 0x84,
+	0x62, 0x6a, 0x45, 0x7e, 0x92, 0x58, 0x97, 0x76, 0x1f, 0x31, 0xdf, 0xb6, 0x32, 0x42, 0x31, 0xc3,
+	0x24, 0x35, 0xb3, 0x21, 0x61, 0x44, 0x6d, 0x97, 0x20, 0xb3, 0x00, 0x99, 0x25, 0xe8, 0xa0, 0x1d,
+	0x91, 0x88, 0x70, 0x80, 0x55, 0xbc, 0x04, 0x16, 0xfe, 0x96, 0xc1, 0xc3, 0xd3, 0x32, 0x5d, 0x7d,
+	0x09, 0xb6, 0x33, 0x42, 0x62, 0x0f, 0x87, 0x9a, 0xd4, 0x91, 0xba, 0xb2, 0xa3, 0xce, 0x73, 0x63,
+	0x77, 0xe2, 0x27, 0xf1, 0x3b, 0x58, 0x06, 0xa0, 0xdb, 0x2a, 0x5e, 0xbd, 0x50, 0x3d, 0x04, 0x5b,
+	0x64, 0x9c, 0xa2, 0xa1, 0xf6, 0xa0, 0x23, 0x75, 0x15, 0x67, 0x6f, 0x9e, 0x1b, 0x3b, 0x02, 0xca,
+	0xdd, 0xd0, 0x15, 0x61, 0xf5, 0x0d, 0x00, 0x31, 0x19, 0xa3, 0xa1, 0xc7, 0x70, 0x30, 0xd0, 0x9a,
+	0x1d, 0xa9, 0xdb, 0x74, 0x9e, 0xce, 0x73, 0x63, 0x5f, 0x80, 0x97, 0x31, 0xe8, 0x2a, 0xdc, 0xf8,
+	0x82, 0x83, 0x41, 0x91, 0x35, 0xca, 0xb2, 0x2a, 0x4b, 0x5e, 0xcf, 0x5a, 0xc6, 0xa0, 0xab, 0x70,
+	0x83, 0x67, 0x7d, 0x03, 0x4a, 0x8c, 0x2f, 0x46, 0x38, 0xc4, 0x6c, 0xa2, 0x6d, 0x71, 0x5d, 0xce,
+	0x75, 0x6e, 0x34, 0xfe, 0xe6, 0xc6, 0x61, 0x84, 0xd9, 0xf9, 0xa8, 0x6f, 0x06, 0x24, 0xb1, 0x02,
+	0xde, 0xa0, 0xf2, 0x73, 0x44, 0xc3, 0x81, 0xc5, 0x26, 0x19, 0xa2, 0xe6, 0x7b, 0x14, 0xcc, 0x73,
+	0x63, 0xaf, 0x14, 0x56, 0x15, 0x2a, 0x74, 0x55, 0x6f, 0xf5, 0x87, 0x04, 0x9e, 0x9f, 0x21, 0xe4,
+	0x45, 0x43, 0x32, 0x66, 0xe7, 0x1e, 0x4e, 0x29, 0x0e, 0xd1, 0x2b, 0x2f, 0xf6, 0x29, 0xd3, 0x5a,
+	0x9c, 0xf0, 0xb4, 0x36, 0xa1, 0x2e, 0x08, 0xef, 0x28, 0x0b, 0xdd, 0xf6, 0x19, 0x42, 0xc7, 0x3c,
+	0xd0, 0x13, 0xfe, 0x13, 0x9f, 0xb2, 0xcd, 0x4a, 0x6c, 0xa1, 0x64, 0xfb, 0x9e, 0x95, 0xd8, 0x77,
+	0x28, 0xb1, 0x0b, 0x25, 0xf0, 0x97, 0x0c, 0x64, 0xde, 0xfe, 0xba, 0xfb, 0x83, 0xd3, 0x10, 0x5d,
+	0xf1, 0xfd, 0x69, 0xae, 0xee, 0x0f, 0x77, 0x43, 0x57, 0x84, 0xd5, 0x0b, 0xf0, 0x78, 0xd1, 0xfe,
+	0x42, 0x15, 0xa5, 0x7c, 0x89, 0x14, 0xe7, 0x63, 0xed, 0xdf, 0x7b, 0xb6, 0x36, 0x59, 0x51, 0x0e,
+	0xba, 0xbb, 0x0b, 0xcf, 0x71, 0xe1, 0x50, 0x07, 0xe0, 0xd1, 0x12, 0x93, 0x22, 0xc6, 0xf7, 0x4f,
+	0x71, 0x3e, 0xd4, 0x26, 0x6c, 0xaf, 0x13, 0xa6, 0x88, 0x41, 0x77, 0x67, 0x61, 0x7f, 0x42, 0x4c,
+	0xfd, 0x0e, 0x9e, 0xac, 0xf4, 0x9b, 0x8c, 0x18, 0x1f, 0x71, 0xb9, 0xbd, 0x27, 0xb5, 0x29, 0x0f,
+	0x6e, 0x8d, 0xb0, 0x2a, 0x09, 0xdd, 0xfd, 0xc5, 0xf8, 0x3e, 0x97, 0xbe, 0xcd, 0xec, 0xb6, 0xd6,
+	0xba, 0x6f, 0x76, 0x7b, 0x03, 0xbb, 0xed, 0xf4, 0xae, 0xa7, 0xba, 0x74, 0x33, 0xd5, 0xa5, 0x7f,
+	0x53, 0x5d, 0xfa, 0x39, 0xd3, 0x1b, 0x37, 0x33, 0xbd, 0xf1, 0x67, 0xa6, 0x37, 0xbe, 0x5a, 0x2b,
+	0x94, 0xe5, 0x39, 0x3b, 0x8a, 0xfd, 0x3e, 0xad, 0x0c, 0xeb, 0xf2, 0xad, 0x75, 0x25, 0xae, 0x20,
+	0xe7, 0xef, 0xb7, 0xf8, 0x3d, 0x7b, 0xfd, 0x7f, 0x00, 0x6c, 0x14, 0xfc, 0x6d, 0x22, 0x05, 0x00,
+	0x00,
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthInside1Last.Size()
+		i -= size
+		if _, err := m.FeeGrowthInside1Last.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeeGrowthInside0Last.Size()
+		i -= size
+		if _, err := m.FeeGrowthInside0Last.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPosition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthInside0Last.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthInside1Last.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	return n
+}
+
+func (m *Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPosition(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	return n
+}
+
+func sovPosition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPosition(x uint64) (n int) {
+	return sovPosition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInside0Last", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInside0Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInside1Last", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInside1Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPosition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPosition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPosition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPosition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPosition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPosition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPosition = fmt.Errorf("proto: unexpected end of group")
+)
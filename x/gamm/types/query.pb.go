@@ -222,7 +222,328 @@ func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//=============================== NumPools
+// =============================== PoolsWithDenoms
+type QueryPoolsWithDenomsRequest struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsWithDenomsRequest) Reset()         { *m = QueryPoolsWithDenomsRequest{} }
+func (m *QueryPoolsWithDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithDenomsRequest) ProtoMessage()    {}
+func (*QueryPoolsWithDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{4}
+}
+func (m *QueryPoolsWithDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsWithDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsWithDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsWithDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithDenomsRequest.Merge(m, src)
+}
+func (m *QueryPoolsWithDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsWithDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsWithDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsWithDenomsRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryPoolsWithDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsWithDenomsResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsWithDenomsResponse) Reset()         { *m = QueryPoolsWithDenomsResponse{} }
+func (m *QueryPoolsWithDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithDenomsResponse) ProtoMessage()    {}
+func (*QueryPoolsWithDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{5}
+}
+func (m *QueryPoolsWithDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsWithDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsWithDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsWithDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithDenomsResponse.Merge(m, src)
+}
+func (m *QueryPoolsWithDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsWithDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsWithDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsWithDenomsResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryPoolsWithDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolsByType
+type QueryPoolsByTypeRequest struct {
+	PoolType string `protobuf:"bytes,1,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty" yaml:"pool_type"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByTypeRequest) Reset()         { *m = QueryPoolsByTypeRequest{} }
+func (m *QueryPoolsByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByTypeRequest) ProtoMessage()    {}
+func (*QueryPoolsByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{6}
+}
+func (m *QueryPoolsByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByTypeRequest.Merge(m, src)
+}
+func (m *QueryPoolsByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByTypeRequest) GetPoolType() string {
+	if m != nil {
+		return m.PoolType
+	}
+	return ""
+}
+
+func (m *QueryPoolsByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsByTypeResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByTypeResponse) Reset()         { *m = QueryPoolsByTypeResponse{} }
+func (m *QueryPoolsByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByTypeResponse) ProtoMessage()    {}
+func (*QueryPoolsByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{7}
+}
+func (m *QueryPoolsByTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByTypeResponse.Merge(m, src)
+}
+func (m *QueryPoolsByTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByTypeResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByTypeResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryPoolsByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolsByMinLiquidity
+type QueryPoolsByMinLiquidityRequest struct {
+	MinLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_liquidity,json=minLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_liquidity" yaml:"min_liquidity"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByMinLiquidityRequest) Reset()         { *m = QueryPoolsByMinLiquidityRequest{} }
+func (m *QueryPoolsByMinLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByMinLiquidityRequest) ProtoMessage()    {}
+func (*QueryPoolsByMinLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{8}
+}
+func (m *QueryPoolsByMinLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByMinLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByMinLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByMinLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByMinLiquidityRequest.Merge(m, src)
+}
+func (m *QueryPoolsByMinLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByMinLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByMinLiquidityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByMinLiquidityRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByMinLiquidityRequest) GetMinLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinLiquidity
+	}
+	return nil
+}
+
+func (m *QueryPoolsByMinLiquidityRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsByMinLiquidityResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) Reset()         { *m = QueryPoolsByMinLiquidityResponse{} }
+func (m *QueryPoolsByMinLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByMinLiquidityResponse) ProtoMessage()    {}
+func (*QueryPoolsByMinLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{9}
+}
+func (m *QueryPoolsByMinLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByMinLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByMinLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByMinLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByMinLiquidityResponse.Merge(m, src)
+}
+func (m *QueryPoolsByMinLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByMinLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByMinLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByMinLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByMinLiquidityResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== NumPools
 type QueryNumPoolsRequest struct {
 }
 
@@ -230,7 +551,7 @@ func (m *QueryNumPoolsRequest) Reset()         { *m = QueryNumPoolsRequest{} }
 func (m *QueryNumPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNumPoolsRequest) ProtoMessage()    {}
 func (*QueryNumPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{4}
+	return fileDescriptor_d9a717df9ca609ef, []int{10}
 }
 func (m *QueryNumPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +588,7 @@ func (m *QueryNumPoolsResponse) Reset()         { *m = QueryNumPoolsResponse{} }
 func (m *QueryNumPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNumPoolsResponse) ProtoMessage()    {}
 func (*QueryNumPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{5}
+	return fileDescriptor_d9a717df9ca609ef, []int{11}
 }
 func (m *QueryNumPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +633,7 @@ func (m *QueryPoolParamsRequest) Reset()         { *m = QueryPoolParamsRequest{}
 func (m *QueryPoolParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolParamsRequest) ProtoMessage()    {}
 func (*QueryPoolParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{6}
+	return fileDescriptor_d9a717df9ca609ef, []int{12}
 }
 func (m *QueryPoolParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +677,7 @@ func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse
 func (m *QueryPoolParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolParamsResponse) ProtoMessage()    {}
 func (*QueryPoolParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{7}
+	return fileDescriptor_d9a717df9ca609ef, []int{13}
 }
 func (m *QueryPoolParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +722,7 @@ func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLi
 func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{8}
+	return fileDescriptor_d9a717df9ca609ef, []int{14}
 }
 func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +766,7 @@ func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolL
 func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{9}
+	return fileDescriptor_d9a717df9ca609ef, []int{15}
 }
 func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +811,7 @@ func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest
 func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesRequest) ProtoMessage()    {}
 func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{10}
+	return fileDescriptor_d9a717df9ca609ef, []int{16}
 }
 func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +855,7 @@ func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesRespon
 func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesResponse) ProtoMessage()    {}
 func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{11}
+	return fileDescriptor_d9a717df9ca609ef, []int{17}
 }
 func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +903,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{12}
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +964,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{13}
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +1012,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{14}
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +1077,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{15}
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1118,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{16}
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1183,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{17}
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,21 +1212,26 @@ func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
 
-type QueryTotalLiquidityRequest struct {
+// =============================== EstimateBestRoute
+type QueryEstimateBestRouteRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in the route, at most 4.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
 }
 
-func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
-func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+func (m *QueryEstimateBestRouteRequest) Reset()         { *m = QueryEstimateBestRouteRequest{} }
+func (m *QueryEstimateBestRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBestRouteRequest) ProtoMessage()    {}
+func (*QueryEstimateBestRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEstimateBestRouteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEstimateBestRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEstimateBestRouteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,34 +1241,59 @@ func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+func (m *QueryEstimateBestRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBestRouteRequest.Merge(m, src)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+func (m *QueryEstimateBestRouteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+func (m *QueryEstimateBestRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBestRouteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEstimateBestRouteRequest proto.InternalMessageInfo
 
-type QueryTotalLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *QueryEstimateBestRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
 }
 
-func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
-func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+func (m *QueryEstimateBestRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
 }
-func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryEstimateBestRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+type QueryEstimateBestRouteResponse struct {
+	Routes         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// price_impact is the relative difference between the execution price of
+	// the route, swap fees included, and its spot price.
+	PriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *QueryEstimateBestRouteResponse) Reset()         { *m = QueryEstimateBestRouteResponse{} }
+func (m *QueryEstimateBestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBestRouteResponse) ProtoMessage()    {}
+func (*QueryEstimateBestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
+}
+func (m *QueryEstimateBestRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEstimateBestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEstimateBestRouteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -952,1548 +1303,4427 @@ func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+func (m *QueryEstimateBestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBestRouteResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+func (m *QueryEstimateBestRouteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+func (m *QueryEstimateBestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBestRouteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEstimateBestRouteResponse proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryEstimateBestRouteResponse) GetRoutes() []SwapAmountInRoute {
 	if m != nil {
-		return m.Liquidity
+		return m.Routes
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
-	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
-	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
-	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
-	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
-	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
-	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
-	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
-	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
-	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
-	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
-	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
-	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
-	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
+// =============================== PoolVolume
+type QueryPoolVolumeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
-
-var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0xb3, 0xa9, 0x93, 0xc6, 0x13, 0x9a, 0x26, 0xd3, 0xb4, 0x75, 0x37, 0xad, 0xb7, 0x0c,
-	0xa2, 0x09, 0x6d, 0xb2, 0x4b, 0xda, 0x54, 0x48, 0x15, 0x50, 0x6a, 0x9a, 0xb4, 0xae, 0xa0, 0x0d,
-	0x5b, 0x04, 0x02, 0x0e, 0xd6, 0x26, 0x59, 0xdc, 0x55, 0xed, 0x9d, 0x8d, 0x67, 0xb6, 0x69, 0x84,
-	0x2a, 0x24, 0x84, 0x38, 0x71, 0x40, 0x2a, 0xdc, 0x2a, 0x95, 0x03, 0x07, 0xc4, 0x99, 0x7f, 0x01,
-	0xa9, 0x42, 0x42, 0x2a, 0xe2, 0x82, 0x38, 0x18, 0xd4, 0x72, 0xe0, 0xec, 0x7f, 0x00, 0x34, 0x33,
-	0x6f, 0x3f, 0x1c, 0x6f, 0xfc, 0x11, 0x84, 0xc4, 0x29, 0xf6, 0xfb, 0xfc, 0xbd, 0xf7, 0x66, 0x3c,
-	0x2f, 0xe8, 0x24, 0x65, 0x75, 0xca, 0x3c, 0x66, 0x55, 0x9d, 0x7a, 0xdd, 0xba, 0xb3, 0xb8, 0xe6,
-	0x72, 0x67, 0xd1, 0xda, 0x0c, 0xdd, 0xc6, 0xb6, 0x19, 0x34, 0x28, 0xa7, 0x78, 0x1a, 0x2c, 0x4c,
-	0x61, 0x61, 0x82, 0x85, 0x3e, 0x5d, 0xa5, 0x55, 0x2a, 0x0d, 0x2c, 0xf1, 0x49, 0xd9, 0xea, 0x27,
-	0x32, 0xa3, 0xf1, 0xbb, 0xa0, 0x2e, 0xae, 0x4b, 0xbd, 0xb5, 0xe6, 0x30, 0x37, 0xd6, 0xae, 0x53,
-	0xcf, 0x07, 0xfd, 0xe9, 0xb4, 0x5e, 0x32, 0xc4, 0x56, 0x81, 0x53, 0xf5, 0x7c, 0x87, 0x7b, 0x34,
-	0xb2, 0x3d, 0x5e, 0xa5, 0xb4, 0x5a, 0x73, 0x2d, 0x27, 0xf0, 0x2c, 0xc7, 0xf7, 0x29, 0x97, 0x4a,
-	0x06, 0xda, 0x63, 0xa0, 0x95, 0xdf, 0xd6, 0xc2, 0x0f, 0x2d, 0xc7, 0xdf, 0x8e, 0x54, 0x2a, 0x49,
-	0x45, 0xc1, 0xab, 0x2f, 0x4a, 0x45, 0x2e, 0xa2, 0xc9, 0xb7, 0x44, 0xd6, 0x55, 0x4a, 0x6b, 0xb6,
-	0xbb, 0x19, 0xba, 0x8c, 0xe3, 0x33, 0x68, 0x7f, 0x40, 0x69, 0xad, 0xe2, 0x6d, 0x14, 0xb4, 0x93,
-	0xda, 0x5c, 0xae, 0x84, 0x5b, 0x4d, 0x63, 0x62, 0xdb, 0xa9, 0xd7, 0x2e, 0x10, 0x50, 0x10, 0x7b,
-	0x54, 0x7c, 0x2a, 0x6f, 0x90, 0xab, 0x68, 0x2a, 0x15, 0x80, 0x05, 0xd4, 0x67, 0x2e, 0x3e, 0x87,
-	0x72, 0x42, 0x2d, 0xdd, 0xc7, 0xcf, 0x4e, 0x9b, 0x0a, 0xcd, 0x8c, 0xd0, 0xcc, 0x4b, 0xfe, 0x76,
-	0x29, 0xff, 0xe3, 0xf7, 0x0b, 0x23, 0xc2, 0xab, 0x6c, 0x4b, 0x63, 0xf2, 0x41, 0x2a, 0x12, 0x8b,
-	0x58, 0x56, 0x10, 0x4a, 0xfa, 0x50, 0x18, 0x96, 0xf1, 0x4e, 0x99, 0x50, 0x82, 0x68, 0x9a, 0xa9,
-	0x06, 0x07, 0x4d, 0x33, 0x57, 0x9d, 0xaa, 0x0b, 0xbe, 0x76, 0xca, 0x93, 0x7c, 0xa9, 0x21, 0x9c,
-	0x8e, 0x0e, 0xa0, 0xe7, 0xd1, 0x88, 0xc8, 0xcd, 0x0a, 0xda, 0xc9, 0x7d, 0xfd, 0x90, 0x2a, 0x6b,
-	0x7c, 0x25, 0x83, 0x6a, 0xb6, 0x27, 0x95, 0xca, 0xd9, 0x86, 0x75, 0x04, 0x4d, 0x4b, 0xaa, 0xeb,
-	0x61, 0x3d, 0x5d, 0x36, 0xb9, 0x86, 0x0e, 0xef, 0x90, 0x03, 0xf0, 0x22, 0xca, 0xfb, 0x61, 0xbd,
-	0x12, 0x41, 0x8b, 0xe9, 0x4c, 0xb7, 0x9a, 0xc6, 0xa4, 0x9a, 0x4e, 0xac, 0x22, 0xf6, 0x98, 0x0f,
-	0xae, 0x64, 0x19, 0x1d, 0x89, 0x2b, 0x5f, 0x75, 0x1a, 0x4e, 0x9d, 0xed, 0x69, 0xd0, 0x57, 0xd0,
-	0xd1, 0x8e, 0x30, 0x00, 0x35, 0x8f, 0x46, 0x03, 0x29, 0xe9, 0x36, 0x70, 0x1b, 0x6c, 0xc8, 0x9b,
-	0xa8, 0x28, 0x03, 0xbd, 0x4d, 0xb9, 0x53, 0x13, 0xd1, 0xde, 0xf0, 0x36, 0x43, 0x6f, 0xc3, 0xe3,
-	0xdb, 0x7b, 0xe2, 0xfa, 0x5a, 0x43, 0xc6, 0xae, 0xf1, 0x00, 0xf0, 0x1e, 0xca, 0xd7, 0x22, 0x21,
-	0x8c, 0xfa, 0x58, 0xdb, 0xb8, 0xa2, 0x41, 0xbd, 0x4e, 0x3d, 0xbf, 0x74, 0xf9, 0x51, 0xd3, 0x18,
-	0x4a, 0x9a, 0x1a, 0x7b, 0x92, 0xef, 0x7e, 0x37, 0xe6, 0xaa, 0x1e, 0xbf, 0x15, 0xae, 0x99, 0xeb,
-	0xb4, 0x0e, 0x17, 0x09, 0xfe, 0x2c, 0xb0, 0x8d, 0xdb, 0x16, 0xdf, 0x0e, 0x5c, 0x26, 0x83, 0x30,
-	0x3b, 0xc9, 0x48, 0x56, 0xa0, 0x75, 0x92, 0xf0, 0xe6, 0x2d, 0xa7, 0xe1, 0xee, 0x6d, 0x04, 0x21,
-	0x2a, 0x74, 0xc6, 0x81, 0x12, 0xdf, 0x43, 0xcf, 0x70, 0x21, 0xae, 0x30, 0x29, 0x87, 0x49, 0x74,
-	0xa9, 0x72, 0x06, 0xaa, 0x3c, 0xa4, 0x92, 0xa5, 0x9d, 0x89, 0x3d, 0xce, 0x93, 0x14, 0xe4, 0x2f,
-	0x0d, 0x4e, 0xe3, 0xcd, 0x80, 0xf2, 0xd5, 0x86, 0xb7, 0xee, 0xee, 0x85, 0x1e, 0x2f, 0xa3, 0x49,
-	0x41, 0x51, 0x71, 0x18, 0x73, 0x79, 0x65, 0xc3, 0xf5, 0x69, 0x5d, 0x5e, 0x9d, 0x7c, 0x69, 0xa6,
-	0xd5, 0x34, 0x8e, 0x2a, 0xaf, 0x9d, 0x16, 0xc4, 0x9e, 0x10, 0xa2, 0x4b, 0x42, 0x72, 0x59, 0x08,
-	0xf0, 0x55, 0x34, 0xb5, 0x19, 0x52, 0xde, 0x1e, 0x67, 0x9f, 0x8c, 0x73, 0xbc, 0xd5, 0x34, 0x0a,
-	0x2a, 0x4e, 0x87, 0x09, 0xb1, 0x0f, 0x4a, 0x59, 0x12, 0xe9, 0x5a, 0x6e, 0x2c, 0x37, 0x39, 0x62,
-	0x8f, 0x6f, 0x79, 0xfc, 0xd6, 0xcd, 0x2d, 0x27, 0x58, 0x71, 0x5d, 0x72, 0x1d, 0xee, 0x4a, 0xaa,
-	0x52, 0xe8, 0xef, 0x12, 0x42, 0x2c, 0xa0, 0xbc, 0x12, 0x08, 0xa9, 0xac, 0x36, 0x5f, 0x3a, 0xdc,
-	0x6a, 0x1a, 0x53, 0x2a, 0x5f, 0xa2, 0x23, 0x76, 0x9e, 0x45, 0xde, 0xe4, 0x6f, 0x0d, 0x9d, 0x50,
-	0x01, 0xb7, 0x9c, 0x60, 0xf9, 0xae, 0xb3, 0xce, 0x2f, 0xd5, 0x69, 0xe8, 0xf3, 0xb2, 0x1f, 0xb5,
-	0xf0, 0x05, 0x34, 0xca, 0x5c, 0x7f, 0xc3, 0x6d, 0x40, 0xcc, 0xa9, 0x56, 0xd3, 0x38, 0x00, 0x31,
-	0xa5, 0x9c, 0xd8, 0x60, 0x90, 0xee, 0xf6, 0x70, 0xcf, 0x6e, 0x9b, 0x68, 0x8c, 0xd3, 0xdb, 0xae,
-	0x5f, 0xf1, 0x7c, 0xe8, 0xce, 0xa1, 0x56, 0xd3, 0x38, 0x18, 0x0d, 0x5b, 0x69, 0x88, 0xbd, 0x5f,
-	0x7e, 0x2c, 0xfb, 0xf8, 0x1d, 0x34, 0xda, 0xa0, 0x21, 0x77, 0x59, 0x21, 0x27, 0xef, 0xc7, 0xac,
-	0x99, 0xf5, 0x08, 0x9a, 0xa2, 0x8e, 0xb8, 0x04, 0x61, 0x5f, 0x3a, 0x0c, 0xe7, 0x08, 0xa0, 0x55,
-	0x10, 0x62, 0x43, 0x34, 0xf2, 0x95, 0x06, 0xd7, 0x3d, 0xa3, 0x03, 0xd0, 0x5a, 0x86, 0x26, 0x15,
-	0x10, 0x0d, 0x79, 0xc5, 0x91, 0x5a, 0x68, 0x46, 0x59, 0xc4, 0xfe, 0xad, 0x69, 0x9c, 0xea, 0xe3,
-	0xd6, 0x95, 0x7d, 0x9e, 0x1c, 0xa3, 0x9d, 0xf1, 0x88, 0x3d, 0x21, 0x45, 0x37, 0x42, 0x48, 0x4f,
-	0x3e, 0x1d, 0xce, 0xe6, 0xba, 0x11, 0xf2, 0xff, 0x7a, 0x34, 0xef, 0xc6, 0xad, 0xde, 0x27, 0x5b,
-	0x3d, 0xd7, 0xab, 0xd5, 0x82, 0xa9, 0x8f, 0x5e, 0x8b, 0xc7, 0x21, 0x2e, 0xbc, 0x90, 0x93, 0xcc,
-	0xa9, 0xc7, 0x21, 0x56, 0x11, 0x7b, 0x2c, 0x6a, 0x06, 0xb9, 0x1f, 0xfd, 0x7a, 0x66, 0xb5, 0x01,
-	0xe6, 0x13, 0xa0, 0x83, 0xd1, 0x81, 0x69, 0x1f, 0xcf, 0xd5, 0x81, 0xc7, 0x73, 0xa4, 0xfd, 0xfc,
-	0xc5, 0xd3, 0x39, 0x00, 0xc7, 0x10, 0x86, 0x73, 0x1c, 0xe9, 0xc9, 0x0f, 0xdd, 0xce, 0xe7, 0x81,
-	0x3c, 0xd0, 0xd0, 0x4c, 0xa6, 0xfa, 0x7f, 0xf1, 0x6b, 0x7f, 0xf6, 0xe1, 0x01, 0x34, 0x22, 0xf1,
-	0xf0, 0xc7, 0x48, 0xae, 0x0d, 0x0c, 0xef, 0x72, 0x99, 0x3a, 0xd6, 0x1d, 0x7d, 0xae, 0xb7, 0xa1,
-	0x2a, 0x92, 0x3c, 0xf7, 0xc9, 0x2f, 0x7f, 0xde, 0x1f, 0x3e, 0x81, 0x67, 0xac, 0xcc, 0x05, 0x54,
-	0xed, 0x29, 0x9f, 0x6b, 0x68, 0x2c, 0x5a, 0x21, 0xf0, 0xe9, 0x2e, 0xb1, 0x77, 0xec, 0x1f, 0xfa,
-	0x99, 0xbe, 0x6c, 0x01, 0x65, 0x56, 0xa2, 0x3c, 0x8b, 0x8d, 0x6c, 0x94, 0x78, 0x29, 0xc1, 0xdf,
-	0x68, 0x68, 0xa2, 0x7d, 0x66, 0xf8, 0xc5, 0x2e, 0x89, 0x32, 0xa7, 0xaf, 0x2f, 0x0e, 0xe0, 0x01,
-	0x80, 0x0b, 0x12, 0x70, 0x16, 0x3f, 0x9f, 0x0d, 0xa8, 0x9e, 0xbe, 0x78, 0x80, 0xf8, 0x33, 0x0d,
-	0xe5, 0x44, 0x85, 0xf8, 0x54, 0x8f, 0x69, 0x44, 0x48, 0xb3, 0x3d, 0xed, 0xfa, 0x03, 0x91, 0x5d,
-	0xb2, 0x3e, 0x82, 0x1f, 0x8c, 0x7b, 0xf8, 0xa1, 0x86, 0x50, 0xb2, 0x6e, 0xe1, 0xf9, 0x1e, 0x69,
-	0xda, 0x96, 0x3b, 0x7d, 0xa1, 0x4f, 0x6b, 0x40, 0x5b, 0x92, 0x68, 0x26, 0x9e, 0xef, 0x0b, 0xcd,
-	0x52, 0xbb, 0x1c, 0xfe, 0x41, 0x43, 0xb8, 0x73, 0xef, 0xc2, 0x4b, 0xbd, 0x66, 0x94, 0xb5, 0xf6,
-	0xe9, 0xe7, 0x07, 0xf4, 0x02, 0xf2, 0x92, 0x24, 0x7f, 0x19, 0x5f, 0xe8, 0x8f, 0x5c, 0x4d, 0x5b,
-	0x7e, 0x4d, 0x46, 0xfe, 0xad, 0x86, 0xc6, 0x53, 0x5b, 0x15, 0x5e, 0xe8, 0x85, 0xd2, 0xb6, 0xc5,
-	0xe9, 0x66, 0xbf, 0xe6, 0x80, 0x7c, 0x41, 0x22, 0x2f, 0xe1, 0xb3, 0x83, 0x20, 0xab, 0xdd, 0x0c,
-	0x3f, 0xd0, 0x50, 0x3e, 0x5e, 0x4f, 0x70, 0xb7, 0x8b, 0xba, 0x73, 0x5d, 0xd3, 0xe7, 0xfb, 0x33,
-	0xde, 0xe3, 0x89, 0x10, 0xce, 0x0c, 0xff, 0xa4, 0xa1, 0x63, 0xcb, 0x8c, 0x7b, 0x75, 0x87, 0xbb,
-	0x1d, 0x4f, 0x3e, 0x3e, 0xd7, 0x8d, 0x60, 0x97, 0x15, 0x49, 0x5f, 0x1a, 0xcc, 0x09, 0xf0, 0x97,
-	0x25, 0xfe, 0x45, 0xfc, 0x4a, 0x36, 0x7e, 0x02, 0xee, 0x02, 0xad, 0xc5, 0xb6, 0x9c, 0xa0, 0xe2,
-	0x8a, 0x60, 0xf0, 0x2e, 0x55, 0x3c, 0x1f, 0xff, 0xac, 0x21, 0x7d, 0x97, 0x7a, 0x6e, 0x84, 0x1c,
-	0x0f, 0xc0, 0x96, 0x6c, 0x16, 0x5d, 0x4f, 0xfa, 0xee, 0x0f, 0x31, 0x59, 0x91, 0x25, 0xbd, 0x86,
-	0x5f, 0xfd, 0x17, 0x25, 0xd1, 0x90, 0x97, 0xca, 0x8f, 0x9e, 0x14, 0xb5, 0xc7, 0x4f, 0x8a, 0xda,
-	0x1f, 0x4f, 0x8a, 0xda, 0x17, 0x4f, 0x8b, 0x43, 0x8f, 0x9f, 0x16, 0x87, 0x7e, 0x7d, 0x5a, 0x1c,
-	0x7a, 0xdf, 0x4a, 0x3d, 0x78, 0x90, 0x63, 0xa1, 0xe6, 0xac, 0xb1, 0x38, 0xe1, 0x9d, 0x97, 0xac,
-	0xbb, 0x2a, 0xab, 0x7c, 0xfd, 0xd6, 0x46, 0xe5, 0xbf, 0x78, 0xe7, 0xfe, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0x47, 0xc7, 0xb1, 0x55, 0x11, 0x00, 0x00,
+func (m *QueryPoolVolumeRequest) Reset()         { *m = QueryPoolVolumeRequest{} }
+func (m *QueryPoolVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVolumeRequest) ProtoMessage()    {}
+func (*QueryPoolVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// Estimate the swap.
-	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
-	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+func (m *QueryPoolVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPoolVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPoolVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVolumeRequest.Merge(m, src)
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPoolVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVolumeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
-	out := new(QueryNumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolVolumeRequest proto.InternalMessageInfo
+
+func (m *QueryPoolVolumeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
-	out := new(QueryTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPoolVolumeResponse struct {
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolVolumeResponse) Reset()         { *m = QueryPoolVolumeResponse{} }
+func (m *QueryPoolVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVolumeResponse) ProtoMessage()    {}
+func (*QueryPoolVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
+}
+func (m *QueryPoolVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVolumeResponse.Merge(m, src)
+}
+func (m *QueryPoolVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVolumeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
-	out := new(QueryPoolParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolVolumeResponse proto.InternalMessageInfo
+
+func (m *QueryPoolVolumeResponse) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
-	out := new(QueryTotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== PoolFeesCollected
+type QueryPoolFeesCollectedRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
-	out := new(QueryTotalSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolFeesCollectedRequest) Reset()         { *m = QueryPoolFeesCollectedRequest{} }
+func (m *QueryPoolFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryPoolFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeesCollectedRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolFeesCollectedRequest proto.InternalMessageInfo
+
+func (m *QueryPoolFeesCollectedRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
-	out := new(QuerySwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPoolFeesCollectedResponse struct {
+	FeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_collected" yaml:"fees_collected"`
 }
 
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
-	out := new(QuerySwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolFeesCollectedResponse) Reset()         { *m = QueryPoolFeesCollectedResponse{} }
+func (m *QueryPoolFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryPoolFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// Estimate the swap.
-	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
-	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+func (m *QueryPoolFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeesCollectedResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryPoolFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryPoolFeesCollectedResponse) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+// =============================== TakerFeesCollected
+type QueryTakerFeesCollectedRequest struct {
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+
+func (m *QueryTakerFeesCollectedRequest) Reset()         { *m = QueryTakerFeesCollectedRequest{} }
+func (m *QueryTakerFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTakerFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryTakerFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+func (m *QueryTakerFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryTakerFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTakerFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+func (m *QueryTakerFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTakerFeesCollectedRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+func (m *QueryTakerFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+func (m *QueryTakerFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTakerFeesCollectedRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+
+var xxx_messageInfo_QueryTakerFeesCollectedRequest proto.InternalMessageInfo
+
+type QueryTakerFeesCollectedResponse struct {
+	TakerFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=taker_fees_collected,json=takerFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_collected" yaml:"taker_fees_collected"`
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+
+func (m *QueryTakerFeesCollectedResponse) Reset()         { *m = QueryTakerFeesCollectedResponse{} }
+func (m *QueryTakerFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTakerFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryTakerFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *QueryTakerFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTakerFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTakerFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTakerFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTakerFeesCollectedResponse.DiscardUnknown(m)
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+var xxx_messageInfo_QueryTakerFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryTakerFeesCollectedResponse) GetTakerFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesCollected
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNumPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== PoolPauseStatus
+type QueryPoolPauseStatusRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
+func (m *QueryPoolPauseStatusRequest) Reset()         { *m = QueryPoolPauseStatusRequest{} }
+func (m *QueryPoolPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPoolPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QueryPoolPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPoolPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolPauseStatusRequest.DiscardUnknown(m)
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+var xxx_messageInfo_QueryPoolPauseStatusRequest proto.InternalMessageInfo
+
+func (m *QueryPoolPauseStatusRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPoolPauseStatusResponse struct {
+	PauseStatus PoolPauseStatus `protobuf:"bytes,1,opt,name=pause_status,json=pauseStatus,proto3" json:"pause_status" yaml:"pause_status"`
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPoolLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+func (m *QueryPoolPauseStatusResponse) Reset()         { *m = QueryPoolPauseStatusResponse{} }
+func (m *QueryPoolPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPoolPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
+}
+func (m *QueryPoolPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPoolPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolPauseStatusResponse.DiscardUnknown(m)
 }
 
-func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+var xxx_messageInfo_QueryPoolPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPoolPauseStatusResponse) GetPauseStatus() PoolPauseStatus {
+	if m != nil {
+		return m.PauseStatus
 	}
-	return interceptor(ctx, in, info, handler)
+	return PoolPauseStatus{}
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryTotalLiquidityRequest struct {
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
+func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
+func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
+}
+func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
 }
 
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
+var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+
+type QueryTotalLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
+func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
+}
+func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
+}
+func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.gamm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "TotalLiquidity",
-			Handler:    _Query_TotalLiquidity_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "PoolParams",
-			Handler:    _Query_PoolParams_Handler,
-		},
-		{
-			MethodName: "TotalPoolLiquidity",
-			Handler:    _Query_TotalPoolLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalShares",
-			Handler:    _Query_TotalShares_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/gamm/v1beta1/query.proto",
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPoolsWithDenomsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithDenomsRequest")
+	proto.RegisterType((*QueryPoolsWithDenomsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithDenomsResponse")
+	proto.RegisterType((*QueryPoolsByTypeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsByTypeRequest")
+	proto.RegisterType((*QueryPoolsByTypeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsByTypeResponse")
+	proto.RegisterType((*QueryPoolsByMinLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsByMinLiquidityRequest")
+	proto.RegisterType((*QueryPoolsByMinLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsByMinLiquidityResponse")
+	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
+	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
+	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
+	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
+	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
+	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
+	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QueryEstimateBestRouteRequest)(nil), "osmosis.gamm.v1beta1.QueryEstimateBestRouteRequest")
+	proto.RegisterType((*QueryEstimateBestRouteResponse)(nil), "osmosis.gamm.v1beta1.QueryEstimateBestRouteResponse")
+	proto.RegisterType((*QueryPoolVolumeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolVolumeRequest")
+	proto.RegisterType((*QueryPoolVolumeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolVolumeResponse")
+	proto.RegisterType((*QueryPoolFeesCollectedRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolFeesCollectedRequest")
+	proto.RegisterType((*QueryPoolFeesCollectedResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolFeesCollectedResponse")
+	proto.RegisterType((*QueryTakerFeesCollectedRequest)(nil), "osmosis.gamm.v1beta1.QueryTakerFeesCollectedRequest")
+	proto.RegisterType((*QueryTakerFeesCollectedResponse)(nil), "osmosis.gamm.v1beta1.QueryTakerFeesCollectedResponse")
+	proto.RegisterType((*QueryPoolPauseStatusRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolPauseStatusRequest")
+	proto.RegisterType((*QueryPoolPauseStatusResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolPauseStatusResponse")
+	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
+	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
+
+var fileDescriptor_d9a717df9ca609ef = []byte{
+	// 2002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5d, 0x68, 0x5c, 0x59,
+	0x1d, 0xef, 0xc9, 0xa6, 0x69, 0xe6, 0xa4, 0xcd, 0xc7, 0x69, 0xda, 0x4e, 0x6f, 0xda, 0x4c, 0x3c,
+	0xb2, 0x4d, 0x3f, 0x92, 0xb9, 0x26, 0x4d, 0x76, 0xa1, 0xac, 0xae, 0x9d, 0x6d, 0xb2, 0x99, 0xb2,
+	0xbb, 0x89, 0xb7, 0xcb, 0x2e, 0xea, 0xc3, 0xe5, 0x66, 0x72, 0x9a, 0x5c, 0x3a, 0xf7, 0xa3, 0x39,
+	0xe7, 0xb6, 0x19, 0xa4, 0x08, 0xa2, 0x22, 0x28, 0xb8, 0xb0, 0xeb, 0x8b, 0x2c, 0x2a, 0xa2, 0xa0,
+	0x3e, 0x08, 0x42, 0x7d, 0x11, 0x1f, 0x04, 0x11, 0x16, 0x41, 0x58, 0xf1, 0x41, 0xf1, 0x61, 0x94,
+	0xd6, 0x07, 0x9f, 0x83, 0xef, 0xca, 0x39, 0xf7, 0x7f, 0x3f, 0x66, 0xe6, 0x66, 0x66, 0xee, 0xd8,
+	0x85, 0x3e, 0x65, 0xee, 0xf9, 0x7f, 0xfd, 0xfe, 0x1f, 0xe7, 0xe3, 0xff, 0x0f, 0x9e, 0xf3, 0xb8,
+	0xe3, 0x71, 0x9b, 0xeb, 0xbb, 0x96, 0xe3, 0xe8, 0x0f, 0x96, 0xb6, 0x99, 0xb0, 0x96, 0xf4, 0xfb,
+	0x01, 0xdb, 0x6f, 0x94, 0xfd, 0x7d, 0x4f, 0x78, 0x64, 0x1a, 0x38, 0xca, 0x92, 0xa3, 0x0c, 0x1c,
+	0xda, 0xf4, 0xae, 0xb7, 0xeb, 0x29, 0x06, 0x5d, 0xfe, 0x0a, 0x79, 0xb5, 0x8b, 0x99, 0xda, 0xc4,
+	0x01, 0x90, 0xb3, 0x8d, 0xf9, 0x56, 0xc0, 0x19, 0x70, 0xcc, 0xd6, 0x14, 0x8b, 0xbe, 0x6d, 0x71,
+	0x16, 0x33, 0xd4, 0x3c, 0xdb, 0x05, 0xfa, 0xd5, 0x34, 0x5d, 0xa1, 0x4c, 0xa9, 0xd9, 0xb5, 0x5d,
+	0x4b, 0xd8, 0x5e, 0xc4, 0x7b, 0x61, 0xd7, 0xf3, 0x76, 0xeb, 0x4c, 0xb7, 0x7c, 0x5b, 0xb7, 0x5c,
+	0xd7, 0x13, 0x8a, 0xc8, 0x81, 0x7a, 0x1e, 0xa8, 0xea, 0x6b, 0x3b, 0xb8, 0xab, 0x5b, 0x6e, 0x23,
+	0x22, 0x85, 0x46, 0xcc, 0xd0, 0xbd, 0xf0, 0x23, 0x24, 0xd1, 0x57, 0xf1, 0xe4, 0x17, 0xa4, 0xd5,
+	0x2d, 0xcf, 0xab, 0x1b, 0xec, 0x7e, 0xc0, 0xb8, 0x20, 0xd7, 0xf0, 0x09, 0xdf, 0xf3, 0xea, 0xa6,
+	0xbd, 0x53, 0x44, 0x73, 0xe8, 0xf2, 0x70, 0x85, 0x1c, 0x36, 0x4b, 0xe3, 0x0d, 0xcb, 0xa9, 0xdf,
+	0xa0, 0x40, 0xa0, 0xc6, 0x88, 0xfc, 0x55, 0xdd, 0xa1, 0x1b, 0x78, 0x2a, 0xa5, 0x80, 0xfb, 0x9e,
+	0xcb, 0x19, 0xb9, 0x8e, 0x87, 0x25, 0x59, 0x89, 0x8f, 0x2d, 0x4f, 0x97, 0x43, 0x68, 0xe5, 0x08,
+	0x5a, 0xf9, 0xa6, 0xdb, 0xa8, 0x14, 0xfe, 0xf8, 0x78, 0xf1, 0xb8, 0x94, 0xaa, 0x1a, 0x8a, 0x99,
+	0x7e, 0x39, 0xa5, 0x89, 0x47, 0x58, 0xd6, 0x31, 0x4e, 0xe2, 0x50, 0x1c, 0x52, 0xfa, 0x2e, 0x95,
+	0xc1, 0x05, 0x19, 0xb4, 0x72, 0x98, 0x5a, 0x08, 0x5a, 0x79, 0xcb, 0xda, 0x65, 0x20, 0x6b, 0xa4,
+	0x24, 0xe9, 0x07, 0x08, 0x93, 0xb4, 0x76, 0x00, 0xba, 0x8a, 0x8f, 0x4b, 0xdb, 0xbc, 0x88, 0xe6,
+	0x5e, 0xe8, 0x07, 0x69, 0xc8, 0x4d, 0x5e, 0xcf, 0x40, 0x35, 0xdf, 0x13, 0x55, 0x68, 0xb3, 0x05,
+	0xd6, 0x7b, 0x08, 0xcf, 0x24, 0xb0, 0xde, 0xb5, 0xc5, 0xde, 0x2d, 0xe6, 0x7a, 0x4e, 0xec, 0xfe,
+	0x15, 0x3c, 0xb2, 0xa3, 0x16, 0x14, 0xc0, 0x42, 0x65, 0xea, 0xb0, 0x59, 0x3a, 0x15, 0x66, 0x22,
+	0x5c, 0xa7, 0x06, 0x30, 0x3c, 0xb3, 0x48, 0xfd, 0x00, 0xe1, 0x0b, 0xd9, 0x90, 0x9e, 0x93, 0x98,
+	0x7d, 0x80, 0xf0, 0xb9, 0x04, 0x60, 0xa5, 0xf1, 0x76, 0xc3, 0x8f, 0x1c, 0x21, 0x4b, 0xb8, 0xa0,
+	0x2a, 0x54, 0x34, 0x7c, 0xa6, 0xaa, 0xaf, 0x50, 0x99, 0x3e, 0x6c, 0x96, 0x26, 0x53, 0xc5, 0x2b,
+	0x49, 0xd4, 0x18, 0x95, 0xbf, 0xa5, 0xe4, 0x33, 0x8b, 0xdb, 0xf7, 0x11, 0x2e, 0x76, 0xc2, 0x7a,
+	0x4e, 0x62, 0xf6, 0x1f, 0x84, 0x4b, 0x69, 0x70, 0x6f, 0xda, 0xee, 0x1b, 0xf6, 0xfd, 0xc0, 0xde,
+	0xb1, 0x45, 0x23, 0x8a, 0xdd, 0xb7, 0x10, 0x3e, 0xe5, 0xd8, 0xae, 0x59, 0x8f, 0x08, 0x00, 0xf6,
+	0x7c, 0x8b, 0xc1, 0xc8, 0xd4, 0x6b, 0x9e, 0xed, 0x56, 0x36, 0x3e, 0x6a, 0x96, 0x8e, 0x1d, 0x36,
+	0x4b, 0xd3, 0x61, 0x7c, 0x5b, 0xa4, 0xe9, 0x2f, 0xfe, 0x51, 0xba, 0xbc, 0x6b, 0x8b, 0xbd, 0x60,
+	0xbb, 0x5c, 0xf3, 0x1c, 0x38, 0x76, 0xe0, 0xcf, 0x22, 0xdf, 0xb9, 0xa7, 0xcb, 0x4c, 0x70, 0xa5,
+	0x88, 0x1b, 0x27, 0x9d, 0x14, 0xa2, 0x67, 0x96, 0x93, 0x1f, 0x23, 0x3c, 0x77, 0xb4, 0xdb, 0xcf,
+	0x49, 0x6e, 0xce, 0xe2, 0x69, 0x85, 0xf1, 0xad, 0xc0, 0x49, 0x1f, 0x7d, 0xf4, 0x36, 0x3e, 0xd3,
+	0xb6, 0x0e, 0x80, 0x97, 0x70, 0xc1, 0x0d, 0x1c, 0x33, 0x02, 0x2d, 0x4f, 0xe8, 0x54, 0x91, 0xc7,
+	0x24, 0x6a, 0x8c, 0xba, 0x20, 0x4a, 0xd7, 0xf0, 0xd9, 0x38, 0x0e, 0x5b, 0xd6, 0xbe, 0xe5, 0xf0,
+	0x81, 0x0e, 0xfb, 0xd7, 0xf1, 0xb9, 0x0e, 0x35, 0x00, 0x6a, 0x01, 0x8f, 0xf8, 0x6a, 0xa5, 0xdb,
+	0xa1, 0x6f, 0x00, 0x0f, 0x7d, 0x13, 0xcf, 0x2a, 0x45, 0x6f, 0x7b, 0xc2, 0xaa, 0x4b, 0x6d, 0x1d,
+	0xd5, 0x98, 0x0b, 0xd7, 0x8f, 0xa2, 0xf2, 0xce, 0xd2, 0x07, 0x00, 0x1f, 0xe1, 0x42, 0x8e, 0xca,
+	0xbe, 0x05, 0x95, 0x0d, 0x41, 0x1d, 0xb0, 0xaa, 0x13, 0x8b, 0x74, 0x1d, 0x9f, 0x4b, 0x10, 0xde,
+	0xd9, 0xb3, 0xf6, 0xd9, 0x60, 0x29, 0x08, 0x70, 0xb1, 0x53, 0x0f, 0xb8, 0xf8, 0x45, 0x7c, 0x52,
+	0xc8, 0x65, 0x93, 0xab, 0x75, 0xc8, 0x44, 0x17, 0x2f, 0x67, 0xc0, 0xcb, 0xd3, 0xa1, 0xb1, 0xb4,
+	0x30, 0x35, 0xc6, 0x44, 0x62, 0x82, 0xfe, 0x1b, 0x41, 0x35, 0xde, 0xf1, 0x3d, 0xb1, 0xb5, 0x6f,
+	0xd7, 0xd8, 0x20, 0xe8, 0xc9, 0x1a, 0x9e, 0x94, 0x28, 0x4c, 0x8b, 0x73, 0x26, 0x4c, 0x75, 0x73,
+	0xa9, 0xad, 0x53, 0xa8, 0xcc, 0x1c, 0x36, 0x4b, 0xe7, 0x42, 0xa9, 0x76, 0x0e, 0x6a, 0x8c, 0xcb,
+	0xa5, 0x9b, 0x72, 0x45, 0xdd, 0x45, 0x64, 0x03, 0x4f, 0xdd, 0x0f, 0x3c, 0xd1, 0xaa, 0xe7, 0x05,
+	0xa5, 0xe7, 0xc2, 0x61, 0xb3, 0x54, 0x0c, 0xf5, 0x74, 0xb0, 0x50, 0x63, 0x42, 0xad, 0x25, 0x9a,
+	0x6e, 0x0f, 0x8f, 0x0e, 0x4f, 0x1e, 0x37, 0xc6, 0x1e, 0xda, 0x62, 0xef, 0xce, 0x43, 0xcb, 0x5f,
+	0x67, 0x8c, 0xbe, 0x85, 0xcf, 0xb6, 0x7b, 0x0a, 0xf1, 0x5d, 0xc1, 0x98, 0xfb, 0x9e, 0x30, 0x7d,
+	0xb9, 0x0a, 0xd7, 0xcb, 0x99, 0xc3, 0x66, 0x69, 0x2a, 0xb4, 0x97, 0xd0, 0xa8, 0x51, 0xe0, 0x91,
+	0x34, 0xfd, 0x2f, 0xc2, 0x17, 0x43, 0x85, 0x0f, 0x2d, 0x7f, 0xed, 0xc0, 0xaa, 0x89, 0x9b, 0x8e,
+	0x17, 0xb8, 0xa2, 0xea, 0xa6, 0x6e, 0x79, 0xce, 0xdc, 0x1d, 0xb6, 0x0f, 0x3a, 0x53, 0xb7, 0x7c,
+	0xb8, 0x4e, 0x0d, 0x60, 0x48, 0x47, 0x7b, 0xa8, 0x67, 0xb4, 0xcb, 0x78, 0x54, 0x78, 0xf7, 0x98,
+	0x6b, 0xda, 0x2e, 0x44, 0xe7, 0xf4, 0x61, 0xb3, 0x34, 0x11, 0x25, 0x3b, 0xa4, 0x50, 0xe3, 0x84,
+	0xfa, 0x59, 0x75, 0xc9, 0x3b, 0x78, 0x64, 0xdf, 0x0b, 0x04, 0xe3, 0xc5, 0x61, 0xb5, 0x3f, 0xe6,
+	0xcb, 0x59, 0x4f, 0xe5, 0xb2, 0xf4, 0x23, 0x76, 0x41, 0xf2, 0x57, 0xce, 0x40, 0x1d, 0x01, 0xe8,
+	0x50, 0x09, 0x35, 0x40, 0x1b, 0xfd, 0x1e, 0x82, 0xed, 0x9e, 0x11, 0x01, 0x08, 0x2d, 0xc7, 0x93,
+	0x21, 0x20, 0x2f, 0x10, 0xa6, 0xa5, 0xa8, 0x10, 0x8c, 0xaa, 0xd4, 0xfd, 0xf7, 0x66, 0xe9, 0x52,
+	0x1f, 0xbb, 0xae, 0xea, 0x8a, 0xa4, 0x8c, 0xda, 0xf5, 0x51, 0x63, 0x5c, 0x2d, 0x6d, 0x06, 0x60,
+	0x9e, 0x7e, 0x7d, 0x28, 0x1b, 0xd7, 0x66, 0x20, 0x3e, 0xe9, 0xd4, 0xbc, 0x1b, 0x87, 0xfa, 0x05,
+	0x15, 0xea, 0xcb, 0xbd, 0x42, 0x2d, 0x31, 0xf5, 0x11, 0x6b, 0x79, 0x39, 0xc4, 0x8e, 0x17, 0x87,
+	0xdb, 0x5f, 0x40, 0x31, 0x89, 0x1a, 0xa3, 0x51, 0x30, 0xe8, 0xfb, 0xd1, 0xe9, 0x99, 0x15, 0x06,
+	0xc8, 0x8f, 0x8f, 0x27, 0xa2, 0x82, 0x69, 0x4d, 0xcf, 0x46, 0xee, 0xf4, 0x9c, 0x6d, 0xad, 0xbf,
+	0x38, 0x3b, 0xa7, 0xa0, 0x0c, 0x21, 0x39, 0xbf, 0x8f, 0xb6, 0xcd, 0x1a, 0x17, 0xb6, 0x63, 0x09,
+	0x56, 0x91, 0xb7, 0xbb, 0x74, 0x32, 0xca, 0x4d, 0xba, 0xbc, 0x51, 0x1f, 0xe5, 0x5d, 0xc1, 0x13,
+	0x49, 0x4d, 0xa4, 0xcf, 0x1e, 0xad, 0x1d, 0x55, 0xcc, 0x10, 0xa1, 0xda, 0x0c, 0xe0, 0xe4, 0x29,
+	0xe3, 0x51, 0xc7, 0x3a, 0x30, 0xf7, 0x3c, 0x9f, 0xab, 0x2d, 0x35, 0x9c, 0xb6, 0x19, 0x51, 0xa8,
+	0x71, 0xc2, 0xb1, 0x0e, 0x36, 0xe4, 0xaf, 0xbf, 0x46, 0x25, 0x96, 0xe1, 0x05, 0x84, 0x36, 0xd9,
+	0x75, 0xe8, 0x59, 0xee, 0xba, 0xcc, 0x2d, 0x35, 0xf4, 0x09, 0x6f, 0x29, 0xb2, 0x87, 0x4f, 0xaa,
+	0x13, 0xd0, 0xb4, 0x1d, 0xdf, 0xaa, 0x09, 0x38, 0x76, 0xd6, 0x72, 0x18, 0xbc, 0xc5, 0x6a, 0xc9,
+	0x8d, 0x94, 0xd6, 0x45, 0x8d, 0x31, 0xf5, 0x59, 0x0d, 0xbf, 0xd2, 0x4f, 0x9a, 0x77, 0xbc, 0x7a,
+	0xe0, 0x0c, 0x74, 0x23, 0xd1, 0xef, 0xa6, 0xbb, 0x89, 0x48, 0x0f, 0x64, 0x46, 0xe0, 0x91, 0x07,
+	0x6a, 0xa5, 0xf7, 0x7b, 0xe1, 0x66, 0x6b, 0x2e, 0x42, 0xb1, 0x7c, 0x8f, 0x05, 0xb0, 0x45, 0xdf,
+	0xc0, 0x17, 0x63, 0x40, 0xeb, 0x8c, 0xf1, 0xd7, 0xbc, 0x7a, 0x9d, 0xd5, 0x04, 0xdb, 0x19, 0xc8,
+	0xbf, 0x5f, 0x46, 0x67, 0x6f, 0x86, 0x3a, 0x70, 0xf3, 0xdb, 0x08, 0x8f, 0xdf, 0x65, 0x8c, 0x9b,
+	0xb5, 0x88, 0xd4, 0xdb, 0xdf, 0x2a, 0xf8, 0x7b, 0x26, 0x34, 0xdb, 0x2a, 0x9e, 0xcf, 0xef, 0x53,
+	0x77, 0xd3, 0xa8, 0xe8, 0x5c, 0xf4, 0x34, 0xb4, 0xee, 0xb1, 0xfd, 0x2c, 0xff, 0xe9, 0xef, 0xe2,
+	0xd7, 0x5e, 0x06, 0x0b, 0xf8, 0xf4, 0x21, 0xc2, 0xd3, 0x42, 0x92, 0xcd, 0xbc, 0x9e, 0x6d, 0x82,
+	0x67, 0x33, 0x50, 0xf2, 0x19, 0x4a, 0xf2, 0xf9, 0x47, 0x44, 0x07, 0x4c, 0x7a, 0x3b, 0xd5, 0xf6,
+	0x6f, 0x59, 0x01, 0x67, 0x77, 0x84, 0x25, 0x82, 0xc1, 0x5e, 0x84, 0xdf, 0x48, 0x37, 0xec, 0x2d,
+	0xca, 0x20, 0x16, 0x0c, 0x9f, 0x54, 0x23, 0x29, 0x93, 0xab, 0x75, 0x78, 0x16, 0xbe, 0x98, 0x7d,
+	0xcc, 0xb4, 0x29, 0x69, 0x7f, 0x22, 0xa6, 0x15, 0xc9, 0x0d, 0x99, 0x70, 0xd2, 0x0b, 0x58, 0x4b,
+	0x5e, 0xa6, 0xed, 0xef, 0x79, 0xfa, 0x61, 0x34, 0xe9, 0x68, 0x27, 0x3f, 0x17, 0xcf, 0xf3, 0xe5,
+	0xc7, 0x45, 0x7c, 0x5c, 0xc1, 0x23, 0x5f, 0xc5, 0xaa, 0xcf, 0xe3, 0xe4, 0x88, 0x73, 0xb8, 0x63,
+	0x46, 0xa5, 0x5d, 0xee, 0xcd, 0x18, 0x3a, 0x49, 0x3f, 0xfd, 0xb5, 0xbf, 0xfc, 0xeb, 0xfd, 0xa1,
+	0x8b, 0x64, 0x46, 0xcf, 0x1e, 0x1c, 0x2a, 0xbb, 0x3f, 0x47, 0x78, 0xa2, 0x6d, 0xf6, 0x42, 0x96,
+	0x7a, 0x99, 0xe8, 0x18, 0x1d, 0x69, 0xcb, 0x79, 0x44, 0x00, 0x9f, 0xae, 0xf0, 0x5d, 0x21, 0xf3,
+	0x5d, 0xf0, 0x99, 0xf2, 0xad, 0x6c, 0xc2, 0xd0, 0xe9, 0xa7, 0x08, 0x8f, 0xa5, 0xe6, 0x1d, 0x64,
+	0xb1, 0x97, 0xd1, 0x96, 0x71, 0x8d, 0x56, 0xee, 0x97, 0x1d, 0xf0, 0xbd, 0xac, 0xf0, 0x2d, 0x11,
+	0xbd, 0x1b, 0xbe, 0xed, 0x86, 0x9a, 0xf1, 0xe8, 0x5f, 0x89, 0xc7, 0x3d, 0x8f, 0xc8, 0x6f, 0x10,
+	0x3e, 0x9d, 0x31, 0x03, 0x20, 0xab, 0xbd, 0x01, 0x64, 0x8c, 0x4a, 0xb4, 0x97, 0xf2, 0x8a, 0x01,
+	0xfe, 0x15, 0x85, 0xbf, 0x4c, 0x16, 0x7a, 0xe0, 0x6f, 0x19, 0xa4, 0x90, 0xef, 0x20, 0x3c, 0x1a,
+	0x0d, 0x01, 0xc8, 0xd5, 0x2e, 0xa6, 0xdb, 0x26, 0x08, 0xda, 0xb5, 0xbe, 0x78, 0x01, 0xdb, 0xbc,
+	0xc2, 0xf6, 0x29, 0x52, 0xca, 0xc6, 0x16, 0x8f, 0x15, 0xc8, 0x4f, 0x10, 0x1e, 0x6f, 0xdd, 0xc4,
+	0xe4, 0x33, 0x5d, 0x0c, 0x65, 0x1e, 0x07, 0xda, 0x52, 0x0e, 0x09, 0x00, 0xb8, 0xa8, 0x00, 0xce,
+	0x93, 0x17, 0xb3, 0x01, 0x86, 0xcd, 0x6b, 0x12, 0xb5, 0x6f, 0x22, 0x3c, 0x2c, 0x3d, 0x24, 0x97,
+	0x7a, 0x24, 0x2b, 0x82, 0x34, 0xdf, 0x93, 0xaf, 0x3f, 0x20, 0x2a, 0x4a, 0x50, 0x7d, 0xf6, 0xce,
+	0x23, 0xf2, 0x43, 0x84, 0x71, 0x32, 0x30, 0x21, 0x0b, 0x3d, 0xcc, 0xb4, 0x8c, 0x67, 0xb4, 0xc5,
+	0x3e, 0xb9, 0x73, 0x14, 0x58, 0x02, 0x4d, 0x0f, 0xa7, 0x31, 0xe4, 0x0f, 0x08, 0x93, 0xce, 0xc9,
+	0x09, 0x59, 0xe9, 0x95, 0xa3, 0xac, 0xc1, 0x8d, 0xb6, 0x9a, 0x53, 0x0a, 0x90, 0x57, 0x14, 0xf2,
+	0x57, 0xc8, 0x8d, 0xfe, 0x90, 0x87, 0xd9, 0x56, 0x9f, 0x49, 0xca, 0x7f, 0x86, 0xf0, 0x58, 0x6a,
+	0x2e, 0xd2, 0xf5, 0x34, 0xea, 0x9c, 0xc3, 0x68, 0xe5, 0x7e, 0xd9, 0x01, 0xf2, 0x0d, 0x05, 0x79,
+	0x85, 0x2c, 0xe7, 0x81, 0x1c, 0x4e, 0x57, 0xe4, 0xfb, 0xa4, 0x10, 0x0f, 0x18, 0x48, 0xb7, 0x8d,
+	0xda, 0x3e, 0x70, 0xd1, 0x16, 0xfa, 0x63, 0x1e, 0xb0, 0x22, 0xa4, 0x30, 0x27, 0x7f, 0x42, 0xf8,
+	0x7c, 0xd4, 0xb1, 0x74, 0x34, 0xed, 0xe4, 0x7a, 0x37, 0x04, 0x47, 0x0c, 0x39, 0xb4, 0x95, 0x7c,
+	0x42, 0x00, 0x7f, 0x4d, 0xc1, 0x7f, 0x95, 0x7c, 0x36, 0x1b, 0x7e, 0x02, 0x9c, 0x01, 0x5a, 0x9d,
+	0x3f, 0xb4, 0x7c, 0x93, 0x49, 0x65, 0xd0, 0xa4, 0x98, 0xb6, 0x4b, 0xfe, 0x8c, 0xb0, 0x76, 0x84,
+	0x3f, 0x9b, 0x81, 0x20, 0x39, 0xb0, 0x25, 0xb3, 0x01, 0x6d, 0x35, 0xa7, 0x14, 0xb8, 0xb4, 0xae,
+	0x5c, 0xfa, 0x3c, 0xf9, 0xdc, 0xff, 0xe1, 0x92, 0x17, 0x08, 0xf2, 0x2b, 0x84, 0xa7, 0x3a, 0xba,
+	0xca, 0xae, 0xb9, 0x39, 0xaa, 0x93, 0xd6, 0x56, 0xf2, 0x09, 0x81, 0x23, 0x4b, 0xca, 0x91, 0x6b,
+	0xe4, 0x4a, 0xb6, 0x23, 0x31, 0xfc, 0x6d, 0xc6, 0x85, 0xa9, 0x9a, 0xd2, 0xf8, 0x2c, 0x0c, 0x1b,
+	0xad, 0x9e, 0x67, 0x61, 0x4b, 0x5f, 0xa7, 0x2d, 0xf6, 0xc9, 0x3d, 0x58, 0xe5, 0x87, 0xdd, 0x17,
+	0xf9, 0x2d, 0xc2, 0x53, 0x1d, 0xad, 0x52, 0xd7, 0xa8, 0x1e, 0xd5, 0xa7, 0x69, 0x2b, 0xf9, 0x84,
+	0x00, 0xf6, 0x2b, 0x0a, 0xf6, 0x4b, 0x64, 0xa5, 0x3f, 0xd8, 0xad, 0x9d, 0x09, 0xf9, 0xb5, 0x3c,
+	0xca, 0x3b, 0xfa, 0x8d, 0xee, 0x47, 0xf9, 0x51, 0x8d, 0x96, 0xb6, 0x9a, 0x53, 0x0a, 0x3c, 0x58,
+	0x56, 0x1e, 0x2c, 0x90, 0xab, 0x47, 0x5c, 0xd4, 0x19, 0x1d, 0x15, 0x79, 0x0c, 0x8f, 0xde, 0x54,
+	0xeb, 0xd1, 0xf3, 0xd1, 0xdb, 0xd9, 0x38, 0x69, 0xcb, 0x79, 0x44, 0x06, 0x3b, 0xc6, 0xd3, 0x1d,
+	0x50, 0xa5, 0xfa, 0xd1, 0x93, 0x59, 0xf4, 0xf1, 0x93, 0x59, 0xf4, 0xcf, 0x27, 0xb3, 0xe8, 0xbd,
+	0xa7, 0xb3, 0xc7, 0x3e, 0x7e, 0x3a, 0x7b, 0xec, 0x6f, 0x4f, 0x67, 0x8f, 0x7d, 0x49, 0x4f, 0x75,
+	0x21, 0xa0, 0x77, 0xb1, 0x6e, 0x6d, 0xf3, 0xd8, 0xc8, 0x83, 0x97, 0xf5, 0x83, 0xd0, 0x92, 0x6a,
+	0x49, 0xb6, 0x47, 0xd4, 0x3f, 0x4a, 0xae, 0xff, 0x6f, 0x00, 0x31, 0xa7, 0x2d, 0x61, 0xc1, 0x20,
+	0x00, 0x00,
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// PoolsWithDenoms returns the pools containing all of the given denoms.
+	PoolsWithDenoms(ctx context.Context, in *QueryPoolsWithDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsWithDenomsResponse, error)
+	// PoolsByType returns the pools of a pool type, which is one of balancer,
+	// stableswap or concentrated.
+	PoolsByType(ctx context.Context, in *QueryPoolsByTypeRequest, opts ...grpc.CallOption) (*QueryPoolsByTypeResponse, error)
+	// PoolsByMinLiquidity returns the pools with at least the given liquidity
+	// of each denom.
+	PoolsByMinLiquidity(ctx context.Context, in *QueryPoolsByMinLiquidityRequest, opts ...grpc.CallOption) (*QueryPoolsByMinLiquidityResponse, error)
+	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// Estimate the swap.
+	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
+	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateBestRoute returns the multihop route with the highest amount out
+	// when swapping token_in for token_out_denom.
+	EstimateBestRoute(ctx context.Context, in *QueryEstimateBestRouteRequest, opts ...grpc.CallOption) (*QueryEstimateBestRouteResponse, error)
+	// PoolVolume returns the cumulative swap volume of a pool per denom.
+	PoolVolume(ctx context.Context, in *QueryPoolVolumeRequest, opts ...grpc.CallOption) (*QueryPoolVolumeResponse, error)
+	// PoolFeesCollected returns the cumulative swap fees collected by a pool
+	// per denom.
+	PoolFeesCollected(ctx context.Context, in *QueryPoolFeesCollectedRequest, opts ...grpc.CallOption) (*QueryPoolFeesCollectedResponse, error)
+	// TakerFeesCollected returns the taker fees collected from all swaps.
+	TakerFeesCollected(ctx context.Context, in *QueryTakerFeesCollectedRequest, opts ...grpc.CallOption) (*QueryTakerFeesCollectedResponse, error)
+	// PoolPauseStatus returns which operations of a pool are paused.
+	PoolPauseStatus(ctx context.Context, in *QueryPoolPauseStatusRequest, opts ...grpc.CallOption) (*QueryPoolPauseStatusResponse, error)
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PoolsWithDenoms(ctx context.Context, in *QueryPoolsWithDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsWithDenomsResponse, error) {
+	out := new(QueryPoolsWithDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolsByType(ctx context.Context, in *QueryPoolsByTypeRequest, opts ...grpc.CallOption) (*QueryPoolsByTypeResponse, error) {
+	out := new(QueryPoolsByTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsByType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolsByMinLiquidity(ctx context.Context, in *QueryPoolsByMinLiquidityRequest, opts ...grpc.CallOption) (*QueryPoolsByMinLiquidityResponse, error) {
+	out := new(QueryPoolsByMinLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsByMinLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
+	out := new(QueryNumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
+	out := new(QueryTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
+	out := new(QueryTotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
+	out := new(QuerySwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
+	out := new(QuerySwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateBestRoute(ctx context.Context, in *QueryEstimateBestRouteRequest, opts ...grpc.CallOption) (*QueryEstimateBestRouteResponse, error) {
+	out := new(QueryEstimateBestRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateBestRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) PoolVolume(ctx context.Context, in *QueryPoolVolumeRequest, opts ...grpc.CallOption) (*QueryPoolVolumeResponse, error) {
+	out := new(QueryPoolVolumeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolFeesCollected(ctx context.Context, in *QueryPoolFeesCollectedRequest, opts ...grpc.CallOption) (*QueryPoolFeesCollectedResponse, error) {
+	out := new(QueryPoolFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolFeesCollected", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) TakerFeesCollected(ctx context.Context, in *QueryTakerFeesCollectedRequest, opts ...grpc.CallOption) (*QueryTakerFeesCollectedResponse, error) {
+	out := new(QueryTakerFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TakerFeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolPauseStatus(ctx context.Context, in *QueryPoolPauseStatusRequest, opts ...grpc.CallOption) (*QueryPoolPauseStatusResponse, error) {
+	out := new(QueryPoolPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolPauseStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// PoolsWithDenoms returns the pools containing all of the given denoms.
+	PoolsWithDenoms(context.Context, *QueryPoolsWithDenomsRequest) (*QueryPoolsWithDenomsResponse, error)
+	// PoolsByType returns the pools of a pool type, which is one of balancer,
+	// stableswap or concentrated.
+	PoolsByType(context.Context, *QueryPoolsByTypeRequest) (*QueryPoolsByTypeResponse, error)
+	// PoolsByMinLiquidity returns the pools with at least the given liquidity
+	// of each denom.
+	PoolsByMinLiquidity(context.Context, *QueryPoolsByMinLiquidityRequest) (*QueryPoolsByMinLiquidityResponse, error)
+	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// Estimate the swap.
+	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
+	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateBestRoute returns the multihop route with the highest amount out
+	// when swapping token_in for token_out_denom.
+	EstimateBestRoute(context.Context, *QueryEstimateBestRouteRequest) (*QueryEstimateBestRouteResponse, error)
+	// PoolVolume returns the cumulative swap volume of a pool per denom.
+	PoolVolume(context.Context, *QueryPoolVolumeRequest) (*QueryPoolVolumeResponse, error)
+	// PoolFeesCollected returns the cumulative swap fees collected by a pool
+	// per denom.
+	PoolFeesCollected(context.Context, *QueryPoolFeesCollectedRequest) (*QueryPoolFeesCollectedResponse, error)
+	// TakerFeesCollected returns the taker fees collected from all swaps.
+	TakerFeesCollected(context.Context, *QueryTakerFeesCollectedRequest) (*QueryTakerFeesCollectedResponse, error)
+	// PoolPauseStatus returns which operations of a pool are paused.
+	PoolPauseStatus(context.Context, *QueryPoolPauseStatusRequest) (*QueryPoolPauseStatusResponse, error)
 }
 
-func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
-
-func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) PoolsWithDenoms(ctx context.Context, req *QueryPoolsWithDenomsRequest) (*QueryPoolsWithDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithDenoms not implemented")
 }
-
-func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) PoolsByType(ctx context.Context, req *QueryPoolsByTypeRequest) (*QueryPoolsByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByType not implemented")
 }
-
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) PoolsByMinLiquidity(ctx context.Context, req *QueryPoolsByMinLiquidityRequest) (*QueryPoolsByMinLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByMinLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBestRoute(ctx context.Context, req *QueryEstimateBestRouteRequest) (*QueryEstimateBestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBestRoute not implemented")
+}
+func (*UnimplementedQueryServer) PoolVolume(ctx context.Context, req *QueryPoolVolumeRequest) (*QueryPoolVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolume not implemented")
+}
+func (*UnimplementedQueryServer) PoolFeesCollected(ctx context.Context, req *QueryPoolFeesCollectedRequest) (*QueryPoolFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFeesCollected not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeesCollected(ctx context.Context, req *QueryTakerFeesCollectedRequest) (*QueryTakerFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeesCollected not implemented")
+}
+func (*UnimplementedQueryServer) PoolPauseStatus(ctx context.Context, req *QueryPoolPauseStatusRequest) (*QueryPoolPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolPauseStatus not implemented")
 }
 
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolsWithDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsWithDenomsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsWithDenoms(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsWithDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsWithDenoms(ctx, req.(*QueryPoolsWithDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByTypeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByType(ctx, req.(*QueryPoolsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolsByMinLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByMinLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByMinLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsByMinLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByMinLiquidity(ctx, req.(*QueryPoolsByMinLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateBestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateBestRouteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateBestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBestRoute(ctx, req.(*QueryEstimateBestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolume(ctx, req.(*QueryPoolVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_PoolFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFeesCollected(ctx, req.(*QueryPoolFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TakerFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTakerFeesCollectedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TakerFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeesCollected(ctx, req.(*QueryTakerFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolPauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolPauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolPauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolPauseStatus(ctx, req.(*QueryPoolPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "PoolsWithDenoms",
+			Handler:    _Query_PoolsWithDenoms_Handler,
+		},
+		{
+			MethodName: "PoolsByType",
+			Handler:    _Query_PoolsByType_Handler,
+		},
+		{
+			MethodName: "PoolsByMinLiquidity",
+			Handler:    _Query_PoolsByMinLiquidity_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "TotalLiquidity",
+			Handler:    _Query_TotalLiquidity_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "PoolParams",
+			Handler:    _Query_PoolParams_Handler,
+		},
+		{
+			MethodName: "TotalPoolLiquidity",
+			Handler:    _Query_TotalPoolLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "EstimateBestRoute",
+			Handler:    _Query_EstimateBestRoute_Handler,
+		},
+		{
+			MethodName: "PoolVolume",
+			Handler:    _Query_PoolVolume_Handler,
+		},
+		{
+			MethodName: "PoolFeesCollected",
+			Handler:    _Query_PoolFeesCollected_Handler,
+		},
+		{
+			MethodName: "TakerFeesCollected",
+			Handler:    _Query_TakerFeesCollected_Handler,
+		},
+		{
+			MethodName: "PoolPauseStatus",
+			Handler:    _Query_PoolPauseStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/v1beta1/query.proto",
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsWithDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
-	}
-	return n
+func (m *QueryPoolsWithDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsWithDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolsWithDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *QueryPoolsWithDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsWithDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryPoolsByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolsByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolType) > 0 {
+		i -= len(m.PoolType)
+		copy(dAtA[i:], m.PoolType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolsByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsByMinLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPoolsByMinLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsByMinLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinLiquidity) > 0 {
+		for iNdEx := len(m.MinLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateBestRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateBestRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBestRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateBestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateBestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTakerFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTakerFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTakerFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTakerFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTakerFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTakerFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerFeesCollected) > 0 {
+		for iNdEx := len(m.TakerFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByMinLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinLiquidity) > 0 {
+		for _, e := range m.MinLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByMinLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *QueryPoolParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateBestRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *QueryEstimateBestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTakerFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTakerFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeesCollected) > 0 {
+		for _, e := range m.TakerFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PauseStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsWithDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsWithDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsWithDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsWithDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsWithDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsWithDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByMinLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByMinLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByMinLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLiquidity = append(m.MinLiquidity, types1.Coin{})
+			if err := m.MinLiquidity[len(m.MinLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByMinLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByMinLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByMinLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2516,10 +5746,10 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2562,7 +5792,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,15 +5815,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2620,10 +5850,10 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
+			if m.Params == nil {
+				m.Params = &types.Any{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2648,7 +5878,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2671,17 +5901,17 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2691,28 +5921,11 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2734,7 +5947,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,15 +5970,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2792,16 +6005,66 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types1.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2811,28 +6074,11 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2854,7 +6100,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2877,12 +6123,45 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2904,7 +6183,7 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2927,17 +6206,17 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.NumPools = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2947,11 +6226,75 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2973,7 +6316,7 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2996,17 +6339,17 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3016,11 +6359,24 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3042,7 +6398,7 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3065,15 +6421,98 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3100,10 +6539,8 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3128,7 +6565,7 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3151,17 +6588,17 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3171,11 +6608,26 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3197,7 +6649,7 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3220,15 +6672,66 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3240,25 +6743,57 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types1.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3281,7 +6816,7 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3304,17 +6839,17 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3324,11 +6859,26 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3350,7 +6900,7 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateBestRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3373,17 +6923,17 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateBestRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateBestRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3393,25 +6943,75 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3433,7 +7033,7 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateBestRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3456,17 +7056,17 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateBestRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateBestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3476,14 +7076,29 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3511,11 +7126,13 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3543,7 +7160,9 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3566,7 +7185,7 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,17 +7208,17 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3609,24 +7228,11 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3648,7 +7254,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3665,104 +7271,21 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolVolumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3789,8 +7312,8 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types1.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3815,7 +7338,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeesCollectedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3838,17 +7361,17 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeesCollectedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3858,26 +7381,11 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3899,7 +7407,7 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeesCollectedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,17 +7430,17 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeesCollectedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3942,46 +7450,129 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.FeesCollected = append(m.FeesCollected, types1.Coin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTakerFeesCollectedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTakerFeesCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCollected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4008,16 +7599,66 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeesCollected = append(m.TakerFeesCollected, types1.Coin{})
+			if err := m.TakerFeesCollected[len(m.TakerFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolPauseStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolPauseStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4027,24 +7668,11 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4066,7 +7694,7 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolPauseStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4089,17 +7717,17 @@ func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolPauseStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4109,23 +7737,22 @@ func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 type SwapAmountInRoutes []SwapAmountInRoute
 
@@ -35,3 +38,83 @@ func (routes SwapAmountOutRoutes) Validate() error {
 
 	return nil
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that every route is a valid multihop route swapping a
+// positive amount in, and that all the routes end in the same denom.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	tokenOutDenom := ""
+	for _, route := range routes {
+		err := SwapAmountInRoutes(route.Pools).Validate()
+		if err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return sdkerrors.Wrapf(ErrNotPositiveRequireAmount, "route token in amount %s", route.TokenInAmount)
+		}
+
+		routeTokenOutDenom := route.Pools[len(route.Pools)-1].TokenOutDenom
+		if tokenOutDenom == "" {
+			tokenOutDenom = routeTokenOutDenom
+		} else if routeTokenOutDenom != tokenOutDenom {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "routes end in both %s and %s", tokenOutDenom, routeTokenOutDenom)
+		}
+	}
+
+	return nil
+}
+
+// TokenInAmount returns the total amount swapped in by the routes.
+func (routes SwapAmountInSplitRoutes) TokenInAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, route := range routes {
+		total = total.Add(route.TokenInAmount)
+	}
+	return total
+}
+
+type SwapAmountOutSplitRoutes []SwapAmountOutSplitRoute
+
+// Validate checks that every route is a valid multihop route swapping for a
+// positive amount out, and that all the routes start from the same denom.
+func (routes SwapAmountOutSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	tokenInDenom := ""
+	for _, route := range routes {
+		err := SwapAmountOutRoutes(route.Pools).Validate()
+		if err != nil {
+			return err
+		}
+
+		if route.TokenOutAmount.IsNil() || !route.TokenOutAmount.IsPositive() {
+			return sdkerrors.Wrapf(ErrNotPositiveRequireAmount, "route token out amount %s", route.TokenOutAmount)
+		}
+
+		routeTokenInDenom := route.Pools[0].TokenInDenom
+		if tokenInDenom == "" {
+			tokenInDenom = routeTokenInDenom
+		} else if routeTokenInDenom != tokenInDenom {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "routes start from both %s and %s", tokenInDenom, routeTokenInDenom)
+		}
+	}
+
+	return nil
+}
+
+// TokenOutAmount returns the total amount swapped out by the routes.
+func (routes SwapAmountOutSplitRoutes) TokenOutAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, route := range routes {
+		total = total.Add(route.TokenOutAmount)
+	}
+	return total
+}
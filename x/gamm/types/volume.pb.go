@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/volume.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolVolume is the cumulative swap volume of a pool and the swap fees it
+// collected, per denom.
+type PoolVolume struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// volume is the sum of the tokens swapped in and out of the pool.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	// fees_collected is the sum of the swap fees paid on the tokens swapped in.
+	FeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_collected" yaml:"fees_collected"`
+}
+
+func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
+func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolVolume) ProtoMessage()    {}
+func (*PoolVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a9888536d3bd097, []int{0}
+}
+func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolume.Merge(m, src)
+}
+func (m *PoolVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolume proto.InternalMessageInfo
+
+func (m *PoolVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolVolume) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PoolVolume)(nil), "osmosis.gamm.v1beta1.PoolVolume")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/volume.proto", fileDescriptor_3a9888536d3bd097) }
+
+var fileDescriptor_3a9888536d3bd097 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbf, 0x4e, 0x32, 0x41,
+	0x14, 0xc5, 0x77, 0xe0, 0x0b, 0x5f, 0x32, 0x06, 0x8a, 0x0d, 0x26, 0x48, 0x31, 0x8b, 0x5b, 0x91,
+	0x18, 0x66, 0x82, 0x16, 0x26, 0x76, 0x42, 0x45, 0x67, 0x28, 0x2c, 0x6c, 0xc8, 0xfe, 0x19, 0xd7,
+	0x8d, 0x33, 0x5c, 0xe2, 0x0c, 0x44, 0x9e, 0xc1, 0xc6, 0xe7, 0xf0, 0x1d, 0xec, 0x29, 0x29, 0xad,
+	0xd0, 0xc0, 0x1b, 0xf0, 0x04, 0x66, 0x67, 0x66, 0x8d, 0x56, 0xc6, 0x6a, 0xee, 0xe4, 0x9e, 0x73,
+	0x7e, 0x27, 0xb9, 0xf8, 0x18, 0x94, 0x04, 0x95, 0x2b, 0x96, 0x45, 0x52, 0xb2, 0x45, 0x3f, 0xe6,
+	0x3a, 0xea, 0xb3, 0x05, 0x88, 0xb9, 0xe4, 0x74, 0xf6, 0x00, 0x1a, 0xfc, 0xa6, 0x93, 0xd0, 0x42,
+	0x42, 0x9d, 0xa4, 0xdd, 0xcc, 0x20, 0x03, 0x23, 0x60, 0xc5, 0x64, 0xb5, 0x6d, 0x92, 0x18, 0x31,
+	0x8b, 0x23, 0xc5, 0xbf, 0xd2, 0x12, 0xc8, 0xa7, 0x76, 0x1f, 0xbe, 0x56, 0x30, 0xbe, 0x02, 0x10,
+	0xd7, 0x06, 0xe0, 0x9f, 0xe0, 0xff, 0x33, 0x00, 0x31, 0xc9, 0xd3, 0x16, 0xea, 0xa0, 0xee, 0xbf,
+	0x81, 0xbf, 0xdf, 0x04, 0x8d, 0x65, 0x24, 0xc5, 0x45, 0xe8, 0x16, 0xe1, 0xb8, 0x56, 0x4c, 0xa3,
+	0xd4, 0xd7, 0xb8, 0x66, 0x7b, 0xb5, 0x2a, 0x9d, 0x6a, 0xf7, 0xe0, 0xf4, 0x88, 0x5a, 0x18, 0x2d,
+	0x60, 0x65, 0x2f, 0x3a, 0x84, 0x7c, 0x3a, 0xb8, 0x5c, 0x6d, 0x02, 0x6f, 0xbf, 0x09, 0xea, 0x36,
+	0xca, 0xda, 0xc2, 0x97, 0xf7, 0xa0, 0x9b, 0xe5, 0xfa, 0x6e, 0x1e, 0xd3, 0x04, 0x24, 0x73, 0x55,
+	0xed, 0xd3, 0x53, 0xe9, 0x3d, 0xd3, 0xcb, 0x19, 0x57, 0x26, 0x41, 0x8d, 0x1d, 0xcb, 0x7f, 0x42,
+	0xb8, 0x71, 0xcb, 0xb9, 0x9a, 0x24, 0x20, 0x04, 0x4f, 0x34, 0x4f, 0x5b, 0xd5, 0xdf, 0xf0, 0x23,
+	0x87, 0x3f, 0xb4, 0xf8, 0x9f, 0xf6, 0xbf, 0xd5, 0xa8, 0x17, 0xe6, 0x61, 0xe9, 0x1d, 0x8c, 0x56,
+	0x5b, 0x82, 0xd6, 0x5b, 0x82, 0x3e, 0xb6, 0x04, 0x3d, 0xef, 0x88, 0xb7, 0xde, 0x11, 0xef, 0x6d,
+	0x47, 0xbc, 0x1b, 0xf6, 0x2d, 0xd2, 0x1d, 0xac, 0x27, 0xa2, 0x58, 0x95, 0x1f, 0xb6, 0x38, 0x67,
+	0x8f, 0xf6, 0xca, 0x26, 0x3f, 0xae, 0x99, 0x8b, 0x9c, 0x7d, 0x0e, 0x00, 0xa5, 0x7b, 0xde, 0x2e,
+	0x02, 0x02, 0x00, 0x00,
+}
+
+func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintVolume(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVolume(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVolume(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovVolume(uint64(m.PoolId))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovVolume(uint64(l))
+		}
+	}
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovVolume(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVolume(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVolume(x uint64) (n int) {
+	return sovVolume(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCollected = append(m.FeesCollected, types.Coin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVolume(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVolume
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVolume
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVolume
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVolume
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVolume        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVolume          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVolume = fmt.Errorf("proto: unexpected end of group")
+)
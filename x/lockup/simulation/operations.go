@@ -64,17 +64,12 @@ func WeightedOperations(
 	}
 }
 
+// genLockTokens returns a random amount of one of the coins, as a lock can
+// only hold a single denom.
 func genLockTokens(r *rand.Rand, acct simtypes.Account, coins sdk.Coins) (res sdk.Coins) {
-	numCoins := 1 + r.Intn(Min(coins.Len(), 6))
-	denomIndices := r.Perm(numCoins)
-	for i := 0; i < numCoins; i++ {
-		denom := coins[denomIndices[i]].Denom
-		amt, _ := simtypes.RandPositiveInt(r, coins[i].Amount)
-		res = append(res, sdk.Coin{Denom: denom, Amount: amt})
-	}
-
-	res.Sort()
-	return
+	coin := coins[r.Intn(coins.Len())]
+	amt, _ := simtypes.RandPositiveInt(r, coin.Amount)
+	return sdk.Coins{sdk.Coin{Denom: coin.Denom, Amount: amt}}
 }
 
 func Min(x, y int) int {
@@ -16,7 +16,12 @@ var _ gammtypes.GammHooks = Hooks{}
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
 // AfterPoolCreated creates a gauge for each pool’s lockable duration.
+// Pools that issue no LP shares, like concentrated liquidity pools, have no
+// share denom for gauges to pay out to, so none are created for them.
 func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if !h.k.bankKeeper.HasSupply(ctx, gammtypes.GetPoolShareDenom(poolId)) {
+		return
+	}
 	err := h.k.CreatePoolGauges(ctx, poolId)
 	if err != nil {
 		panic(err)
@@ -35,6 +40,21 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
 
+// BeforeJoinPool hook is a noop.
+func (h Hooks) BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error {
+	return nil
+}
+
+// BeforeExitPool hook is a noop.
+func (h Hooks) BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error {
+	return nil
+}
+
+// BeforeSwap hook is a noop.
+func (h Hooks) BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error {
+	return nil
+}
+
 // Distribute coins after minter module allocate assets to pool-incentives module.
 func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 	// @Sunny, @Tony, @Dev, what comments should we keep after modifying own BeginBlocker to hooks?
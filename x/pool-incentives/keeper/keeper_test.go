@@ -71,3 +71,18 @@ func (suite *KeeperTestSuite) TestCreateBalancerPoolGauges() {
 		suite.Equal(lockableDurations[2], gauge.DistributeTo.Duration)
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateConcentratedPoolGauges() {
+	suite.SetupTest()
+
+	keeper := suite.App.PoolIncentivesKeeper
+
+	// Concentrated liquidity pools have no share denom for gauges to pay out to,
+	// so none are created for them, and creating them does not fail.
+	poolId := suite.PrepareConcentratedPool()
+	for _, lockableDuration := range keeper.GetLockableDurations(suite.Ctx) {
+		_, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDuration)
+		suite.Error(err)
+	}
+	suite.Empty(suite.App.IncentivesKeeper.GetGauges(suite.Ctx))
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdParams(),
+		GetCmdArithmeticTwap(),
+	)
+
+	return cmd
+}
+
+// GetCmdParams returns the params for the module.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params [flags]",
+		Short: "Get the params for the x/twap module",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdArithmeticTwap returns the arithmetic twap of a pool's asset pair.
+func GetCmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arithmetic [pool-id] [base-denom] [quote-denom] [start-time] [end-time]",
+		Short: "Query the arithmetic twap of the quote denom in terms of the base denom",
+		Long: `Query the arithmetic time weighted average price of the quote denom in terms of the base denom.
+Times are given in RFC3339 format, or as unix timestamps. The end time defaults to the latest block time.
+
+Example:
+$ osmosisd query twap arithmetic 1 uosmo uatom 2022-06-01T00:00:00Z 2022-06-02T00:00:00Z
+`,
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+			}
+			if len(args) == 5 {
+				endTime, err := parseTime(args[4])
+				if err != nil {
+					return err
+				}
+				req.EndTime = &endTime
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	unix, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse time %s, expected RFC3339 or unix timestamp", s)
+	}
+	return time.Unix(unix, 0).UTC(), nil
+}
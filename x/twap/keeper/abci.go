@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock updates the records of the pools changed within the block, and
+// prunes twap records older than the record history keep period.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	for _, poolId := range k.popChangedPools(ctx) {
+		if err := k.updateRecords(ctx, poolId); err != nil {
+			k.Logger(ctx).Error("failed to update twap records", "pool_id", poolId, "error", err.Error())
+		}
+	}
+	k.pruneRecords(ctx)
+}
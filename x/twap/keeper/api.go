@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// ArithmeticTwap returns the arithmetic time weighted average price of the quote asset
+// in terms of the base asset, in the given pool, over the time range [startTime, endTime).
+// This mirrors the semantics of gamm's CalculateSpotPrice.
+//
+// startTime must be before endTime, and endTime can not be after the current block time.
+// An error is returned if startTime is before the oldest record kept in state
+// for the pair, see Params.RecordHistoryKeepPeriod.
+func (k Keeper) ArithmeticTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange,
+			"start time (%s) must be before end time (%s)", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange,
+			"end time (%s) can not be after the current block time (%s)", endTime, ctx.BlockTime())
+	}
+	// time deltas are measured in milliseconds.
+	if endTime.Sub(startTime) < time.Millisecond {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidTimeRange, "time range must be at least one millisecond")
+	}
+
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, asset0Denom, asset1Denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, asset0Denom, asset1Denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return computeArithmeticTwap(startRecord, endRecord, baseAssetDenom), nil
+}
+
+// ArithmeticTwapToNow returns the arithmetic twap of the quote asset in terms of the base asset,
+// from startTime until the current block time.
+func (k Keeper) ArithmeticTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.ArithmeticTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime())
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	return k.getMostRecentRecordStoreRepresentation(ctx, poolId, asset0Denom, asset1Denom)
+}
+
+func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllHistoricalTimeIndexedTWAPs(ctx)
+}
+
+func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
+	return recordWithUpdatedAccumulators(record, t)
+}
+
+func ComputeArithmeticTwap(startRecord, endRecord types.TwapRecord, baseAsset string) sdk.Dec {
+	return computeArithmeticTwap(startRecord, endRecord, baseAsset)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// InitGenesis initializes the twap module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+
+	for _, record := range genState.Twaps {
+		k.storeHistoricalTWAP(ctx, record)
+		// the latest record of each pair becomes its most recent record.
+		mostRecent, err := k.getMostRecentRecordStoreRepresentation(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+		if err == nil && !record.Time.After(mostRecent.Time) {
+			continue
+		}
+		k.storeNewRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns the twap module's exported genesis.
+// It contains every historical record, along with the most recent record of
+// each pair whenever that one has already been pruned from the historical indexes.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	twaps, err := k.getAllHistoricalTimeIndexedTWAPs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	mostRecentRecords, err := k.getAllMostRecentRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range mostRecentRecords {
+		if !store.Has(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time)) {
+			twaps = append(twaps, record)
+		}
+	}
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Twaps:  twaps,
+	}
+}
+
+// MigrateExistingPools creates twap records for every pool that does not have records yet.
+// It is meant to be run in the upgrade that introduces the twap module.
+func (k Keeper) MigrateExistingPools(ctx sdk.Context) error {
+	pools, err := k.ammkeeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		records, err := k.getAllMostRecentRecordsForPool(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		if len(records) != 0 {
+			continue
+		}
+		if err := k.afterCreatePool(ctx, pool.GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
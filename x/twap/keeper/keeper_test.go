@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+}
+
+func (suite *KeeperTestSuite) TestRecordsCreatedWithPool() {
+	poolId := suite.PrepareBalancerPool()
+
+	// the records of the pool are created at the end of the block.
+	_, err := suite.App.TwapKeeper.GetMostRecentRecordStoreRepresentation(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().Error(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	// foo, bar and baz make for three unique pairs.
+	for _, pair := range [][2]string{{"bar", "baz"}, {"bar", "foo"}, {"baz", "foo"}} {
+		record, err := suite.App.TwapKeeper.GetMostRecentRecordStoreRepresentation(suite.Ctx, poolId, pair[0], pair[1])
+		suite.Require().NoError(err)
+
+		sp0, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, pair[0], pair[1])
+		suite.Require().NoError(err)
+		sp1, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, pair[1], pair[0])
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(suite.Ctx.BlockTime(), record.Time)
+		suite.Require().Equal(sp0, record.P0LastSpotPrice)
+		suite.Require().Equal(sp1, record.P1LastSpotPrice)
+		suite.Require().True(record.P0ArithmeticTwapAccumulator.IsZero())
+		suite.Require().True(record.P1ArithmeticTwapAccumulator.IsZero())
+	}
+
+	records, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 3)
+}
+
+func (suite *KeeperTestSuite) TestArithmeticTwap() {
+	poolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	startTime := suite.Ctx.BlockTime()
+
+	spBefore, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+
+	// swap 10 seconds after pool creation, moving the price.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(10 * time.Second)).WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000)))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 1_000_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// the records of the pool are only updated at the end of the block.
+	record, err := suite.App.TwapKeeper.GetMostRecentRecordStoreRepresentation(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(startTime, record.Time)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	record, err = suite.App.TwapKeeper.GetMostRecentRecordStoreRepresentation(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.Time)
+
+	spAfter, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().False(spBefore.Equal(spAfter))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(20 * time.Second)).WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+
+	tests := map[string]struct {
+		start       time.Time
+		end         time.Time
+		expected    sdk.Dec
+		expectedErr bool
+	}{
+		"before the swap": {
+			start:    startTime,
+			end:      startTime.Add(10 * time.Second),
+			expected: spBefore,
+		},
+		"within the range before the swap": {
+			start:    startTime.Add(2 * time.Second),
+			end:      startTime.Add(5 * time.Second),
+			expected: spBefore,
+		},
+		"after the swap": {
+			start:    startTime.Add(10 * time.Second),
+			end:      startTime.Add(20 * time.Second),
+			expected: spAfter,
+		},
+		"across the swap": {
+			start:    startTime,
+			end:      startTime.Add(20 * time.Second),
+			expected: spBefore.Add(spAfter).QuoInt64(2),
+		},
+		"start before pool creation": {
+			start:       startTime.Add(-time.Second),
+			end:         startTime.Add(10 * time.Second),
+			expectedErr: true,
+		},
+		"end in the future": {
+			start:       startTime,
+			end:         startTime.Add(time.Hour),
+			expectedErr: true,
+		},
+		"start after end": {
+			start:       startTime.Add(10 * time.Second),
+			end:         startTime,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			twap, err := suite.App.TwapKeeper.ArithmeticTwap(suite.Ctx, poolId, "bar", "foo", tc.start, tc.end)
+			if tc.expectedErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, twap)
+		})
+	}
+
+	// the query defaults the end time to the current block time. It is called
+	// on the querier directly, as the query helper context is still at startTime.
+	querier := keeper.NewQuerier(*suite.App.TwapKeeper)
+	res, err := querier.ArithmeticTwap(sdk.WrapSDKContext(suite.Ctx), &types.QueryArithmeticTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  "bar",
+		QuoteAsset: "foo",
+		StartTime:  startTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(spBefore.Add(spAfter).QuoInt64(2), res.ArithmeticTwap)
+}
+
+func (suite *KeeperTestSuite) TestPruneRecords() {
+	poolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	startTime := suite.Ctx.BlockTime()
+	keepPeriod := suite.App.TwapKeeper.GetParams(suite.Ctx).RecordHistoryKeepPeriod
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Second))
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	records, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+
+	// only the record written at pool creation is past the keep period, and
+	// it is kept as the last record of the pair before the keep period.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(keepPeriod).Add(time.Millisecond))
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	records, err = suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+
+	// once both records are past the keep period, only the last one is kept.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(2 * keepPeriod))
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	records, err = suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(startTime.Add(time.Second), records[0].Time)
+
+	spot, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	twap, err := suite.App.TwapKeeper.ArithmeticTwapToNow(suite.Ctx, poolId, "bar", "foo", suite.Ctx.BlockTime().Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(spot, twap)
+
+	// genesis export still contains the most recent record.
+	genesis := suite.App.TwapKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.Twaps, 1)
+}
+
+func (suite *KeeperTestSuite) TestArithmeticTwapOfQuietPairAfterPruning() {
+	poolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	startTime := suite.Ctx.BlockTime()
+	keepPeriod := suite.App.TwapKeeper.GetParams(suite.Ctx).RecordHistoryKeepPeriod
+
+	spBefore, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+
+	// the pair is next updated well after the keep period of its first record,
+	// which is the last record before the keep period when pruning.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(keepPeriod).Add(time.Hour))
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	// the start time is within the keep period, before the update.
+	start := suite.Ctx.BlockTime().Add(-keepPeriod / 2)
+	twap, err := suite.App.TwapKeeper.ArithmeticTwap(suite.Ctx, poolId, "bar", "foo", start, suite.Ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.Require().Equal(spBefore, twap)
+}
+
+func TestComputeArithmeticTwap(t *testing.T) {
+	baseTime := time.Unix(1257894000, 0).UTC()
+	start := types.TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 "bar",
+		Asset1Denom:                 "foo",
+		Time:                        baseTime,
+		P0LastSpotPrice:             sdk.NewDec(10),
+		P1LastSpotPrice:             sdk.NewDecWithPrec(1, 1),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+	}
+	end := keeper.RecordWithUpdatedAccumulators(start, baseTime.Add(time.Minute))
+
+	if !end.P0ArithmeticTwapAccumulator.Equal(sdk.NewDec(10 * 60_000)) {
+		t.Fatalf("unexpected p0 accumulator %s", end.P0ArithmeticTwapAccumulator)
+	}
+	if twap := keeper.ComputeArithmeticTwap(start, end, "bar"); !twap.Equal(sdk.NewDec(10)) {
+		t.Fatalf("unexpected twap with base asset0: %s", twap)
+	}
+	if twap := keeper.ComputeArithmeticTwap(start, end, "foo"); !twap.Equal(sdk.NewDecWithPrec(1, 1)) {
+		t.Fatalf("unexpected twap with base asset1: %s", twap)
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var _ gammtypes.GammHooks = &gammhook{}
+
+type gammhook struct {
+	k Keeper
+}
+
+// GammHooks returns the gamm hooks that keep the twap records up to date.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool.
+// The records of the pool are created in EndBlock, like they are updated.
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut.
+// The records of the pool are updated in EndBlock, so that swaps only pay
+// for tracking the pool, and pools changed several times within a block are
+// only updated once.
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterJoinPool is called after JoinPool, JoinSwapExternAmountIn, and JoinSwapShareAmountOut
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterExitPool is called after ExitPool, ExitSwapShareAmountIn, and ExitSwapExternAmountOut
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+// BeforeJoinPool is a noop.
+func (hook *gammhook) BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error {
+	return nil
+}
+
+// BeforeExitPool is a noop.
+func (hook *gammhook) BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error {
+	return nil
+}
+
+// BeforeSwap is a noop.
+func (hook *gammhook) BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error {
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// afterCreatePool creates a record for every asset pair of a newly created pool,
+// or of a pool created before the twap module existed.
+func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.ammkeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	denoms := make([]string, 0, len(liquidity))
+	for _, coin := range liquidity {
+		denoms = append(denoms, coin.Denom)
+	}
+	denomPairs0, denomPairs1 := types.GetAllUniqueDenomPairs(denoms)
+	for i := 0; i < len(denomPairs0); i++ {
+		sp0, sp1 := getSpotPrices(ctx, pool, denomPairs0[i], denomPairs1[i], sdk.ZeroDec(), sdk.ZeroDec())
+		record := types.NewTwapRecord(ctx, poolId, denomPairs0[i], denomPairs1[i], sp0, sp1)
+		k.storeNewRecord(ctx, record)
+	}
+	return nil
+}
+
+// updateRecords updates every record of the given pool to the current block time,
+// and sets their last spot prices to the pool's current spot prices.
+func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
+	records, err := k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// Pools created within the block, or before the twap module existed, have
+	// no records yet.
+	if len(records) == 0 {
+		return k.afterCreatePool(ctx, poolId)
+	}
+
+	pool, err := k.ammkeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		newRecord := updateRecord(ctx, pool, record)
+		k.storeNewRecord(ctx, newRecord)
+	}
+	return nil
+}
+
+// updateRecord returns a record with the accumulators updated to the current block time,
+// and the last spot prices set to the pool's current spot prices.
+// If a spot price can not be computed, e.g. due to a drained pool, the last spot price is kept.
+func updateRecord(ctx sdk.Context, pool gammtypes.PoolI, record types.TwapRecord) types.TwapRecord {
+	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
+	newRecord.Height = ctx.BlockHeight()
+	newRecord.P0LastSpotPrice, newRecord.P1LastSpotPrice = getSpotPrices(
+		ctx, pool, record.Asset0Denom, record.Asset1Denom,
+		record.P0LastSpotPrice, record.P1LastSpotPrice)
+	return newRecord
+}
+
+// getSpotPrices returns the spot price of asset1 in terms of asset0, and of asset0 in terms of asset1,
+// as gamm's CalculateSpotPrice does.
+// Falls back to the provided prices if the pool can not provide a spot price.
+func getSpotPrices(ctx sdk.Context, pool gammtypes.PoolI, denom0, denom1 string, fallbackSp0, fallbackSp1 sdk.Dec) (sp0, sp1 sdk.Dec) {
+	sp0, err := pool.SpotPrice(ctx, denom0, denom1)
+	if err != nil {
+		sp0 = fallbackSp0
+	}
+	sp1, err = pool.SpotPrice(ctx, denom1, denom0)
+	if err != nil {
+		sp1 = fallbackSp1
+	}
+	return sp0, sp1
+}
+
+// pruneRecords deletes the historical records older than the record history keep period,
+// except for the last one of each pair.
+func (k Keeper) pruneRecords(ctx sdk.Context) {
+	keepPeriod := k.GetParams(ctx).RecordHistoryKeepPeriod
+	lastKeptTime := ctx.BlockTime().Add(-keepPeriod)
+	k.pruneRecordsBeforeTime(ctx, lastKeptTime)
+}
+
+// recordWithUpdatedAccumulators returns a record with the accumulators increased by
+// last spot price * milliseconds elapsed between the record's time and newTime.
+// The input record is not mutated.
+func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) types.TwapRecord {
+	newRecord := record
+	timeDelta := newTime.Sub(record.Time)
+	newRecord.Time = newTime
+
+	// record.LastSpotPrice is the last spot price from the block the record was created in,
+	// thus it is treated as the effective spot price until the new time.
+	timeDeltaMs := sdk.NewInt(timeDelta.Milliseconds())
+	newRecord.P0ArithmeticTwapAccumulator = record.P0ArithmeticTwapAccumulator.Add(record.P0LastSpotPrice.MulInt(timeDeltaMs))
+	newRecord.P1ArithmeticTwapAccumulator = record.P1ArithmeticTwapAccumulator.Add(record.P1LastSpotPrice.MulInt(timeDeltaMs))
+	return newRecord
+}
+
+// getInterpolatedRecord returns a record for the pair at exactly the given time,
+// interpolating the accumulators from the last record at or before that time.
+func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	if record.Time.Equal(t) {
+		return record, nil
+	}
+	return recordWithUpdatedAccumulators(record, t), nil
+}
+
+// computeArithmeticTwap returns the arithmetic twap of the quote asset in terms of the base asset
+// between the two records.
+func computeArithmeticTwap(startRecord, endRecord types.TwapRecord, baseAsset string) sdk.Dec {
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	var accumDiff sdk.Dec
+	if baseAsset == startRecord.Asset0Denom {
+		accumDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	} else {
+		accumDiff = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	}
+	return accumDiff.QuoInt64(timeDelta.Milliseconds())
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// trackChangedPool marks the pool as changed within the current block, for
+// its records to be updated in EndBlock. The transient store is reset at the
+// end of every block.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(sdk.Uint64ToBigEndian(poolId), []byte{})
+}
+
+// popChangedPools returns the ids of the pools changed within the current
+// block, in increasing order, and stops tracking them.
+func (k Keeper) popChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	iter := store.Iterator(nil, nil)
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()))
+	}
+	iter.Close()
+
+	for _, poolId := range poolIds {
+		store.Delete(sdk.Uint64ToBigEndian(poolId))
+	}
+	return poolIds
+}
+
+// storeNewRecord sets the given record as the most recent record of its pair,
+// and stores it in the historical indexes.
+func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := mustMarshalRecord(twap)
+	store.Set(types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom), bz)
+	k.storeHistoricalTWAP(ctx, twap)
+}
+
+// storeHistoricalTWAP writes the record to both the time index and the pool index.
+func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := mustMarshalRecord(twap)
+	store.Set(types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom), bz)
+	store.Set(types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time), bz)
+}
+
+func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom))
+	store.Delete(types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time))
+}
+
+// pruneRecordsBeforeTime deletes the historical records written strictly before lastKeptTime,
+// except for the last one of each pair. That record is kept, so that twaps starting at or after
+// lastKeptTime can still be interpolated for pairs that have not been updated since.
+func (k Keeper) pruneRecordsBeforeTime(ctx sdk.Context, lastKeptTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	// the records are iterated from the newest, so the first record of each pair is kept.
+	iter := store.ReverseIterator(types.HistoricalTWAPTimeIndexPrefix(), types.FormatHistoricalTWAPTimeIndexPrefixUntil(lastKeptTime))
+	defer iter.Close()
+
+	type pair struct {
+		poolId                   uint64
+		asset0Denom, asset1Denom string
+	}
+	seenPairs := map[pair]bool{}
+	toDelete := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		twap := mustUnmarshalRecord(iter.Value())
+		recordPair := pair{twap.PoolId, twap.Asset0Denom, twap.Asset1Denom}
+		if !seenPairs[recordPair] {
+			seenPairs[recordPair] = true
+			continue
+		}
+		toDelete = append(toDelete, twap)
+	}
+
+	for _, twap := range toDelete {
+		k.deleteHistoricalRecord(ctx, twap)
+	}
+}
+
+// getMostRecentRecordStoreRepresentation returns the most recent record of the pair, exactly as stored.
+// The denoms are expected to be sorted.
+func (k Keeper) getMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FormatMostRecentTWAPKey(poolId, asset0Denom, asset1Denom))
+	if bz == nil {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"pool %d, denoms (%s, %s)", poolId, asset0Denom, asset1Denom)
+	}
+	return unmarshalRecord(bz)
+}
+
+// getAllMostRecentRecords returns the most recent record of every pair in every pool.
+func (k Keeper) getAllMostRecentRecords(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getRecordsWithPrefix(ctx, types.MostRecentTWAPsPrefix())
+}
+
+// getAllMostRecentRecordsForPool returns the most recent record of every pair in the pool.
+func (k Keeper) getAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	return k.getRecordsWithPrefix(ctx, types.FormatMostRecentTWAPPoolPrefix(poolId))
+}
+
+// getAllHistoricalTimeIndexedTWAPs returns every historical record, ordered by time.
+func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getRecordsWithPrefix(ctx, types.HistoricalTWAPTimeIndexPrefix())
+}
+
+func (k Keeper) getRecordsWithPrefix(ctx sdk.Context, prefix []byte) ([]types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		twap, err := unmarshalRecord(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, twap)
+	}
+	return records, nil
+}
+
+// getRecordAtOrBeforeTime returns the last record of the pair written at or before the given time.
+// The denoms are expected to be sorted.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	// The most recent record is not guaranteed to be in the historical index,
+	// so it is checked first.
+	mostRecent, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	if !mostRecent.Time.After(t) {
+		return mostRecent, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := types.FormatHistoricalPoolIndexTWAPKeyPrefix(poolId, asset0Denom, asset1Denom)
+	// the end key is exclusive, so we look one nanosecond past the requested time.
+	end := types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, t.Add(time.Nanosecond))
+	iter := store.ReverseIterator(start, end)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrTooOldTime,
+			"pool %d, denoms (%s, %s), time %s", poolId, asset0Denom, asset1Denom, t)
+	}
+	return unmarshalRecord(iter.Value())
+}
+
+func mustMarshalRecord(twap types.TwapRecord) []byte {
+	bz, err := proto.Marshal(&twap)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func unmarshalRecord(bz []byte) (types.TwapRecord, error) {
+	twap := types.TwapRecord{}
+	err := proto.Unmarshal(bz, &twap)
+	return twap, err
+}
+
+func mustUnmarshalRecord(bz []byte) types.TwapRecord {
+	twap, err := unmarshalRecord(bz)
+	if err != nil {
+		panic(err)
+	}
+	return twap
+}
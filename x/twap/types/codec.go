@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterLegacyAminoCodec is a no-op, the twap module has no messages.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces is a no-op, the twap module has no messages.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
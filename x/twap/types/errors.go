@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/twap module errors.
+var (
+	ErrInvalidTwapRecord = sdkerrors.Register(ModuleName, 2, "invalid twap record")
+	ErrRecordNotFound    = sdkerrors.Register(ModuleName, 3, "twap record not found")
+	ErrTooOldTime        = sdkerrors.Register(ModuleName, 4, "requested time is before the oldest available twap record")
+	ErrInvalidTimeRange  = sdkerrors.Register(ModuleName, 5, "invalid twap time range")
+	ErrInvalidDenomPair  = sdkerrors.Register(ModuleName, 6, "invalid denom pair")
+	ErrInvalidGenesis    = sdkerrors.Register(ModuleName, 7, "invalid genesis")
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// AmmInterface defines the contract needed from the x/gamm keeper to maintain twap records.
+type AmmInterface interface {
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	GetPoolsAndPoke(ctx sdk.Context) ([]gammtypes.PoolI, error)
+}
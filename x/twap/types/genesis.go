@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default twap genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Twaps:  []TwapRecord{},
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. It does not verify that the records' pools exist.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, record := range gs.Twaps {
+		if err := record.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	return nil
+}
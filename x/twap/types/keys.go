@@ -0,0 +1,94 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "twap"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key, which tracks the
+	// pools changed within a block.
+	TransientStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the twap module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// KeySeparator is used to combine parts of the keys in the store.
+	KeySeparator = "|"
+)
+
+var (
+	mostRecentTWAPsPrefix         = "recent_twap" + KeySeparator
+	historicalTWAPTimeIndexPrefix = "historical_time_index" + KeySeparator
+	historicalTWAPPoolIndexPrefix = "historical_pool_index" + KeySeparator
+)
+
+// FormatMostRecentTWAPKey returns the key under which the latest twap record
+// of the given pool and (sorted) asset pair is stored.
+func FormatMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s", mostRecentTWAPsPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+// FormatHistoricalTimeIndexTWAPKey returns the key of a historical record in the time index.
+// The time index is used to efficiently prune records older than the keep period.
+func FormatHistoricalTimeIndexTWAPKey(accumulatorWriteTime time.Time, poolId uint64, denom1, denom2 string) []byte {
+	timeS := string(sdk.FormatTimeBytes(accumulatorWriteTime))
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s", historicalTWAPTimeIndexPrefix, timeS, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+// FormatHistoricalPoolIndexTWAPKey returns the key of a historical record in the pool index.
+// The pool index is used to find the record of a pair at, or right before, a given time.
+func FormatHistoricalPoolIndexTWAPKey(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := string(sdk.FormatTimeBytes(accumulatorWriteTime))
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s", historicalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+// FormatHistoricalPoolIndexTWAPKeyPrefix returns the prefix of all historical records
+// of the given pool and asset pair in the pool index.
+func FormatHistoricalPoolIndexTWAPKeyPrefix(poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s", historicalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+// FormatMostRecentTWAPPoolPrefix returns the prefix of the latest records of every asset pair in a pool.
+func FormatMostRecentTWAPPoolPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", mostRecentTWAPsPrefix, poolId, KeySeparator))
+}
+
+// MostRecentTWAPsPrefix returns the prefix of all latest records.
+func MostRecentTWAPsPrefix() []byte {
+	return []byte(mostRecentTWAPsPrefix)
+}
+
+// HistoricalTWAPTimeIndexPrefix returns the prefix of the time index.
+func HistoricalTWAPTimeIndexPrefix() []byte {
+	return []byte(historicalTWAPTimeIndexPrefix)
+}
+
+// FormatHistoricalTWAPTimeIndexPrefixUntil returns the exclusive end key for iterating
+// over every time index entry written strictly before the given time.
+func FormatHistoricalTWAPTimeIndexPrefixUntil(t time.Time) []byte {
+	return []byte(historicalTWAPTimeIndexPrefix + string(sdk.FormatTimeBytes(t)))
+}
+
+// ParseTimeFromHistoricalTimeIndexKey parses the write time out of a time index key.
+func ParseTimeFromHistoricalTimeIndexKey(key []byte) (time.Time, error) {
+	keyS := string(key)
+	s := strings.Split(keyS, KeySeparator)
+	if len(s) != 5 || s[0]+KeySeparator != historicalTWAPTimeIndexPrefix {
+		return time.Time{}, errors.New("invalid historical time index key")
+	}
+	return sdk.ParseTimeBytes([]byte(s[1]))
+}
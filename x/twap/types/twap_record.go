@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTwapRecord returns a fresh record for the given pool and asset pair,
+// with zero valued accumulators. The denoms are expected to be sorted.
+func NewTwapRecord(ctx sdk.Context, poolId uint64, denom0, denom1 string, sp0, sp1 sdk.Dec) TwapRecord {
+	return TwapRecord{
+		PoolId:                      poolId,
+		Asset0Denom:                 denom0,
+		Asset1Denom:                 denom1,
+		Height:                      ctx.BlockHeight(),
+		Time:                        ctx.BlockTime(),
+		P0LastSpotPrice:             sp0,
+		P1LastSpotPrice:             sp1,
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+	}
+}
+
+// Validate performs stateless validation of a twap record.
+func (record TwapRecord) Validate() error {
+	if record.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidTwapRecord, "pool id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(record.Asset0Denom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(record.Asset1Denom); err != nil {
+		return err
+	}
+	if record.Asset0Denom >= record.Asset1Denom {
+		return sdkerrors.Wrapf(ErrInvalidDenomPair, "denoms must be sorted and distinct, got (%s, %s)", record.Asset0Denom, record.Asset1Denom)
+	}
+	for _, d := range []sdk.Dec{
+		record.P0LastSpotPrice, record.P1LastSpotPrice,
+		record.P0ArithmeticTwapAccumulator, record.P1ArithmeticTwapAccumulator,
+	} {
+		if d.IsNil() || d.IsNegative() {
+			return sdkerrors.Wrap(ErrInvalidTwapRecord, fmt.Sprintf("spot prices and accumulators must be non-negative, got %s", d))
+		}
+	}
+	return nil
+}
+
+// GetAllUniqueDenomPairs returns all unique lexicographically sorted pairs of the given denoms.
+// The denoms are expected to be sorted, as is the case for the denoms of sdk.Coins.
+func GetAllUniqueDenomPairs(denoms []string) (pairGT, pairLT []string) {
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			pairGT = append(pairGT, denoms[i])
+			pairLT = append(pairLT, denoms[j])
+		}
+	}
+	return pairGT, pairLT
+}
+
+// LexicographicalOrderDenoms returns the two denoms sorted, or an error if they are equal.
+func LexicographicalOrderDenoms(denom0, denom1 string) (string, string, error) {
+	if denom0 == denom1 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenomPair, "both assets cannot be of the same denom: %s", denom0)
+	}
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return denom0, denom1, nil
+}
@@ -0,0 +1,8 @@
+package cli
+
+const (
+	// Will be parsed to []uint64.
+	FlagSwapRoutePoolIds = "swap-route-pool-ids"
+	// Will be parsed to []string.
+	FlagSwapRouteDenoms = "swap-route-denoms"
+)
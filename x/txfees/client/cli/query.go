@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdCurrentBaseFee(),
 	)
 
 	return cmd
@@ -142,3 +143,39 @@ $ %s query txfees base-denom
 
 	return cmd
 }
+
+// GetCmdCurrentBaseFee returns the base fee every tx has to pay.
+func GetCmdCurrentBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-base-fee",
+		Short: "Query the current base fee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current base fee, the gas price in the base fee denom that every tx has to pay.
+
+Example:
+$ %s query txfees current-base-fee
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentBaseFee(cmd.Context(), &types.QueryCurrentBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,15 +1,20 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -37,6 +42,18 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 		Use:   "update-fee-token [denom] [poolId]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Submit an update to a fee token to be usable for tx fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update to a fee token to be usable for tx fees.
+A fee token without a pool with the base denom is routed to it through several pools,
+given with the swap route flags, in which case poolId must be 0.
+
+Example:
+$ %s tx txfees update-fee-token ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 1 --title="..." --description="..."
+$ %s tx txfees update-fee-token ibc/46B44899322F3CD854D2D46DEEF881958467CDD4B3B10086DA49296BBED94BED 0 --swap-route-pool-ids=4 --swap-route-denoms=ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --swap-route-pool-ids=1 --swap-route-denoms=uosmo --title="..." --description="..."
+`,
+				version.AppName, version.AppName,
+			),
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -49,9 +66,15 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 				return err
 			}
 
+			routes, err := swapAmountInRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			feeToken := types.FeeToken{
 				Denom:  denom,
 				PoolID: pool_id,
+				Routes: routes,
 			}
 
 			title, err := cmd.Flags().GetString(cli.FlagTitle)
@@ -93,9 +116,40 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringArray(FlagSwapRoutePoolIds, []string{}, "swap route pool ids, for fee tokens routed to the base denom")
+	cmd.Flags().StringArray(FlagSwapRouteDenoms, []string{}, "swap route denoms, for fee tokens routed to the base denom")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(cli.FlagTitle)
 	_ = cmd.MarkFlagRequired(cli.FlagDescription)
 
 	return cmd
 }
+
+func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
+	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	swapRouteDenoms, err := fs.GetStringArray(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(swapRoutePoolIds) != len(swapRouteDenoms) {
+		return nil, errors.New("swap route pool ids and denoms mismatch")
+	}
+
+	routes := []types.SwapAmountInRoute{}
+	for index, poolIDStr := range swapRoutePoolIds {
+		pID, err := strconv.ParseUint(poolIDStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, types.SwapAmountInRoute{
+			PoolId:        pID,
+			TokenOutDenom: swapRouteDenoms[index],
+		})
+	}
+	return routes, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+// GetCurrentBaseFee returns the base fee, the gas price in the base denom that
+// every tx of the current block has to pay.
+func (k Keeper) GetCurrentBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CurrentBaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetCurrentBaseFee sets the base fee.
+func (k Keeper) SetCurrentBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.CurrentBaseFeeKey, bz)
+}
+
+// UpdateBaseFee moves the base fee toward the price at which blocks use the
+// target block gas, given the gas used by the block that just ended, like in
+// EIP-1559. The base fee changes by the max base fee change times how far the
+// gas used is from the target, relative to it, capped to the max base fee
+// change. It is kept within the min and max base fee, and is zero while the
+// min base fee is zero.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	if params.MinBaseFee.IsZero() {
+		k.SetCurrentBaseFee(ctx, sdk.ZeroDec())
+		return
+	}
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	gasUsed := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed))
+	// the gas used relative to the target, in [-1, 1]
+	usage := gasUsed.Sub(target).Quo(target)
+	usage = sdk.MinDec(usage, sdk.OneDec())
+
+	baseFee := k.GetCurrentBaseFee(ctx)
+	baseFee = baseFee.Add(baseFee.Mul(params.MaxBaseFeeChange).Mul(usage))
+	baseFee = sdk.MaxDec(baseFee, params.MinBaseFee)
+	baseFee = sdk.MinDec(baseFee, params.MaxBaseFee)
+	k.SetCurrentBaseFee(ctx, baseFee)
+}
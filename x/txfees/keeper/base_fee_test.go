@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.MustNewDecFromStr("0.01")
+	params.MaxBaseFee = sdk.OneDec()
+	params.TargetBlockGas = 1000
+
+	tests := []struct {
+		name            string
+		params          types.Params
+		baseFee         sdk.Dec
+		blockGasUsed    uint64
+		expectedBaseFee sdk.Dec
+	}{
+		{
+			name:            "target gas used",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    1000,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:            "twice the target gas used",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    2000,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.1125"),
+		},
+		{
+			name:            "change is capped above twice the target gas used",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    10000,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.1125"),
+		},
+		{
+			name:            "half the target gas used",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    500,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.09375"),
+		},
+		{
+			name:            "no gas used",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.0875"),
+		},
+		{
+			name:            "does not fall below the min base fee",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.011"),
+			blockGasUsed:    0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:            "does not rise above the max base fee",
+			params:          params,
+			baseFee:         sdk.MustNewDecFromStr("0.95"),
+			blockGasUsed:    2000,
+			expectedBaseFee: sdk.OneDec(),
+		},
+		{
+			name:            "starts from the min base fee once enabled",
+			params:          params,
+			baseFee:         sdk.ZeroDec(),
+			blockGasUsed:    1000,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:            "disabled by a zero min base fee",
+			params:          types.DefaultParams(),
+			baseFee:         sdk.MustNewDecFromStr("0.1"),
+			blockGasUsed:    2000,
+			expectedBaseFee: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			keeper := suite.App.TxFeesKeeper
+			keeper.SetParams(suite.Ctx, tc.params)
+			keeper.SetCurrentBaseFee(suite.Ctx, tc.baseFee)
+
+			keeper.UpdateBaseFee(suite.Ctx, tc.blockGasUsed)
+
+			suite.Require().Equal(tc.expectedBaseFee.String(), keeper.GetCurrentBaseFee(suite.Ctx).String())
+
+			res, err := suite.queryClient.CurrentBaseFee(suite.Ctx.Context(), &types.QueryCurrentBaseFeeRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedBaseFee.String(), res.CurrentBaseFee.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithoutParams() {
+	suite.SetupTest(false)
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.MustNewDecFromStr("0.01")
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.App.TxFeesKeeper.SetCurrentBaseFee(suite.Ctx, params.MinBaseFee)
+
+	// the genesis state set by the v5 upgrade handler has no params, and
+	// leaves the base fee as it is
+	suite.Require().NotPanics(func() {
+		suite.App.TxFeesKeeper.InitGenesis(suite.Ctx, types.GenesisState{Basedenom: sdk.DefaultBondDenom})
+	})
+	suite.Require().Equal(params, suite.App.TxFeesKeeper.GetParams(suite.Ctx))
+	suite.Require().Equal(params.MinBaseFee, suite.App.TxFeesKeeper.GetCurrentBaseFee(suite.Ctx))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+// CalcFeeTokenPrice returns the price of a fee token in the base denom that
+// fees paid in it are valued at, which is either its spot price or its TWAP,
+// depending on the fee token valuation mode.
+func (k Keeper) CalcFeeTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	switch k.GetParams(ctx).FeeTokenValuationMode {
+	case types.Twap:
+		return k.CalcFeeTokenTwap(ctx, denom)
+	default:
+		return k.CalcFeeSpotPrice(ctx, denom)
+	}
+}
+
+// CalcFeeTokenTwap returns the time weighted average price of a fee token in
+// the base denom, over the fee token TWAP window ending at the current block
+// time. The price of the fee token in the current block has no weight, so it
+// can't be moved by txs of the block. If the price records of the fee token
+// don't go back to the start of the window, the TWAP is taken since the first
+// record.
+func (k Keeper) CalcFeeTokenTwap(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	records := k.getFeeTokenPriceRecords(ctx, denom)
+	if len(records) == 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoFeeTokenPriceRecords, "%s", denom)
+	}
+
+	windowStart := ctx.BlockTime().Add(-k.GetParams(ctx).FeeTokenTwapWindow)
+	startRecord := records[0]
+	for _, record := range records[1:] {
+		if record.Time.After(windowStart) {
+			break
+		}
+		startRecord = record
+	}
+	if startRecord.Time.Before(windowStart) {
+		startRecord = startRecord.RecordAt(windowStart)
+	}
+	endRecord := records[len(records)-1].RecordAt(ctx.BlockTime())
+
+	// a fee token whitelisted in the current block only has the price of the
+	// block
+	timeDeltaMs := endRecord.Time.Sub(startRecord.Time).Milliseconds()
+	if timeDeltaMs <= 0 {
+		return endRecord.LastPrice, nil
+	}
+	return endRecord.Accumulator.Sub(startRecord.Accumulator).QuoInt64(timeDeltaMs), nil
+}
+
+// RecordExistingFeeTokenPrices records the current price of every fee token,
+// so that fee tokens whitelisted before their prices were recorded have TWAPs.
+func (k Keeper) RecordExistingFeeTokenPrices(ctx sdk.Context) {
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		k.recordFeeTokenPrice(ctx, feeToken)
+	}
+}
+
+// recordPoolFeeTokenPrices records the current price of the fee tokens whose
+// price is derived through the given pool, after it changed.
+func (k Keeper) recordPoolFeeTokenPrices(ctx sdk.Context, poolId uint64) {
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		if feeToken.UsesPool(poolId) {
+			k.recordFeeTokenPrice(ctx, feeToken)
+		}
+	}
+}
+
+// recordFeeTokenPrice writes a price record of a fee token at the current
+// block time, with its current spot price. Records older than needed for the
+// fee token TWAP window are pruned.
+// If the spot price can't be computed, e.g. due to a drained pool, the last
+// recorded price is kept.
+func (k Keeper) recordFeeTokenPrice(ctx sdk.Context, feeToken types.FeeToken) {
+	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	record, found := k.getLastFeeTokenPriceRecord(ctx, feeToken.Denom)
+	if !found {
+		if err != nil {
+			k.Logger(ctx).Error("failed to record fee token price", "denom", feeToken.Denom, "error", err.Error())
+			return
+		}
+		record = types.FeeTokenPriceRecord{
+			Denom:       feeToken.Denom,
+			Time:        ctx.BlockTime(),
+			LastPrice:   spotPrice,
+			Accumulator: sdk.ZeroDec(),
+		}
+	}
+
+	record = record.RecordAt(ctx.BlockTime())
+	record.Height = ctx.BlockHeight()
+	if err == nil {
+		record.LastPrice = spotPrice
+	}
+	k.setFeeTokenPriceRecord(ctx, record)
+	k.pruneFeeTokenPriceRecords(ctx, feeToken.Denom)
+}
+
+// pruneFeeTokenPriceRecords deletes the price records of a fee token older
+// than the last one at or before the start of the fee token TWAP window,
+// which is kept to interpolate the accumulator at the start of the window.
+func (k Keeper) pruneFeeTokenPriceRecords(ctx sdk.Context, denom string) {
+	windowStart := ctx.BlockTime().Add(-k.GetParams(ctx).FeeTokenTwapWindow)
+	records := k.getFeeTokenPriceRecords(ctx, denom)
+	store := ctx.KVStore(k.storeKey)
+	for i := 0; i+1 < len(records) && !records[i+1].Time.After(windowStart); i++ {
+		store.Delete(types.GetFeeTokenPriceRecordKey(denom, records[i].Time))
+	}
+}
+
+// deleteFeeTokenPriceRecords deletes all the price records of a fee token.
+func (k Keeper) deleteFeeTokenPriceRecords(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range k.getFeeTokenPriceRecords(ctx, denom) {
+		store.Delete(types.GetFeeTokenPriceRecordKey(denom, record.Time))
+	}
+}
+
+func (k Keeper) getLastFeeTokenPriceRecord(ctx sdk.Context, denom string) (types.FeeTokenPriceRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeeTokenPriceRecordsPrefix(denom))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.FeeTokenPriceRecord{}, false
+	}
+	var record types.FeeTokenPriceRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
+
+// getFeeTokenPriceRecords returns the price records of a fee token, ordered by
+// time.
+func (k Keeper) getFeeTokenPriceRecords(ctx sdk.Context, denom string) []types.FeeTokenPriceRecord {
+	return k.getFeeTokenPriceRecordsWithPrefix(ctx, types.GetFeeTokenPriceRecordsPrefix(denom))
+}
+
+// GetAllFeeTokenPriceRecords returns the price records of all fee tokens, by
+// denom and then by time.
+func (k Keeper) GetAllFeeTokenPriceRecords(ctx sdk.Context) []types.FeeTokenPriceRecord {
+	return k.getFeeTokenPriceRecordsWithPrefix(ctx, types.FeeTokenPriceRecordsPrefix)
+}
+
+func (k Keeper) getFeeTokenPriceRecordsWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.FeeTokenPriceRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.FeeTokenPriceRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.FeeTokenPriceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) setFeeTokenPriceRecord(ctx sdk.Context, record types.FeeTokenPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeTokenPriceRecordKey(record.Denom, record.Time), k.cdc.MustMarshal(&record))
+}
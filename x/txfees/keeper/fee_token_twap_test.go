@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeTokenTwap() {
+	suite.SetupTest(false)
+	keeper := suite.App.TxFeesKeeper
+	baseDenom, err := keeper.GetBaseDenom(suite.Ctx)
+	suite.Require().NoError(err)
+	uion := "uion"
+
+	params := keeper.GetParams(suite.Ctx)
+	params.FeeTokenValuationMode = types.Twap
+	params.FeeTokenTwapWindow = time.Minute
+	keeper.SetParams(suite.Ctx, params)
+
+	startTime := suite.Ctx.BlockTime()
+	poolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1_000_000),
+		sdk.NewInt64Coin(uion, 1_000_000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, poolId))
+	initialPrice, err := keeper.CalcFeeSpotPrice(suite.Ctx, uion)
+	suite.Require().NoError(err)
+
+	// the TWAP of a fee token whitelisted in the current block is its spot
+	// price
+	twap, err := keeper.CalcFeeTokenTwap(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialPrice.String(), twap.String())
+
+	// a swap moving the spot price doesn't move the TWAP in the same block
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin(uion, 1_000_000), baseDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+	swappedPrice, err := keeper.CalcFeeSpotPrice(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(initialPrice.String(), swappedPrice.String())
+
+	twap, err = keeper.CalcFeeTokenTwap(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialPrice.String(), twap.String())
+	convertedFee, err := keeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin(uion, 1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(baseDenom, initialPrice.MulInt64(1000).RoundInt()).String(), convertedFee.String())
+
+	// the price after the swap weighs by how long it held
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(50 * time.Second))
+	twap, err = keeper.CalcFeeTokenTwap(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	expectedTwap := initialPrice.MulInt64(20_000).Add(swappedPrice.MulInt64(30_000)).QuoInt64(50_000)
+	suite.Require().Equal(expectedTwap.String(), twap.String())
+
+	// once the window is past the whitelisting, the TWAP only covers the
+	// window
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(100 * time.Second))
+	twap, err = keeper.CalcFeeTokenTwap(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(swappedPrice.String(), twap.String())
+
+	// in the spot price mode, fees are valued at the spot price
+	params.FeeTokenValuationMode = types.SpotPrice
+	keeper.SetParams(suite.Ctx, params)
+	convertedFee, err = keeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin(uion, 1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(baseDenom, swappedPrice.MulInt64(1000).RoundInt()).String(), convertedFee.String())
+
+	// the records before the last one at or before the start of the window
+	// are pruned when a new one is written
+	suite.Require().Len(keeper.GetAllFeeTokenPriceRecords(suite.Ctx), 2)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin(uion, 1000), baseDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+	records := keeper.GetAllFeeTokenPriceRecords(suite.Ctx)
+	suite.Require().Len(records, 2)
+	suite.Require().True(startTime.Add(20 * time.Second).Equal(records[0].Time))
+	suite.Require().True(suite.Ctx.BlockTime().Equal(records[1].Time))
+
+	// removing the fee token deletes its records
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, 0))
+	suite.Require().Empty(keeper.GetAllFeeTokenPriceRecords(suite.Ctx))
+	_, err = keeper.CalcFeeTokenTwap(suite.Ctx, uion)
+	suite.Require().ErrorIs(err, types.ErrNoFeeTokenPriceRecords)
+}
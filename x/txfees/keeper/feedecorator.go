@@ -13,10 +13,11 @@ import (
 )
 
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
-// as the local validator's minimum gasFee (defined in validator config).
-// If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true
-// If fee is high enough or not CheckTx, then call next AnteHandler
+// as the current base fee of the chain, and in CheckTx as the local validator's
+// minimum gasFee (defined in validator config) as well.
+// If fee is too low, decorator returns error and tx is rejected.
+// Note the local minimum gasFee only applies when ctx.CheckTx = true
+// If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator.
 type MempoolFeeDecorator struct {
 	TxFeesKeeper Keeper
@@ -71,12 +72,17 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
-	// If we are in CheckTx, this function is ran locally to determine if these fees are sufficient
+	// The provided fees must cover the base fee, which is a consensus rule, so it is checked in
+	// DeliverTx as well.
+	// If we are in CheckTx, this function is also ran locally to determine if these fees are sufficient
 	// to enter our mempool.
-	// So we ensure that the provided fees meet a minimum threshold for the validator,
+	// So we ensure that the provided fees meet a minimum threshold for the validator too,
 	// converting every non-osmo specified asset into an osmo-equivalent amount, to determine sufficiency.
-	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
-		minBaseGasPrice := mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx)
+	if !simulate {
+		minBaseGasPrice := mfd.TxFeesKeeper.GetCurrentBaseFee(ctx)
+		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+			minBaseGasPrice = sdk.MaxDec(minBaseGasPrice, mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx))
+		}
 		if !(minBaseGasPrice.IsZero()) {
 			if len(feeCoins) != 1 {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "no fee attached")
@@ -36,6 +36,8 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 		isCheckTx    bool
 		expectPass   bool
 		baseDenomGas bool
+		// baseFee is the current base fee, left unset when nil
+		baseFee sdk.Dec
 	}{
 		{
 			name:         "no min gas price - checktx",
@@ -178,6 +180,57 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 			expectPass:   true,
 			baseDenomGas: false,
 		},
+		{
+			name:         "doesn't work with not enough fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   false,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:         "works with enough fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   true,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:         "doesn't work with not enough converted fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(uion, 1)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   false,
+			baseDenomGas: false,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:         "works with enough converted fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(uion, 1000)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   true,
+			baseDenomGas: false,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:  "doesn't work with fee above min gas price but below the base fee in checktx",
+			txFee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
+				sdk.MustNewDecFromStr("0.01"))),
+			gasRequested: 10000,
+			isCheckTx:    true,
+			expectPass:   false,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -192,6 +245,9 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 
 		suite.Ctx = suite.Ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(tc.minGasPrices)
 		suite.Ctx = suite.Ctx.WithMinGasPrices(tc.minGasPrices)
+		if !tc.baseFee.IsNil() {
+			suite.App.TxFeesKeeper.SetCurrentBaseFee(suite.Ctx, tc.baseFee)
+		}
 
 		// TxBuilder components reset for every test case
 		txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
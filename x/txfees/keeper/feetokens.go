@@ -8,7 +8,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount.
+// ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount,
+// at the price given by the fee token valuation mode.
 func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -24,14 +25,16 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	price, err := k.CalcFeeTokenPrice(ctx, feeToken.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).RoundInt()), nil
 }
 
+// CalcFeeSpotPrice returns the spot price of a fee token in the base denom, which is the product of
+// the spot prices of the pools along its route for fee tokens with routes.
 func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -43,9 +46,20 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 		return sdk.Dec{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
-	if err != nil {
-		return sdk.Dec{}, err
+	return k.calcRouteSpotPrice(ctx, feeToken.Denom, feeToken.SwapRoutes(baseDenom))
+}
+
+// calcRouteSpotPrice returns the spot price of tokenInDenom in the token out denom of the last route,
+// multiplying the spot prices of the pools along the routes.
+func (k Keeper) calcRouteSpotPrice(ctx sdk.Context, tokenInDenom string, routes []types.SwapAmountInRoute) (sdk.Dec, error) {
+	spotPrice := sdk.OneDec()
+	for _, route := range routes {
+		hopSpotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, route.PoolId, route.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		spotPrice = spotPrice.Mul(hopSpotPrice)
+		tokenInDenom = route.TokenOutDenom
 	}
 	return spotPrice, nil
 }
@@ -82,6 +96,11 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 // - The denom is not the base denom
 // - The gamm pool exists
 // - The gamm pool includes the base token and fee token.
+// For fee tokens with routes, it checks instead that:
+// - The pool ID is not set
+// - The routes only reach the base denom at their end
+// - The gamm pools of the routes exist
+// - The gamm pool of each route includes its token in and token out.
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -90,12 +109,27 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	if baseDenom == feeToken.Denom {
 		return sdkerrors.Wrap(types.ErrInvalidFeeToken, "cannot add basedenom as a whitelisted fee token")
 	}
-	// This not returning an error implies that:
-	// - feeToken.Denom exists
-	// - feeToken.PoolID exists
-	// - feeToken.PoolID has both feeToken.Denom and baseDenom
-	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	if len(feeToken.Routes) == 0 {
+		// This not returning an error implies that:
+		// - feeToken.Denom exists
+		// - feeToken.PoolID exists
+		// - feeToken.PoolID has both feeToken.Denom and baseDenom
+		_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+		return err
+	}
 
+	if feeToken.PoolID != 0 {
+		return sdkerrors.Wrap(types.ErrInvalidFeeToken, "a fee token with routes cannot have a pool id")
+	}
+	for i, route := range feeToken.Routes {
+		isLastRoute := i == len(feeToken.Routes)-1
+		if (route.TokenOutDenom == baseDenom) != isLastRoute {
+			return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "the routes of %s must only reach the base denom at their end", feeToken.Denom)
+		}
+	}
+	// This not returning an error implies that the pool of each route exists,
+	// and has both its token in and token out.
+	_, err = k.calcRouteSpotPrice(ctx, feeToken.Denom, feeToken.Routes)
 	return err
 }
 
@@ -117,15 +151,16 @@ func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, erro
 	return feeToken, nil
 }
 
-// setFeeToken sets a new fee token record for a specific denom.
-// If the feeToken pool ID is 0, deletes the fee Token entry.
+// setFeeToken sets a new fee token record for a specific denom, and records its price.
+// If the feeToken pool ID is 0 and it has no routes, deletes the fee Token entry and its price records.
 func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	prefixStore := k.GetFeeTokensStore(ctx)
 
-	if feeToken.PoolID == 0 {
+	if feeToken.PoolID == 0 && len(feeToken.Routes) == 0 {
 		if prefixStore.Has([]byte(feeToken.Denom)) {
 			prefixStore.Delete([]byte(feeToken.Denom))
 		}
+		k.deleteFeeTokenPriceRecords(ctx, feeToken.Denom)
 		return nil
 	}
 
@@ -140,6 +175,7 @@ func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	}
 
 	prefixStore.Set([]byte(feeToken.Denom), bz)
+	k.recordFeeTokenPrice(ctx, feeToken)
 	return nil
 }
 
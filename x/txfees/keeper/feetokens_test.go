@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -219,3 +221,107 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMultihopFeeTokens() {
+	suite.SetupTest(false)
+	keeper := suite.App.TxFeesKeeper
+	baseDenom, _ := keeper.GetBaseDenom(suite.Ctx)
+
+	// foo only has a pool with atom, which has a pool with the base denom
+	atomPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000),
+		sdk.NewInt64Coin("atom", 1000),
+	)
+	fooPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin("atom", 1000),
+		sdk.NewInt64Coin("foo", 2000),
+	)
+	routes := []types.SwapAmountInRoute{
+		{PoolId: fooPoolId, TokenOutDenom: "atom"},
+		{PoolId: atomPoolId, TokenOutDenom: baseDenom},
+	}
+
+	tests := []struct {
+		name       string
+		feeToken   types.FeeToken
+		expectPass bool
+	}{
+		{
+			name:       "routes with a pool id",
+			feeToken:   types.FeeToken{Denom: "foo", PoolID: fooPoolId, Routes: routes},
+			expectPass: false,
+		},
+		{
+			name:       "routes not ending at the base denom",
+			feeToken:   types.FeeToken{Denom: "foo", Routes: routes[:1]},
+			expectPass: false,
+		},
+		{
+			name: "routes reaching the base denom before their end",
+			feeToken: types.FeeToken{Denom: "foo", Routes: []types.SwapAmountInRoute{
+				{PoolId: fooPoolId, TokenOutDenom: "atom"},
+				{PoolId: atomPoolId, TokenOutDenom: baseDenom},
+				{PoolId: atomPoolId, TokenOutDenom: "atom"},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "routes with a pool without their token in",
+			feeToken: types.FeeToken{Denom: "foo", Routes: []types.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "atom"},
+				{PoolId: atomPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: false,
+		},
+		{
+			name:       "valid routes",
+			feeToken:   types.FeeToken{Denom: "foo", Routes: routes},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewUpdateFeeTokenProposal("Test Proposal", "test", tc.feeToken)
+		err := keeper.HandleUpdateFeeTokenProposal(suite.Ctx, &proposal)
+		if tc.expectPass {
+			suite.Require().NoError(err, "test: %s", tc.name)
+		} else {
+			suite.Require().Error(err, "test: %s", tc.name)
+		}
+	}
+
+	// foo is valued along its route, at half an atom, worth one base denom
+	spotPrice, err := keeper.CalcFeeSpotPrice(suite.Ctx, "foo")
+	suite.Require().NoError(err)
+	fooAtomPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, fooPoolId, "atom", "foo")
+	suite.Require().NoError(err)
+	atomBasePrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, atomPoolId, baseDenom, "atom")
+	suite.Require().NoError(err)
+	suite.Require().Equal(fooAtomPrice.Mul(atomBasePrice).String(), spotPrice.String())
+	converted, err := keeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 50).String(), converted.String())
+
+	// a change of any pool along the route records the price of foo
+	suite.Require().Len(keeper.GetAllFeeTokenPriceRecords(suite.Ctx), 1)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], atomPoolId, sdk.NewInt64Coin("atom", 10), baseDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Len(keeper.GetAllFeeTokenPriceRecords(suite.Ctx), 2)
+
+	// foo collected as fees is swapped to the base denom along its route at
+	// the end of the epoch
+	fees := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	suite.FundAcc(suite.TestAccs[1], fees)
+	err = suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+	suite.Require().NoError(err)
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	feesBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom)
+
+	keeper.AfterEpochEnd(suite.Ctx, "day", 1)
+
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+	feesAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom)
+	suite.Require().True(feesAfter.IsGTE(feesBefore.Add(sdk.NewInt64Coin(baseDenom, 1))))
+}
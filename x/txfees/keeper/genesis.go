@@ -13,6 +13,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	// Genesis states from before txfees had a base fee, such as the one the v5
+	// upgrade handler sets, have no params. They are set by the v10 upgrade
+	// handler instead.
+	if !genState.Params.MinBaseFee.IsNil() {
+		k.SetParams(ctx, genState.Params)
+		k.SetCurrentBaseFee(ctx, genState.CurrentBaseFee)
+	}
+	// the price records are set before the fee tokens, which record their
+	// current price on top of them
+	for _, record := range genState.FeeTokenPriceRecords {
+		k.setFeeTokenPriceRecord(ctx, record)
+	}
 	err = k.SetFeeTokens(ctx, genState.Feetokens)
 	if err != nil {
 		panic(err)
@@ -24,5 +36,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.CurrentBaseFee = k.GetCurrentBaseFee(ctx)
+	genesis.FeeTokenPriceRecords = k.GetAllFeeTokenPriceRecords(ctx)
 	return genesis
 }
@@ -81,3 +81,9 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (q Querier) CurrentBaseFee(ctx context.Context, _ *types.QueryCurrentBaseFeeRequest) (*types.QueryCurrentBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryCurrentBaseFeeResponse{CurrentBaseFee: q.Keeper.GetCurrentBaseFee(sdkCtx)}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
@@ -25,15 +26,22 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			continue
 		}
 
-		// Do the swap of this fee token denom to base denom.
+		// Do the swap of this fee token denom to base denom, along its route for fee tokens with routes.
 		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
 			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
 			// but even then the point is a bit moot.
 			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
 			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
 			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
+			tokenIn := coinBalance
+			for _, route := range feetoken.SwapRoutes(baseDenom) {
+				tokenOutAmount, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, route.PoolId, tokenIn, route.TokenOutDenom, minAmountOut)
+				if err != nil {
+					return err
+				}
+				tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+			}
+			return nil
 		})
 	}
 
@@ -63,3 +71,43 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+var _ gammtypes.GammHooks = gammHooks{}
+
+// gammHooks records the prices of the fee tokens whose pool changed, for
+// their TWAPs.
+type gammHooks struct {
+	k Keeper
+}
+
+// GammHooks returns the gamm hooks that record the prices of the fee tokens.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return gammHooks{k}
+}
+
+// AfterPoolCreated is a noop, as new pools aren't fee token pools yet.
+func (h gammHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+func (h gammHooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	h.k.recordPoolFeeTokenPrices(ctx, poolId)
+}
+
+func (h gammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	h.k.recordPoolFeeTokenPrices(ctx, poolId)
+}
+
+func (h gammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.k.recordPoolFeeTokenPrices(ctx, poolId)
+}
+
+func (h gammHooks) BeforeJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) error {
+	return nil
+}
+
+func (h gammHooks) BeforeExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) error {
+	return nil
+}
+
+func (h gammHooks) BeforeSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) error {
+	return nil
+}
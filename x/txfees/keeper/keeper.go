@@ -8,13 +8,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey sdk.StoreKey
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	accountKeeper             types.AccountKeeper
 	bankKeeper                types.BankKeeper
@@ -31,17 +33,22 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	epochKeeper types.EpochKeeper,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	gammKeeper types.GammKeeper,
 	spotPriceCalculator types.SpotPriceCalculator,
 	feeCollectorName string,
 	nonNativeFeeCollectorName string,
 ) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 	return Keeper{
 		cdc:                       cdc,
 		accountKeeper:             accountKeeper,
 		bankKeeper:                bankKeeper,
 		epochKeeper:               epochKeeper,
 		storeKey:                  storeKey,
+		paramSpace:                paramSpace,
 		gammKeeper:                gammKeeper,
 		spotPriceCalculator:       spotPriceCalculator,
 		feeCollectorName:          feeCollectorName,
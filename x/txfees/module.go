@@ -159,9 +159,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock executes all ABCI BeginBlock logic respective to the txfees module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock executes all ABCI EndBlock logic respective to the txfees module,
+// which updates the base fee for the next block given the gas used by this
+// one. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	var blockGasUsed uint64
+	if gasMeter := ctx.BlockGasMeter(); gasMeter != nil {
+		blockGasUsed = gasMeter.GasConsumedToLimit()
+	}
+	am.keeper.UpdateBaseFee(ctx, blockGasUsed)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapRoutes returns the route of pools from the fee token to the base denom,
+// which is the pool of the fee token alone when it has no routes.
+func (feeToken FeeToken) SwapRoutes(baseDenom string) []SwapAmountInRoute {
+	if len(feeToken.Routes) > 0 {
+		return feeToken.Routes
+	}
+	return []SwapAmountInRoute{{PoolId: feeToken.PoolID, TokenOutDenom: baseDenom}}
+}
+
+// UsesPool returns whether the price of the fee token is derived through the
+// given pool.
+func (feeToken FeeToken) UsesPool(poolId uint64) bool {
+	if feeToken.PoolID == poolId {
+		return true
+	}
+	for _, route := range feeToken.Routes {
+		if route.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that a fee token price record has a valid denom and no
+// negative price or accumulator.
+func (record FeeTokenPriceRecord) Validate() error {
+	if err := sdk.ValidateDenom(record.Denom); err != nil {
+		return err
+	}
+	if record.LastPrice.IsNil() || record.LastPrice.IsNegative() {
+		return fmt.Errorf("fee token price record of %s has a negative price: %s", record.Denom, record.LastPrice)
+	}
+	if record.Accumulator.IsNil() || record.Accumulator.IsNegative() {
+		return fmt.Errorf("fee token price record of %s has a negative accumulator: %s", record.Denom, record.Accumulator)
+	}
+	return nil
+}
+
+// RecordAt returns the record moved to the given time, with the accumulator
+// increased by the last price times the milliseconds elapsed since the time of
+// the record. The record is not mutated.
+func (record FeeTokenPriceRecord) RecordAt(t time.Time) FeeTokenPriceRecord {
+	newRecord := record
+	newRecord.Time = t
+	timeDeltaMs := sdk.NewInt(t.Sub(record.Time).Milliseconds())
+	newRecord.Accumulator = record.Accumulator.Add(record.LastPrice.MulInt(timeDeltaMs))
+	return newRecord
+}
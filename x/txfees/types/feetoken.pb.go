@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,9 +32,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // This marks the token as eligible for use as a tx fee asset in Osmosis.
 // Its price in osmo is derived through looking at the provided pool ID.
 // The pool ID must have osmo as one of its assets.
+// Fee tokens without a pool with osmo instead specify a route of pools to
+// osmo, through which their price is derived and they are swapped to osmo.
 type FeeToken struct {
 	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	PoolID uint64 `protobuf:"varint,2,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// routes is the route of pools from the fee token to the base denom, used
+	// instead of poolID, which must then be 0. The last route must have the
+	// base denom as its token out denom.
+	Routes []SwapAmountInRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
 
 func (m *FeeToken) Reset()         { *m = FeeToken{} }
@@ -79,8 +90,143 @@ func (m *FeeToken) GetPoolID() uint64 {
 	return 0
 }
 
+func (m *FeeToken) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// SwapAmountInRoute is a hop of the route of a fee token to the base denom.
+// It mirrors the SwapAmountInRoute of gamm, whose types depend on the txfees
+// types.
+type SwapAmountInRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *SwapAmountInRoute) Reset()         { *m = SwapAmountInRoute{} }
+func (m *SwapAmountInRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInRoute) ProtoMessage()    {}
+func (*SwapAmountInRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c50689857adfcfe0, []int{1}
+}
+func (m *SwapAmountInRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInRoute.Merge(m, src)
+}
+func (m *SwapAmountInRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapAmountInRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// FeeTokenPriceRecord is an observation of the price of a fee token, in the
+// base denom, from which the TWAPs of the fee token are computed. A record is
+// written in the blocks in which the pool of the fee token changes.
+type FeeTokenPriceRecord struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// height this record corresponds to, for debugging purposes
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// last_price is the spot price of the fee token after the last change of
+	// its pool in the block of the record. It is treated as the price of the
+	// fee token until the next record.
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price" yaml:"last_price"`
+	// accumulator is the sum of the prices of the fee token, each multiplied by
+	// the milliseconds it held, up to the time of the record.
+	Accumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=accumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accumulator" yaml:"accumulator"`
+}
+
+func (m *FeeTokenPriceRecord) Reset()         { *m = FeeTokenPriceRecord{} }
+func (m *FeeTokenPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenPriceRecord) ProtoMessage()    {}
+func (*FeeTokenPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c50689857adfcfe0, []int{2}
+}
+func (m *FeeTokenPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenPriceRecord.Merge(m, src)
+}
+func (m *FeeTokenPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenPriceRecord proto.InternalMessageInfo
+
+func (m *FeeTokenPriceRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeTokenPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeTokenPriceRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*FeeToken)(nil), "osmosis.txfees.v1beta1.FeeToken")
+	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.txfees.v1beta1.SwapAmountInRoute")
+	proto.RegisterType((*FeeTokenPriceRecord)(nil), "osmosis.txfees.v1beta1.FeeTokenPriceRecord")
 }
 
 func init() {
@@ -88,22 +234,40 @@ func init() {
 }
 
 var fileDescriptor_c50689857adfcfe0 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4b, 0x4d, 0x2d, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x52, 0xb8, 0x38, 0xdc, 0x52, 0x53, 0x43,
-	0x40, 0xfa, 0x85, 0xd4, 0xb8, 0x58, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x02, 0x0b, 0x2b,
-	0x05, 0x41, 0xa4, 0x85, 0xb4, 0xb8, 0xd8, 0x0a, 0xf2, 0xf3, 0x73, 0x3c, 0x5d, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0x58, 0x9c, 0x84, 0x3e, 0xdd, 0x93, 0xe7, 0x83, 0x28, 0x04, 0x89, 0xc7, 0x67, 0xa6,
-	0x28, 0x05, 0x41, 0x55, 0x58, 0xb1, 0xbc, 0x58, 0x20, 0xcf, 0xe8, 0xe4, 0x7d, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0xfa, 0x50, 0x87, 0xeb, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x38, 0xfa, 0x65, 0xe6, 0xfa,
-	0x15, 0x30, 0x1f, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x6e, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x6f, 0x91, 0x61, 0xbb, 0x10, 0x01, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x25, 0x69, 0xa0, 0x17, 0x0a, 0xe4, 0x80, 0x62, 0x65, 0xb0, 0xa3, 0x93, 0xa8, 0x52,
+	0x50, 0x7d, 0x4a, 0x19, 0x90, 0xba, 0x61, 0x22, 0x50, 0xc5, 0x00, 0x3a, 0x3a, 0x20, 0x96, 0xc8,
+	0x76, 0x2e, 0x8e, 0x55, 0x3b, 0x67, 0xe5, 0xce, 0xa5, 0xfd, 0x0b, 0x4c, 0xfd, 0x09, 0xfc, 0x12,
+	0xe6, 0x8e, 0x1d, 0x11, 0x83, 0x41, 0xc9, 0xc2, 0x9c, 0x1d, 0x09, 0xf9, 0xee, 0xac, 0x46, 0x80,
+	0x04, 0x4c, 0xc9, 0x7b, 0xfe, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x36, 0x7c, 0xc0, 0x45, 0xca, 0x45,
+	0x2c, 0x88, 0x3c, 0x9d, 0x30, 0x26, 0xc8, 0xc9, 0x20, 0x60, 0xd2, 0x1f, 0x90, 0x09, 0x63, 0x92,
+	0x1f, 0xb3, 0x99, 0x9b, 0xcd, 0xb9, 0xe4, 0x68, 0xdb, 0xc0, 0x5c, 0x0d, 0x73, 0x0d, 0xac, 0x7b,
+	0x37, 0xe2, 0x11, 0x57, 0x10, 0x52, 0xfe, 0xd3, 0xe8, 0xae, 0x13, 0x71, 0x1e, 0x25, 0x8c, 0xa8,
+	0x2a, 0xc8, 0x27, 0x44, 0xc6, 0x29, 0x13, 0xd2, 0x4f, 0x33, 0x0d, 0xc0, 0x9f, 0x00, 0xbc, 0xfe,
+	0x9c, 0xb1, 0xa3, 0x52, 0x01, 0xed, 0xc0, 0x8d, 0x31, 0x9b, 0xf1, 0xd4, 0x02, 0x3d, 0xd0, 0xdf,
+	0xf4, 0x6e, 0xaf, 0x0a, 0xe7, 0xc6, 0x99, 0x9f, 0x26, 0x07, 0x58, 0xb5, 0x31, 0xd5, 0x8f, 0xd1,
+	0x43, 0xd8, 0xca, 0x38, 0x4f, 0x0e, 0x87, 0x56, 0xbd, 0x07, 0xfa, 0x4d, 0x0f, 0xad, 0x0a, 0xe7,
+	0xa6, 0x06, 0x96, 0xfd, 0x51, 0x3c, 0xc6, 0xd4, 0x20, 0xd0, 0x5b, 0xd8, 0x9a, 0xf3, 0x5c, 0x32,
+	0x61, 0x35, 0x7a, 0x8d, 0x7e, 0x7b, 0x7f, 0xd7, 0xfd, 0xf3, 0x02, 0xee, 0x9b, 0xf7, 0x7e, 0xf6,
+	0x34, 0xe5, 0xf9, 0x4c, 0x1e, 0xce, 0x68, 0x39, 0xe1, 0xdd, 0xbb, 0x28, 0x9c, 0xda, 0xaa, 0x70,
+	0xb6, 0x34, 0xb5, 0xa6, 0xc1, 0xd4, 0xf0, 0x1d, 0x34, 0xbf, 0x7f, 0x74, 0x00, 0xfe, 0x00, 0x60,
+	0xe7, 0xb7, 0x51, 0xf4, 0x08, 0x5e, 0x33, 0x4e, 0x2c, 0xf0, 0x17, 0x8b, 0x63, 0xe4, 0xc1, 0x5b,
+	0x2a, 0xe1, 0x11, 0xcf, 0xe5, 0x48, 0x07, 0x50, 0x57, 0x01, 0x74, 0x57, 0x85, 0xb3, 0xad, 0x87,
+	0x7e, 0x01, 0x60, 0xba, 0xa5, 0x3a, 0xaf, 0x72, 0x39, 0x2c, 0x6b, 0x63, 0xe6, 0x47, 0x1d, 0xde,
+	0xa9, 0xd2, 0x7c, 0x3d, 0x8f, 0x43, 0x46, 0x59, 0xc8, 0xe7, 0xe3, 0x7f, 0x0e, 0x76, 0x17, 0xb6,
+	0xa6, 0x2c, 0x8e, 0xa6, 0x52, 0x19, 0x68, 0x78, 0x9d, 0xab, 0xed, 0x75, 0x1f, 0x53, 0x03, 0x40,
+	0x2f, 0x60, 0xb3, 0xbc, 0xa5, 0xd5, 0xe8, 0x81, 0x7e, 0x7b, 0xbf, 0xeb, 0xea, 0x43, 0xbb, 0xd5,
+	0xa1, 0xdd, 0xa3, 0xea, 0xd0, 0xde, 0x7d, 0x13, 0x63, 0xdb, 0x6c, 0x12, 0xa7, 0x0c, 0x9f, 0x7f,
+	0x75, 0x00, 0x55, 0x04, 0x28, 0x80, 0x30, 0xf1, 0x85, 0x1c, 0x65, 0xa5, 0x5f, 0xab, 0xa9, 0x0c,
+	0x3e, 0x2b, 0x47, 0xbe, 0x14, 0xce, 0x4e, 0x14, 0xcb, 0x69, 0x1e, 0xb8, 0x21, 0x4f, 0x49, 0xa8,
+	0xee, 0x66, 0x7e, 0xf6, 0xc4, 0xf8, 0x98, 0xc8, 0xb3, 0x8c, 0x09, 0x77, 0xc8, 0xc2, 0x55, 0xe1,
+	0x74, 0x34, 0xf9, 0x15, 0x13, 0xa6, 0x9b, 0x65, 0xa1, 0x52, 0x40, 0x13, 0xd8, 0xf6, 0xc3, 0x30,
+	0x4f, 0xf3, 0xc4, 0x97, 0x7c, 0x6e, 0x6d, 0x28, 0x91, 0xe1, 0x7f, 0x8b, 0x20, 0x2d, 0xb2, 0x46,
+	0x85, 0xe9, 0x3a, 0xb1, 0xf7, 0xf2, 0x62, 0x61, 0x83, 0xcb, 0x85, 0x0d, 0xbe, 0x2d, 0x6c, 0x70,
+	0xbe, 0xb4, 0x6b, 0x97, 0x4b, 0xbb, 0xf6, 0x79, 0x69, 0xd7, 0xde, 0x0d, 0xd6, 0x44, 0xcc, 0x0b,
+	0xb8, 0x97, 0xf8, 0x81, 0xa8, 0x0a, 0x72, 0xf2, 0x84, 0x9c, 0x56, 0x9f, 0x9e, 0xd2, 0x0c, 0x5a,
+	0x2a, 0xcb, 0xc7, 0x3f, 0x07, 0x00, 0x55, 0x52, 0x94, 0xc8, 0x99, 0x03, 0x00, 0x00,
 }
 
 func (this *FeeToken) Equal(that interface{}) bool {
@@ -131,6 +295,41 @@ func (this *FeeToken) Equal(that interface{}) bool {
 	if this.PoolID != that1.PoolID {
 		return false
 	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(&that1.Routes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SwapAmountInRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapAmountInRoute)
+	if !ok {
+		that2, ok := that.(SwapAmountInRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
 	return true
 }
 func (m *FeeToken) Marshal() (dAtA []byte, err error) {
@@ -153,6 +352,20 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeetoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.PoolID != 0 {
 		i = encodeVarintFeetoken(dAtA, i, uint64(m.PoolID))
 		i--
@@ -168,6 +381,104 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountInRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountInRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFeetoken(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Accumulator.Size()
+		i -= size
+		if _, err := m.Accumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeetoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeetoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeetoken(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintFeetoken(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeetoken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeetoken(v)
 	base := offset
@@ -192,6 +503,50 @@ func (m *FeeToken) Size() (n int) {
 	if m.PoolID != 0 {
 		n += 1 + sovFeetoken(uint64(m.PoolID))
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovFeetoken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapAmountInRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovFeetoken(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeTokenPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFeetoken(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeetoken(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovFeetoken(uint64(l))
+	l = m.Accumulator.Size()
+	n += 1 + l + sovFeetoken(uint64(l))
 	return n
 }
 
@@ -281,6 +636,343 @@ func (m *FeeToken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeetoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeetoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeetoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenPriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeetoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenPriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeetoken(dAtA[iNdEx:])
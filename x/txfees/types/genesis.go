@@ -1,12 +1,20 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
-		Basedenom: sdk.DefaultBondDenom,
-		Feetokens: []FeeToken{},
+		Basedenom:            sdk.DefaultBondDenom,
+		Feetokens:            []FeeToken{},
+		Params:               params,
+		CurrentBaseFee:       params.MinBaseFee,
+		FeeTokenPriceRecords: []FeeTokenPriceRecord{},
 	}
 }
 
@@ -26,5 +34,19 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.CurrentBaseFee.IsNil() || gs.CurrentBaseFee.IsNegative() {
+		return fmt.Errorf("current base fee must not be negative: %s", gs.CurrentBaseFee)
+	}
+
+	for _, record := range gs.FeeTokenPriceRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
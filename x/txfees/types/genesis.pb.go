@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,17 +28,131 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type FeeTokenValuationMode int32
+
+const (
+	// Fee tokens are valued at the spot price of their pool
+	SpotPrice FeeTokenValuationMode = 0
+	// Fee tokens are valued at the time weighted average price of their pool
+	// over the fee token TWAP window, which can't be moved within a block
+	Twap FeeTokenValuationMode = 1
+)
+
+var FeeTokenValuationMode_name = map[int32]string{
+	0: "SpotPrice",
+	1: "Twap",
+}
+
+var FeeTokenValuationMode_value = map[string]int32{
+	"SpotPrice": 0,
+	"Twap":      1,
+}
+
+func (x FeeTokenValuationMode) String() string {
+	return proto.EnumName(FeeTokenValuationMode_name, int32(x))
+}
+
+func (FeeTokenValuationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{0}
+}
+
+// Params holds parameters for the txfees module, which control the base fee.
+// The base fee is a gas price, in the base denom, that every tx has to pay
+// regardless of the local minimum gas prices of validators. It moves each
+// block toward the price at which blocks use target_block_gas.
+type Params struct {
+	// min_base_fee is the lowest the base fee can fall to. A zero min_base_fee
+	// disables the base fee.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the highest the base fee can rise to.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee" yaml:"max_base_fee"`
+	// target_block_gas is the gas used by a block at which the base fee stays
+	// the same. The base fee rises after blocks using more gas, and falls after
+	// blocks using less.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// max_base_fee_change is the largest fraction by which the base fee changes
+	// in a block, reached by blocks using no gas or twice target_block_gas.
+	MaxBaseFeeChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_base_fee_change,json=maxBaseFeeChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee_change" yaml:"max_base_fee_change"`
+	// fee_token_valuation_mode is how fees paid in fee tokens are valued in the
+	// base denom, when checking they cover the required fees.
+	FeeTokenValuationMode FeeTokenValuationMode `protobuf:"varint,5,opt,name=fee_token_valuation_mode,json=feeTokenValuationMode,proto3,enum=osmosis.txfees.v1beta1.FeeTokenValuationMode" json:"fee_token_valuation_mode,omitempty" yaml:"fee_token_valuation_mode"`
+	// fee_token_twap_window is the period over which the TWAPs of fee tokens
+	// are taken, when fees are valued at TWAPs.
+	FeeTokenTwapWindow time.Duration `protobuf:"bytes,6,opt,name=fee_token_twap_window,json=feeTokenTwapWindow,proto3,stdduration" json:"fee_token_twap_window" yaml:"fee_token_twap_window"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTokenValuationMode() FeeTokenValuationMode {
+	if m != nil {
+		return m.FeeTokenValuationMode
+	}
+	return SpotPrice
+}
+
+func (m *Params) GetFeeTokenTwapWindow() time.Duration {
+	if m != nil {
+		return m.FeeTokenTwapWindow
+	}
+	return 0
+}
+
 // GenesisState defines the txfees module's genesis state.
 type GenesisState struct {
 	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	// params is the container of txfees parameters.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// current_base_fee is the base fee for the next block.
+	CurrentBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=current_base_fee,json=currentBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_base_fee" yaml:"current_base_fee"`
+	// fee_token_price_records are the records the TWAPs of the fee tokens are
+	// computed from.
+	FeeTokenPriceRecords []FeeTokenPriceRecord `protobuf:"bytes,5,rep,name=fee_token_price_records,json=feeTokenPriceRecords,proto3" json:"fee_token_price_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4423c18e3d020b37, []int{0}
+	return fileDescriptor_4423c18e3d020b37, []int{1}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +195,23 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFeeTokenPriceRecords() []FeeTokenPriceRecord {
+	if m != nil {
+		return m.FeeTokenPriceRecords
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.txfees.v1beta1.FeeTokenValuationMode", FeeTokenValuationMode_name, FeeTokenValuationMode_value)
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
 
@@ -85,22 +220,119 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0x79, 0x9f, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0x5c, 0xbf,
-	0x02, 0xe6, 0xa3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3f, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdd, 0x30, 0x73, 0x62, 0x44, 0x01, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x5d, 0x6b, 0xd4, 0x4c,
+	0x18, 0xdd, 0xb4, 0xdb, 0xe5, 0xdd, 0x69, 0xdf, 0xb2, 0xa4, 0xad, 0x8d, 0x6b, 0x49, 0x96, 0xf8,
+	0xc1, 0xa2, 0x34, 0xa1, 0x2b, 0x28, 0x88, 0x57, 0xb1, 0x1f, 0x88, 0x0a, 0x25, 0x2d, 0x0a, 0xde,
+	0x84, 0x49, 0xf2, 0x6c, 0x1a, 0xba, 0xc9, 0x84, 0xcc, 0xec, 0x47, 0xf1, 0x0f, 0x08, 0x5e, 0xe8,
+	0xa5, 0xf7, 0xfa, 0x63, 0x7a, 0xd9, 0x4b, 0xf1, 0x62, 0x95, 0xf6, 0x1f, 0xec, 0x2f, 0x90, 0x4c,
+	0x26, 0x64, 0xed, 0x07, 0xa5, 0x78, 0x95, 0xcc, 0x33, 0xe7, 0x3c, 0xe7, 0xc9, 0x99, 0x33, 0x41,
+	0xf7, 0x08, 0x8d, 0x08, 0x0d, 0xa9, 0xc9, 0x46, 0x5d, 0x00, 0x6a, 0x0e, 0x36, 0x5c, 0x60, 0x78,
+	0xc3, 0x0c, 0x20, 0x06, 0x1a, 0x52, 0x23, 0x49, 0x09, 0x23, 0xf2, 0x2d, 0x81, 0x32, 0x72, 0x94,
+	0x21, 0x50, 0xcd, 0xe5, 0x80, 0x04, 0x84, 0x43, 0xcc, 0xec, 0x2d, 0x47, 0x37, 0xd5, 0x80, 0x90,
+	0xa0, 0x07, 0x26, 0x5f, 0xb9, 0xfd, 0xae, 0xe9, 0xf7, 0x53, 0xcc, 0x42, 0x12, 0x8b, 0xfd, 0xfb,
+	0x57, 0x68, 0x76, 0x01, 0x18, 0x39, 0x04, 0x01, 0xd3, 0xbf, 0xcf, 0xa1, 0xda, 0x2e, 0x4e, 0x71,
+	0x44, 0xe5, 0x00, 0x2d, 0x44, 0x61, 0xec, 0xb8, 0x98, 0x82, 0xd3, 0x05, 0x50, 0xa4, 0x96, 0xd4,
+	0xae, 0x5b, 0x5b, 0xc7, 0x63, 0xad, 0xf2, 0x73, 0xac, 0x3d, 0x08, 0x42, 0x76, 0xd0, 0x77, 0x0d,
+	0x8f, 0x44, 0xa6, 0xc7, 0x7b, 0x8b, 0xc7, 0x3a, 0xf5, 0x0f, 0x4d, 0x76, 0x94, 0x00, 0x35, 0x36,
+	0xc1, 0x9b, 0x8c, 0xb5, 0xa5, 0x23, 0x1c, 0xf5, 0x9e, 0xe9, 0xd3, 0xbd, 0x74, 0x1b, 0x45, 0x61,
+	0x6c, 0x61, 0x0a, 0xdb, 0x00, 0x5c, 0x08, 0x8f, 0x4a, 0xa1, 0x99, 0x7f, 0x14, 0xc2, 0xa3, 0xbf,
+	0x84, 0xf0, 0xa8, 0x10, 0xda, 0x42, 0x0d, 0x86, 0xd3, 0x00, 0x98, 0xe3, 0xf6, 0x88, 0x77, 0xe8,
+	0x04, 0x98, 0x2a, 0xb3, 0x2d, 0xa9, 0x5d, 0xb5, 0xee, 0x4c, 0xc6, 0xda, 0x6a, 0x4e, 0x3f, 0x8f,
+	0xd0, 0xed, 0xc5, 0xbc, 0x64, 0x65, 0x95, 0x1d, 0x4c, 0xe5, 0x0f, 0x68, 0x69, 0x5a, 0xc3, 0xf1,
+	0x0e, 0x70, 0x1c, 0x80, 0x52, 0xe5, 0x63, 0xbf, 0xbe, 0xf1, 0xd8, 0xcd, 0x8b, 0x63, 0x8b, 0x96,
+	0xba, 0xdd, 0x28, 0xa7, 0x7f, 0xc1, 0x4b, 0xf2, 0x27, 0x09, 0x29, 0x19, 0x82, 0x1f, 0x9a, 0x33,
+	0xc0, 0xbd, 0x3e, 0x3f, 0x65, 0x27, 0x22, 0x3e, 0x28, 0x73, 0x2d, 0xa9, 0xbd, 0xd8, 0x59, 0x37,
+	0x2e, 0x4f, 0x8e, 0xb1, 0x0d, 0xb0, 0x9f, 0xd1, 0xde, 0x16, 0xac, 0x37, 0xc4, 0x07, 0xeb, 0xee,
+	0x64, 0xac, 0x69, 0xf9, 0x0c, 0x57, 0x35, 0xd6, 0xed, 0x95, 0xee, 0x65, 0x5c, 0x79, 0x80, 0x56,
+	0x4a, 0x0e, 0x1b, 0xe2, 0xc4, 0x19, 0x86, 0xb1, 0x4f, 0x86, 0x4a, 0xad, 0x25, 0xb5, 0xe7, 0x3b,
+	0xb7, 0x8d, 0x3c, 0x95, 0x46, 0x91, 0x4a, 0x63, 0x53, 0xa4, 0xd2, 0x6a, 0x67, 0x3e, 0x4d, 0xc6,
+	0xda, 0xda, 0x79, 0xe5, 0xa9, 0x2e, 0xfa, 0xd7, 0x5f, 0x9a, 0x64, 0xcb, 0x85, 0xf4, 0xfe, 0x10,
+	0x27, 0xef, 0xf2, 0x8d, 0xcf, 0xb3, 0x68, 0x61, 0x27, 0xbf, 0x2d, 0x7b, 0x0c, 0x33, 0x90, 0xd7,
+	0x50, 0x3d, 0x33, 0xcf, 0x87, 0x98, 0x44, 0x79, 0x52, 0xed, 0xb2, 0x20, 0x6f, 0xa2, 0x7a, 0x91,
+	0x73, 0xaa, 0xcc, 0xb4, 0x66, 0xdb, 0xf3, 0x9d, 0xd6, 0x75, 0x26, 0x59, 0xd5, 0x6c, 0x42, 0xbb,
+	0x24, 0xca, 0xcf, 0x51, 0x2d, 0xe1, 0x57, 0x83, 0x87, 0x66, 0xbe, 0xa3, 0x5e, 0xd5, 0x22, 0xbf,
+	0x40, 0xa2, 0x81, 0xe0, 0xc8, 0x14, 0x35, 0xbc, 0x7e, 0x9a, 0x42, 0xcc, 0xca, 0xa4, 0xe7, 0x91,
+	0x79, 0x79, 0xe3, 0xc8, 0x88, 0xa8, 0x9e, 0xef, 0xa7, 0xdb, 0x8b, 0xa2, 0x54, 0x24, 0xfe, 0x00,
+	0xad, 0x96, 0xce, 0x26, 0x69, 0xe8, 0x81, 0x93, 0x82, 0x47, 0x52, 0x9f, 0x2a, 0x73, 0xdc, 0x86,
+	0x47, 0xd7, 0xd9, 0xb0, 0x9b, 0x91, 0x6c, 0xce, 0x11, 0x1f, 0xb4, 0xdc, 0xbd, 0xb8, 0x45, 0x1f,
+	0x3e, 0x41, 0x2b, 0x97, 0xc6, 0x4b, 0xfe, 0x1f, 0xd5, 0xf7, 0x12, 0xc2, 0x38, 0xb8, 0x51, 0x91,
+	0xff, 0x43, 0xd5, 0xec, 0x1c, 0x1b, 0x52, 0xb3, 0xfa, 0xf1, 0x9b, 0x5a, 0xb1, 0x5e, 0x1d, 0x9f,
+	0xaa, 0xd2, 0xc9, 0xa9, 0x2a, 0xfd, 0x3e, 0x55, 0xa5, 0x2f, 0x67, 0x6a, 0xe5, 0xe4, 0x4c, 0xad,
+	0xfc, 0x38, 0x53, 0x2b, 0xef, 0x37, 0xa6, 0xec, 0x10, 0x43, 0xae, 0xf7, 0xb0, 0x4b, 0x8b, 0x85,
+	0x39, 0x78, 0x6a, 0x8e, 0x8a, 0xdf, 0x19, 0x77, 0xc7, 0xad, 0xf1, 0x9c, 0x3d, 0xfe, 0x33, 0x00,
+	0xc5, 0x2e, 0x85, 0x0b, 0x61, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FeeTokenTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.FeeTokenValuationMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeTokenValuationMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxBaseFeeChange.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +355,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokenPriceRecords) > 0 {
+		for iNdEx := len(m.FeeTokenPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.CurrentBaseFee.Size()
+		i -= size
+		if _, err := m.CurrentBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Feetokens) > 0 {
 		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -158,6 +424,29 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxBaseFeeChange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.FeeTokenValuationMode != 0 {
+		n += 1 + sovGenesis(uint64(m.FeeTokenValuationMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenTwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -174,6 +463,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CurrentBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeTokenPriceRecords) > 0 {
+		for _, e := range m.FeeTokenPriceRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -183,6 +482,229 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenValuationMode", wireType)
+			}
+			m.FeeTokenValuationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTokenValuationMode |= FeeTokenValuationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FeeTokenTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -278,6 +800,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPriceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokenPriceRecords = append(m.FeeTokenPriceRecords, FeeTokenPriceRecord{})
+			if err := m.FeeTokenPriceRecords[len(m.FeeTokenPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
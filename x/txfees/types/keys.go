@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "txfees"
@@ -18,9 +25,26 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// KeySeparator is used to combine parts of the keys in the store.
+	KeySeparator = "|"
 )
 
 var (
-	BaseDenomKey         = []byte("base_denom")
-	FeeTokensStorePrefix = []byte("fee_tokens")
+	BaseDenomKey               = []byte("base_denom")
+	FeeTokensStorePrefix       = []byte("fee_tokens")
+	CurrentBaseFeeKey          = []byte("current_base_fee")
+	FeeTokenPriceRecordsPrefix = []byte("fee_token_price_records" + KeySeparator)
 )
+
+// GetFeeTokenPriceRecordsPrefix returns the prefix of the price records of a
+// fee token, which are ordered by time.
+func GetFeeTokenPriceRecordsPrefix(denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", FeeTokenPriceRecordsPrefix, denom, KeySeparator))
+}
+
+// GetFeeTokenPriceRecordKey returns the key of the price record of a fee token
+// at the given time.
+func GetFeeTokenPriceRecordKey(denom string, recordTime time.Time) []byte {
+	return append(GetFeeTokenPriceRecordsPrefix(denom), sdk.FormatTimeBytes(recordTime)...)
+}
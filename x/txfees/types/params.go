@@ -0,0 +1,174 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMinBaseFee       = []byte("MinBaseFee")
+	KeyMaxBaseFee       = []byte("MaxBaseFee")
+	KeyTargetBlockGas   = []byte("TargetBlockGas")
+	KeyMaxBaseFeeChange = []byte("MaxBaseFeeChange")
+
+	KeyFeeTokenValuationMode = []byte("FeeTokenValuationMode")
+	KeyFeeTokenTwapWindow    = []byte("FeeTokenTwapWindow")
+)
+
+// ParamKeyTable returns the key table for the txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	minBaseFee, maxBaseFee sdk.Dec,
+	targetBlockGas uint64,
+	maxBaseFeeChange sdk.Dec,
+	feeTokenValuationMode FeeTokenValuationMode,
+	feeTokenTwapWindow time.Duration,
+) Params {
+	return Params{
+		MinBaseFee:            minBaseFee,
+		MaxBaseFee:            maxBaseFee,
+		TargetBlockGas:        targetBlockGas,
+		MaxBaseFeeChange:      maxBaseFeeChange,
+		FeeTokenValuationMode: feeTokenValuationMode,
+		FeeTokenTwapWindow:    feeTokenTwapWindow,
+	}
+}
+
+// default txfees module parameters. The base fee is disabled by default, and
+// changes by at most 12.5% per block once enabled, like in EIP-1559. Fee
+// tokens are valued at their spot price by default.
+func DefaultParams() Params {
+	return Params{
+		MinBaseFee:            sdk.ZeroDec(),
+		MaxBaseFee:            sdk.NewDec(10),
+		TargetBlockGas:        60_000_000,
+		MaxBaseFeeChange:      sdk.NewDecWithPrec(125, 3),
+		FeeTokenValuationMode: SpotPrice,
+		FeeTokenTwapWindow:    10 * time.Minute,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateMaxBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateMaxBaseFeeChange(p.MaxBaseFeeChange); err != nil {
+		return err
+	}
+	if err := validateFeeTokenValuationMode(p.FeeTokenValuationMode); err != nil {
+		return err
+	}
+	if err := validateFeeTokenTwapWindow(p.FeeTokenTwapWindow); err != nil {
+		return err
+	}
+	if p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee %s must not be less than min base fee %s", p.MaxBaseFee, p.MinBaseFee)
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateMaxBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyMaxBaseFeeChange, &p.MaxBaseFeeChange, validateMaxBaseFeeChange),
+		paramtypes.NewParamSetPair(KeyFeeTokenValuationMode, &p.FeeTokenValuationMode, validateFeeTokenValuationMode),
+		paramtypes.NewParamSetPair(KeyFeeTokenTwapWindow, &p.FeeTokenTwapWindow, validateFeeTokenTwapWindow),
+	}
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max base fee must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxBaseFeeChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max base fee change must be in (0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeTokenValuationMode(i interface{}) error {
+	v, ok := i.(FeeTokenValuationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := FeeTokenValuationMode_name[int32(v)]; !ok {
+		return errors.New("invalid fee token valuation mode")
+	}
+
+	return nil
+}
+
+func validateFeeTokenTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("fee token twap window must be positive: %d", v)
+	}
+
+	return nil
+}
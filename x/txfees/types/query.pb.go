@@ -372,6 +372,79 @@ func (m *QueryBaseDenomResponse) GetBaseDenom() string {
 	return ""
 }
 
+type QueryCurrentBaseFeeRequest struct {
+}
+
+func (m *QueryCurrentBaseFeeRequest) Reset()         { *m = QueryCurrentBaseFeeRequest{} }
+func (m *QueryCurrentBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeRequest) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{8}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeRequest proto.InternalMessageInfo
+
+type QueryCurrentBaseFeeResponse struct {
+	CurrentBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=current_base_fee,json=currentBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_base_fee" yaml:"current_base_fee"`
+}
+
+func (m *QueryCurrentBaseFeeResponse) Reset()         { *m = QueryCurrentBaseFeeResponse{} }
+func (m *QueryCurrentBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeResponse) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{9}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -381,6 +454,8 @@ func init() {
 	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
 	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+	proto.RegisterType((*QueryCurrentBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeRequest")
+	proto.RegisterType((*QueryCurrentBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeResponse")
 }
 
 func init() {
@@ -388,46 +463,50 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x33, 0xfd, 0xff, 0x53, 0xc8, 0x54, 0x8a, 0x0e, 0xb6, 0x8d, 0xab, 0x6c, 0xc2, 0xa0,
-	0xa5, 0x54, 0xb2, 0x63, 0x12, 0x45, 0xf0, 0x66, 0x0c, 0x05, 0x11, 0xa4, 0xae, 0x9e, 0x7a, 0x59,
-	0x76, 0x93, 0x37, 0x71, 0x69, 0x92, 0xd9, 0x66, 0x26, 0xa5, 0x41, 0xbc, 0xf8, 0x09, 0x04, 0xc5,
-	0xaf, 0xe0, 0x49, 0x3f, 0x47, 0x8f, 0x05, 0x2f, 0xe2, 0x21, 0x48, 0xe2, 0x27, 0xc8, 0x27, 0x90,
-	0x9d, 0x9d, 0xcd, 0xa6, 0x6d, 0x62, 0x9a, 0x53, 0xb2, 0xf3, 0x3e, 0xf3, 0xbc, 0xcf, 0xbb, 0xf3,
-	0x9b, 0xc5, 0x94, 0x8b, 0x36, 0x17, 0xbe, 0x60, 0xf2, 0xa4, 0x01, 0x20, 0xd8, 0x71, 0xd1, 0x03,
-	0xe9, 0x16, 0xd9, 0x51, 0x0f, 0xba, 0x7d, 0x2b, 0xe8, 0x72, 0xc9, 0xc9, 0xa6, 0xd6, 0x58, 0x91,
-	0xc6, 0xd2, 0x1a, 0xe3, 0x66, 0x93, 0x37, 0xb9, 0x92, 0xb0, 0xf0, 0x5f, 0xa4, 0x36, 0xee, 0x34,
-	0x39, 0x6f, 0xb6, 0x80, 0xb9, 0x81, 0xcf, 0xdc, 0x4e, 0x87, 0x4b, 0x57, 0xfa, 0xbc, 0x23, 0x74,
-	0xd5, 0xd4, 0x55, 0xf5, 0xe4, 0xf5, 0x1a, 0xac, 0xde, 0xeb, 0x2a, 0x81, 0xae, 0xdf, 0x9b, 0x93,
-	0xa7, 0x01, 0x20, 0xf9, 0x21, 0x68, 0x19, 0xdd, 0xc2, 0x1b, 0xaf, 0xc2, 0x84, 0x7b, 0x00, 0x6f,
-	0xc2, 0x65, 0x61, 0xc3, 0x51, 0x0f, 0x84, 0xa4, 0x12, 0x6f, 0x5e, 0x2c, 0x88, 0x80, 0x77, 0x04,
-	0x90, 0x03, 0x8c, 0x1b, 0x00, 0x8e, 0x72, 0x11, 0x59, 0x94, 0xff, 0x6f, 0x67, 0xad, 0x94, 0xb7,
-	0x66, 0x8f, 0x66, 0xc5, 0xdb, 0x2b, 0xb7, 0x4e, 0x07, 0xb9, 0xd4, 0x78, 0x90, 0xbb, 0xd1, 0x77,
-	0xdb, 0xad, 0x27, 0x34, 0x71, 0xa0, 0x76, 0xa6, 0x11, 0xf7, 0xa0, 0x55, 0x6c, 0xa8, 0xae, 0x55,
-	0xe8, 0xf0, 0xf6, 0xeb, 0x80, 0xcb, 0xfd, 0xae, 0x5f, 0x03, 0x9d, 0x89, 0x6c, 0xe3, 0x74, 0x3d,
-	0x2c, 0x64, 0x51, 0x1e, 0xed, 0x64, 0x2a, 0xd7, 0xc7, 0x83, 0xdc, 0xb5, 0xc8, 0x4e, 0x2d, 0x53,
-	0x3b, 0x2a, 0xd3, 0x6f, 0x08, 0xdf, 0x9e, 0x69, 0xa3, 0x27, 0xd8, 0xc5, 0xab, 0x01, 0xe7, 0xad,
-	0xe7, 0x55, 0x65, 0xf4, 0x7f, 0x85, 0x8c, 0x07, 0xb9, 0xf5, 0xc8, 0x28, 0x5c, 0x77, 0xfc, 0x3a,
-	0xb5, 0xb5, 0x82, 0x78, 0x18, 0x8b, 0x80, 0x4b, 0x27, 0x08, 0x1d, 0xb2, 0x2b, 0xaa, 0xf1, 0xb3,
-	0x70, 0x96, 0x5f, 0x83, 0xdc, 0x76, 0xd3, 0x97, 0x6f, 0x7b, 0x9e, 0x55, 0xe3, 0x6d, 0x56, 0x53,
-	0x2f, 0x40, 0xff, 0x14, 0x44, 0xfd, 0x90, 0xc9, 0x7e, 0x00, 0xc2, 0xaa, 0x42, 0x2d, 0x99, 0x3a,
-	0x71, 0xa2, 0x76, 0x46, 0xc4, 0xb9, 0xe8, 0x53, 0xbc, 0x95, 0xc4, 0xdd, 0x0f, 0xfb, 0xd6, 0x97,
-	0x1d, 0x79, 0x0f, 0x67, 0x2f, 0x5b, 0x2c, 0x3f, 0xee, 0x84, 0x87, 0x8a, 0x2b, 0x40, 0x79, 0xc5,
-	0x3c, 0xbc, 0xd4, 0x3c, 0x4c, 0x15, 0xb4, 0xfd, 0x43, 0x8c, 0x3d, 0x57, 0x80, 0x33, 0x9d, 0x73,
-	0x23, 0x99, 0x39, 0xa9, 0x51, 0x3b, 0xe3, 0xc5, 0xbb, 0x4b, 0x9f, 0xd3, 0x38, 0xad, 0x0c, 0xc9,
-	0x17, 0x84, 0x33, 0x13, 0xca, 0x48, 0x61, 0x1e, 0x49, 0x33, 0x31, 0x35, 0xac, 0xab, 0xca, 0xa3,
-	0xb0, 0x74, 0xf7, 0xc3, 0x8f, 0x3f, 0x9f, 0x56, 0xee, 0x12, 0xca, 0xe6, 0xdf, 0x0f, 0x0d, 0x26,
-	0xf9, 0x8e, 0xf0, 0xfa, 0x79, 0x82, 0x48, 0xe9, 0x9f, 0xed, 0x66, 0x52, 0x6b, 0x94, 0x97, 0xda,
-	0xa3, 0x73, 0x96, 0x55, 0xce, 0x02, 0xb9, 0x3f, 0x2f, 0x67, 0x82, 0x92, 0xe3, 0xf5, 0xa3, 0xf7,
-	0x4b, 0xbe, 0x22, 0xbc, 0x36, 0x05, 0x00, 0x61, 0x8b, 0x3b, 0x9f, 0xa3, 0xcd, 0x78, 0x70, 0xf5,
-	0x0d, 0x3a, 0xe7, 0x23, 0x95, 0x93, 0x91, 0xc2, 0xbc, 0x9c, 0x2a, 0x99, 0xa3, 0x39, 0x63, 0xef,
-	0xd4, 0xe3, 0x7b, 0x75, 0xe6, 0x13, 0x92, 0x16, 0x9c, 0xf9, 0x45, 0x14, 0x17, 0x9c, 0xf9, 0x25,
-	0x40, 0x17, 0x9f, 0x79, 0x82, 0x68, 0xe5, 0xc5, 0xe9, 0xd0, 0x44, 0x67, 0x43, 0x13, 0xfd, 0x1e,
-	0x9a, 0xe8, 0xe3, 0xc8, 0x4c, 0x9d, 0x8d, 0xcc, 0xd4, 0xcf, 0x91, 0x99, 0x3a, 0x28, 0x4e, 0x5d,
-	0x76, 0xed, 0x53, 0x68, 0xb9, 0x9e, 0x98, 0x98, 0x1e, 0x3f, 0x66, 0x27, 0xb1, 0xb3, 0xba, 0xfb,
-	0xde, 0xaa, 0xfa, 0xc6, 0x96, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x77, 0xe0, 0xbb, 0x37, 0x1c,
-	0x06, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0x13, 0x5d,
+	0x14, 0xce, 0xf4, 0xff, 0x5b, 0xc8, 0xad, 0x84, 0x7a, 0xb1, 0x6d, 0x1c, 0xcb, 0xa4, 0x5c, 0xb4,
+	0x94, 0x4a, 0xe6, 0xb6, 0x8d, 0x22, 0xb8, 0x33, 0x0d, 0x85, 0x22, 0x48, 0x1d, 0x5d, 0x75, 0x33,
+	0xcc, 0x24, 0x27, 0x31, 0x34, 0xc9, 0x9d, 0xce, 0xbd, 0x53, 0x1a, 0xc4, 0x8d, 0x4f, 0x20, 0x0a,
+	0xbe, 0x82, 0x0b, 0xd1, 0x87, 0x70, 0xd5, 0x65, 0xc1, 0x8d, 0xb8, 0x08, 0xd2, 0xfa, 0x04, 0x7d,
+	0x02, 0x99, 0x3b, 0x77, 0x32, 0xcd, 0x98, 0xb1, 0xc9, 0x2a, 0x99, 0xf9, 0xbe, 0xf3, 0x9d, 0xef,
+	0xe4, 0x9c, 0x8f, 0x20, 0xc2, 0x78, 0x97, 0xf1, 0x36, 0xa7, 0xe2, 0xa4, 0x09, 0xc0, 0xe9, 0xf1,
+	0x96, 0x0b, 0xc2, 0xd9, 0xa2, 0x47, 0x01, 0xf8, 0x7d, 0xd3, 0xf3, 0x99, 0x60, 0x78, 0x49, 0x71,
+	0xcc, 0x88, 0x63, 0x2a, 0x8e, 0x7e, 0xab, 0xc5, 0x5a, 0x4c, 0x52, 0x68, 0xf8, 0x2d, 0x62, 0xeb,
+	0x2b, 0x2d, 0xc6, 0x5a, 0x1d, 0xa0, 0x8e, 0xd7, 0xa6, 0x4e, 0xaf, 0xc7, 0x84, 0x23, 0xda, 0xac,
+	0xc7, 0x15, 0x6a, 0x28, 0x54, 0x3e, 0xb9, 0x41, 0x93, 0x36, 0x02, 0x5f, 0x12, 0x14, 0x7e, 0x2f,
+	0xc3, 0x4f, 0x13, 0x40, 0xb0, 0x43, 0x50, 0x34, 0xb2, 0x8c, 0x16, 0x9f, 0x87, 0x0e, 0x77, 0x01,
+	0x5e, 0x86, 0xaf, 0xb9, 0x05, 0x47, 0x01, 0x70, 0x41, 0x04, 0x5a, 0x4a, 0x03, 0xdc, 0x63, 0x3d,
+	0x0e, 0xf8, 0x00, 0xa1, 0x26, 0x80, 0x2d, 0x55, 0x78, 0x51, 0x5b, 0xfd, 0x6f, 0x7d, 0x7e, 0x7b,
+	0xd5, 0x1c, 0x3f, 0x9a, 0x19, 0x97, 0x57, 0x6f, 0x9f, 0x0e, 0x4a, 0xb9, 0xcb, 0x41, 0xe9, 0x66,
+	0xdf, 0xe9, 0x76, 0x1e, 0x93, 0x44, 0x81, 0x58, 0xf9, 0x66, 0xdc, 0x83, 0xd4, 0x90, 0x2e, 0xbb,
+	0xd6, 0xa0, 0xc7, 0xba, 0x2f, 0x3c, 0x26, 0xf6, 0xfd, 0x76, 0x1d, 0x94, 0x27, 0xbc, 0x86, 0x66,
+	0x1b, 0x21, 0x50, 0xd4, 0x56, 0xb5, 0xf5, 0x7c, 0x75, 0xe1, 0x72, 0x50, 0xba, 0x11, 0xc9, 0xc9,
+	0xd7, 0xc4, 0x8a, 0x60, 0xf2, 0x45, 0x43, 0x77, 0xc6, 0xca, 0xa8, 0x09, 0x36, 0xd0, 0x9c, 0xc7,
+	0x58, 0x67, 0xaf, 0x26, 0x85, 0xfe, 0xaf, 0xe2, 0xcb, 0x41, 0xa9, 0x10, 0x09, 0x85, 0xef, 0xed,
+	0x76, 0x83, 0x58, 0x8a, 0x81, 0x5d, 0x84, 0xb8, 0xc7, 0x84, 0xed, 0x85, 0x0a, 0xc5, 0x19, 0xd9,
+	0x78, 0x27, 0x9c, 0xe5, 0xe7, 0xa0, 0xb4, 0xd6, 0x6a, 0x8b, 0x57, 0x81, 0x6b, 0xd6, 0x59, 0x97,
+	0xd6, 0xe5, 0x0f, 0xa0, 0x3e, 0xca, 0xbc, 0x71, 0x48, 0x45, 0xdf, 0x03, 0x6e, 0xd6, 0xa0, 0x9e,
+	0x4c, 0x9d, 0x28, 0x11, 0x2b, 0xcf, 0x63, 0x5f, 0xe4, 0x09, 0x5a, 0x4e, 0xec, 0xee, 0x87, 0x7d,
+	0x1b, 0xd3, 0x8e, 0xbc, 0x8b, 0x8a, 0x7f, 0x4b, 0x4c, 0x3f, 0xee, 0xf0, 0x1e, 0xaa, 0x0e, 0x07,
+	0xa9, 0x15, 0xdf, 0xc3, 0x33, 0xb4, 0x94, 0x06, 0x94, 0xfc, 0x03, 0x84, 0x5c, 0x87, 0x83, 0x7d,
+	0xd5, 0xe7, 0x62, 0x32, 0x73, 0x82, 0x11, 0x2b, 0xef, 0xc6, 0xd5, 0x64, 0x45, 0x6d, 0x7a, 0x27,
+	0xf0, 0x7d, 0xe8, 0x89, 0x50, 0x76, 0x17, 0xe2, 0x4d, 0x93, 0xf7, 0xf1, 0x06, 0xd3, 0xb0, 0xea,
+	0xc9, 0xd1, 0x42, 0x3d, 0x42, 0x6c, 0xa9, 0xdf, 0x04, 0x50, 0x9d, 0xf7, 0xa6, 0xde, 0xcd, 0x72,
+	0xe4, 0x33, 0xad, 0x47, 0xac, 0x42, 0x7d, 0xa4, 0xf9, 0xf6, 0xb7, 0x39, 0x34, 0x2b, 0x4d, 0xe1,
+	0x8f, 0x1a, 0xca, 0x0f, 0x83, 0x81, 0xcb, 0x59, 0xc7, 0x3f, 0x36, 0x59, 0xba, 0x39, 0x29, 0x3d,
+	0x9a, 0x95, 0x6c, 0xbc, 0xfd, 0xfe, 0xfb, 0xc3, 0xcc, 0x5d, 0x4c, 0x68, 0x76, 0xa4, 0x55, 0x96,
+	0xf0, 0x57, 0x0d, 0x15, 0x46, 0x8f, 0x1e, 0x6f, 0xff, 0xb3, 0xdd, 0xd8, 0xa0, 0xe9, 0x95, 0xa9,
+	0x6a, 0x94, 0xcf, 0x8a, 0xf4, 0x59, 0xc6, 0xf7, 0xb3, 0x7c, 0x26, 0xd7, 0x6f, 0xbb, 0xfd, 0xe8,
+	0x24, 0xf0, 0x27, 0x0d, 0xcd, 0x5f, 0xb9, 0x59, 0x4c, 0xaf, 0xef, 0x3c, 0x12, 0x10, 0x7d, 0x73,
+	0xf2, 0x02, 0xe5, 0xf3, 0xa1, 0xf4, 0x49, 0x71, 0x39, 0xcb, 0xa7, 0x74, 0x66, 0xab, 0x68, 0xd0,
+	0xd7, 0xf2, 0xf1, 0x8d, 0xdc, 0xf9, 0xf0, 0xf8, 0xaf, 0xd9, 0x79, 0x3a, 0x3d, 0xba, 0x39, 0x29,
+	0x7d, 0xd2, 0x9d, 0x27, 0xa9, 0xc2, 0x9f, 0x35, 0x54, 0x18, 0x8d, 0xc9, 0x35, 0x3b, 0x1f, 0x1b,
+	0x39, 0xbd, 0x32, 0x55, 0x8d, 0xf2, 0xb9, 0x29, 0x7d, 0x6e, 0xe0, 0xf5, 0x2c, 0x9f, 0xe9, 0x54,
+	0x55, 0x9f, 0x9e, 0x9e, 0x1b, 0xda, 0xd9, 0xb9, 0xa1, 0xfd, 0x3a, 0x37, 0xb4, 0x77, 0x17, 0x46,
+	0xee, 0xec, 0xc2, 0xc8, 0xfd, 0xb8, 0x30, 0x72, 0x07, 0x5b, 0x57, 0x12, 0xab, 0xd4, 0xca, 0x1d,
+	0xc7, 0xe5, 0x43, 0xe9, 0xe3, 0x47, 0xf4, 0x24, 0xd6, 0x97, 0x01, 0x76, 0xe7, 0xe4, 0x9f, 0x58,
+	0xe5, 0xcf, 0x00, 0x6a, 0x80, 0x1e, 0x4d, 0x7d, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -449,6 +528,9 @@ type QueryClient interface {
 	DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error)
 	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+	// CurrentBaseFee returns the base fee, the gas price in the base denom
+	// every tx has to pay in the current block.
+	CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -495,6 +577,15 @@ func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest,
 	return out, nil
 }
 
+func (c *queryClient) CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error) {
+	out := new(QueryCurrentBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/CurrentBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -504,6 +595,9 @@ type QueryServer interface {
 	DenomSpotPrice(context.Context, *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error)
 	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+	// CurrentBaseFee returns the base fee, the gas price in the base denom
+	// every tx has to pay in the current block.
+	CurrentBaseFee(context.Context, *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -522,6 +616,9 @@ func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
 }
+func (*UnimplementedQueryServer) CurrentBaseFee(ctx context.Context, req *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -599,6 +696,24 @@ func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CurrentBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/CurrentBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentBaseFee(ctx, req.(*QueryCurrentBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -619,6 +734,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseDenom",
 			Handler:    _Query_BaseDenom_Handler,
 		},
+		{
+			MethodName: "CurrentBaseFee",
+			Handler:    _Query_CurrentBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -863,6 +982,62 @@ func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCurrentBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentBaseFee.Size()
+		i -= size
+		if _, err := m.CurrentBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -972,6 +1147,26 @@ func (m *QueryBaseDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCurrentBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1580,6 +1775,140 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCurrentBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0